@@ -1,6 +1,7 @@
 package main
 
 import (
+	"backend-golang/internal/helpers"
 	"backend-golang/internal/infrastructure/config"
 	"backend-golang/internal/infrastructure/container"
 	"backend-golang/internal/infrastructure/database"
@@ -20,6 +21,15 @@ func main() {
 	config.LoadEnv()
 	logger.InitLogger()
 
+	if err := helpers.LoadSigningKeys(); err != nil {
+		log.Fatalf("Failed to load JWT signing keys: %v", err)
+	}
+	reloadInterval, err := time.ParseDuration(config.GetEnv("JWT_KEYS_RELOAD_INTERVAL", "5m"))
+	if err != nil {
+		log.Fatalf("Invalid JWT_KEYS_RELOAD_INTERVAL: %v", err)
+	}
+	helpers.WatchSigningKeys(reloadInterval)
+
 	appContainer, err := initializeContainer()
 	if err != nil {
 		log.Fatalf("Failed to initialize container: %v", err)
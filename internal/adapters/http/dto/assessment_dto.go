@@ -0,0 +1,115 @@
+package dto
+
+import (
+	"backend-golang/internal/helpers"
+	"time"
+)
+
+type AssessmentInstrumentCreateRequest struct {
+	Code           string                            `json:"code" validate:"required,max=10"`
+	Name           string                            `json:"name" validate:"required,max=100"`
+	TherapySection string                            `json:"therapy_section" validate:"required,oneof=Okupasi Fisio Wicara Paedagog"`
+	Description    string                            `json:"description" validate:"omitempty,max=2000"`
+	SortOrder      int                               `json:"sort_order" validate:"min=0"`
+	Items          []AssessmentInstrumentItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+type AssessmentInstrumentItemRequest struct {
+	ItemNumber int    `json:"item_number" validate:"required,min=1"`
+	ItemText   string `json:"item_text" validate:"required"`
+	MaxScore   int    `json:"max_score" validate:"required,min=1,max=10"`
+}
+
+type AssessmentInstrumentUpdateRequest struct {
+	Name        string `json:"name" validate:"required,max=100"`
+	Description string `json:"description" validate:"omitempty,max=2000"`
+	SortOrder   int    `json:"sort_order" validate:"min=0"`
+	IsActive    *bool  `json:"is_active" validate:"required"`
+}
+
+type AssessmentInstrumentQuery struct {
+	TherapySection string `form:"therapy_section" validate:"omitempty,oneof=Okupasi Fisio Wicara Paedagog"`
+}
+
+type AssessmentInstrumentResponse struct {
+	InstrumentId   int                                 `json:"instrument_id"`
+	Code           string                              `json:"code"`
+	Name           string                              `json:"name"`
+	TherapySection string                              `json:"therapy_section"`
+	Description    string                              `json:"description"`
+	SortOrder      int                                 `json:"sort_order"`
+	IsActive       bool                                `json:"is_active"`
+	Items          []*AssessmentInstrumentItemResponse `json:"items"`
+}
+
+type AssessmentInstrumentItemResponse struct {
+	ItemId     int    `json:"item_id"`
+	ItemNumber int    `json:"item_number"`
+	ItemText   string `json:"item_text"`
+	MaxScore   int    `json:"max_score"`
+
+	Score *int   `json:"score,omitempty"`
+	Note  string `json:"note,omitempty"`
+}
+
+type OpenAssessmentRequest struct {
+	ObservationId  int              `json:"observation_id" validate:"required,min=1"`
+	TherapySection string           `json:"therapy_section" validate:"omitempty,oneof=Okupasi Fisio Wicara Paedagog"`
+	AssessorId     string           `json:"assessor_id" validate:"required,len=26"`
+	ScheduledDate  helpers.DateOnly `json:"scheduled_date" validate:"required"`
+}
+
+type ReassignAssessmentRequest struct {
+	AssessorId    string           `json:"assessor_id" validate:"required,len=26"`
+	ScheduledDate helpers.DateOnly `json:"scheduled_date" validate:"required"`
+}
+
+type CancelAssessmentRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+type AssessmentListQuery struct {
+	ListQueryRequest
+	Status string `form:"status" validate:"omitempty,oneof=Scheduled InProgress Completed Cancelled"`
+}
+
+type SaveAssessmentScoresRequest struct {
+	Scores []AssessmentScoreInput `json:"scores" validate:"required,min=1,dive"`
+}
+
+type AssessmentScoreInput struct {
+	ItemId int     `json:"item_id" validate:"required,min=1"`
+	Score  *int    `json:"score" validate:"required,min=0"`
+	Note   *string `json:"note" validate:"omitempty,max=500"`
+}
+
+type CompleteAssessmentRequest struct {
+	Scores          []AssessmentScoreInput `json:"scores" validate:"omitempty,dive"`
+	Summary         string                 `json:"summary" validate:"required,max=5000"`
+	Recommendation  string                 `json:"recommendation" validate:"required,max=5000"`
+	SessionsPerWeek *int                   `json:"sessions_per_week" validate:"omitempty,min=1,max=7"`
+}
+
+type AssessmentResponse struct {
+	AssessmentId   int              `json:"assessment_id"`
+	ObservationId  int              `json:"observation_id"`
+	ChildId        string           `json:"child_id"`
+	ChildName      string           `json:"child_name"`
+	TherapySection string           `json:"therapy_section"`
+	AssessorId     string           `json:"assessor_id"`
+	AssessorName   string           `json:"assessor_name"`
+	ScheduledDate  helpers.DateOnly `json:"scheduled_date"`
+	Status         string           `json:"status"`
+}
+
+type AssessmentDetailResponse struct {
+	AssessmentResponse
+	Summary         string                          `json:"summary"`
+	Recommendation  string                          `json:"recommendation"`
+	SessionsPerWeek *int                            `json:"sessions_per_week"`
+	CancelReason    string                          `json:"cancel_reason,omitempty"`
+	CompletedAt     *time.Time                      `json:"completed_at"`
+	ScoredItems     int                             `json:"scored_items"`
+	TotalItems      int                             `json:"total_items"`
+	Instruments     []*AssessmentInstrumentResponse `json:"instruments"`
+}
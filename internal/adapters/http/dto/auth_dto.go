@@ -21,13 +21,18 @@ type ForgetPasswordRequest struct {
 	Email string `json:"email" validate:"required,email"`
 }
 
+// UserAgent and IpAddress are filled by the handler from the request.
 type LoginRequest struct {
 	Identifier string `json:"identifier" validate:"required,min=3,max=50"`
 	Password   string `json:"password" validate:"required"`
+	UserAgent  string `json:"-"`
+	IpAddress  string `json:"-"`
 }
 
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refreshToken" validate:"required"`
+	UserAgent    string `json:"-"`
+	IpAddress    string `json:"-"`
 }
 
 type LoginResponse struct {
@@ -40,6 +45,12 @@ type LoginResponse struct {
 	RefreshToken string `json:"refreshToken"`
 	CreatedAt    string `json:"createdAt"`
 	UpdatedAt    string `json:"updatedAt"`
+
+	// MfaRequired means no tokens were issued yet; the login continues at
+	// the MFA step with the challenge.
+	MfaRequired   bool                  `json:"mfaRequired"`
+	MfaChallenge  *MfaChallengeResponse `json:"mfaChallenge,omitempty"`
+	RecoveryCodes []string              `json:"recoveryCodes,omitempty"`
 }
 
 type RefreshTokenResponse struct {
@@ -48,3 +59,55 @@ type RefreshTokenResponse struct {
 	TokenType    string `json:"tokenType"`
 	ExpiresAt    string `json:"expiresIn"`
 }
+
+type SessionResponse struct {
+	SessionId  string `json:"sessionId"`
+	UserAgent  string `json:"userAgent"`
+	IpAddress  string `json:"ipAddress"`
+	Current    bool   `json:"current"`
+	SignedInAt string `json:"signedInAt"`
+	LastUsedAt string `json:"lastUsedAt"`
+	ExpiresAt  string `json:"expiresAt"`
+}
+
+type RevokeSessionsResponse struct {
+	Revoked int64 `json:"revoked"`
+}
+
+// MfaLoginRequest completes a login stopped at the MFA step, with either a
+// code from the authenticator app or a recovery code.
+type MfaLoginRequest struct {
+	ChallengeToken string `json:"challengeToken" validate:"required"`
+	Code           string `json:"code" validate:"omitempty,len=6,numeric"`
+	RecoveryCode   string `json:"recoveryCode" validate:"omitempty,max=20"`
+	UserAgent      string `json:"-"`
+	IpAddress      string `json:"-"`
+}
+
+type MfaCodeRequest struct {
+	Code         string `json:"code" validate:"omitempty,len=6,numeric"`
+	RecoveryCode string `json:"recoveryCode" validate:"omitempty,max=20"`
+}
+
+type MfaChallengeResponse struct {
+	ChallengeToken string                 `json:"challengeToken"`
+	ExpiresAt      string                 `json:"expiresAt"`
+	Enrollment     *MfaEnrollmentResponse `json:"enrollment,omitempty"`
+}
+
+type MfaEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OtpauthUri string `json:"otpauthUri"`
+}
+
+type MfaRecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+type MfaStatusResponse struct {
+	Enabled                bool    `json:"enabled"`
+	Pending                bool    `json:"pending"`
+	Required               bool    `json:"required"`
+	EnabledAt              *string `json:"enabledAt"`
+	RecoveryCodesRemaining int64   `json:"recoveryCodesRemaining"`
+}
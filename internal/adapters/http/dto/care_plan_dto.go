@@ -0,0 +1,51 @@
+package dto
+
+import "backend-golang/internal/helpers"
+
+type CarePlanGoalCreateRequest struct {
+	TherapySection      string           `json:"therapy_section" validate:"required,oneof=Okupasi Fisio Wicara Paedagog"`
+	Description         string           `json:"description" validate:"required,max=500"`
+	Baseline            *float64         `json:"baseline" validate:"required"`
+	Target              *float64         `json:"target" validate:"required"`
+	MeasurementCriteria string           `json:"measurement_criteria" validate:"required,max=1000"`
+	ReviewDate          helpers.DateOnly `json:"review_date" validate:"required"`
+}
+
+type CarePlanGoalUpdateRequest struct {
+	Description         string           `json:"description" validate:"required,max=500"`
+	Baseline            *float64         `json:"baseline" validate:"required"`
+	Target              *float64         `json:"target" validate:"required"`
+	MeasurementCriteria string           `json:"measurement_criteria" validate:"required,max=1000"`
+	ReviewDate          helpers.DateOnly `json:"review_date" validate:"required"`
+	Status              string           `json:"status" validate:"required,oneof=Active Achieved Discontinued"`
+}
+
+type CarePlanGoalResponse struct {
+	GoalId              int               `json:"goal_id"`
+	TherapySection      string            `json:"therapy_section"`
+	Description         string            `json:"description"`
+	Baseline            float64           `json:"baseline"`
+	Target              float64           `json:"target"`
+	MeasurementCriteria string            `json:"measurement_criteria"`
+	ReviewDate          helpers.DateOnly  `json:"review_date"`
+	ReviewDue           bool              `json:"review_due"`
+	Status              string            `json:"status"`
+	LatestValue         *float64          `json:"latest_value"`
+	LatestMeasuredOn    *helpers.DateOnly `json:"latest_measured_on"`
+	Attainment          float64           `json:"attainment"`
+}
+
+// SectionAttainmentResponse averages the attainment of the section's goals,
+// leaving discontinued goals out.
+type SectionAttainmentResponse struct {
+	TherapySection string                  `json:"therapy_section"`
+	Attainment     float64                 `json:"attainment"`
+	Goals          []*CarePlanGoalResponse `json:"goals"`
+}
+
+type GoalAttainmentResponse struct {
+	ChildId    string                       `json:"child_id"`
+	ChildName  string                       `json:"child_name"`
+	Attainment float64                      `json:"attainment"`
+	Sections   []*SectionAttainmentResponse `json:"sections"`
+}
@@ -0,0 +1,70 @@
+package dto
+
+import "time"
+
+type CaseConferenceCreateRequest struct {
+	ChildId        string                         `json:"child_id" validate:"required,len=26"`
+	ScheduledAt    time.Time                      `json:"scheduled_at" validate:"required"`
+	Location       string                         `json:"location" validate:"omitempty,max=255"`
+	Agenda         string                         `json:"agenda" validate:"omitempty,max=5000"`
+	ParticipantIds []string                       `json:"participant_ids" validate:"required,min=1,dive,len=26"`
+	Sections       []CaseConferenceSectionRequest `json:"sections" validate:"omitempty,dive"`
+}
+
+type CaseConferenceSectionRequest struct {
+	TherapySection  string `json:"therapy_section" validate:"required,oneof=Okupasi Fisio Wicara Paedagog"`
+	SessionsPerWeek int    `json:"sessions_per_week" validate:"required,min=1,max=7"`
+}
+
+// CaseConferenceUpdateRequest replaces the conference minutes. Participants
+// are left as they are when ParticipantIds is omitted.
+type CaseConferenceUpdateRequest struct {
+	ScheduledAt    time.Time                      `json:"scheduled_at" validate:"required"`
+	Location       string                         `json:"location" validate:"omitempty,max=255"`
+	Status         string                         `json:"status" validate:"required,oneof=Scheduled Held Cancelled"`
+	Agenda         string                         `json:"agenda" validate:"omitempty,max=5000"`
+	Decisions      string                         `json:"decisions" validate:"omitempty,max=10000"`
+	ParticipantIds []string                       `json:"participant_ids" validate:"omitempty,min=1,dive,len=26"`
+	Sections       []CaseConferenceSectionRequest `json:"sections" validate:"omitempty,dive"`
+	ParentConsent  string                         `json:"parent_consent" validate:"required,oneof=Pending Agreed Partial Declined"`
+	ConsentNote    string                         `json:"consent_note" validate:"omitempty,max=1000"`
+}
+
+type CaseConferenceListQuery struct {
+	ListQueryRequest
+	ChildId string `form:"child_id" validate:"omitempty,len=26"`
+	Status  string `form:"status" validate:"omitempty,oneof=Scheduled Held Cancelled"`
+}
+
+type CaseConferenceResponse struct {
+	ConferenceId  int                                  `json:"conference_id"`
+	ChildId       string                               `json:"child_id"`
+	ChildName     string                               `json:"child_name"`
+	ScheduledAt   time.Time                            `json:"scheduled_at"`
+	Location      string                               `json:"location"`
+	Status        string                               `json:"status"`
+	ParentConsent string                               `json:"parent_consent"`
+	Participants  []*CaseConferenceParticipantResponse `json:"participants"`
+	Sections      []*CaseConferenceSectionResponse     `json:"sections"`
+}
+
+type CaseConferenceParticipantResponse struct {
+	TherapistId      string `json:"therapist_id"`
+	TherapistName    string `json:"therapist_name"`
+	TherapistSection string `json:"therapist_section"`
+}
+
+type CaseConferenceSectionResponse struct {
+	TherapySection  string `json:"therapy_section"`
+	SessionsPerWeek int    `json:"sessions_per_week"`
+}
+
+type CaseConferenceDetailResponse struct {
+	CaseConferenceResponse
+	Agenda            string     `json:"agenda"`
+	Decisions         string     `json:"decisions"`
+	ConsentNote       string     `json:"consent_note"`
+	ConsentRecordedAt *time.Time `json:"consent_recorded_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
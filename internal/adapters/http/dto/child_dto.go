@@ -1,6 +1,9 @@
 package dto
 
-import "backend-golang/internal/helpers"
+import (
+	"backend-golang/internal/helpers"
+	"time"
+)
 
 type ChildResponse struct {
 	ChildId        string           `json:"child_id"`
@@ -12,3 +15,80 @@ type ChildResponse struct {
 	CreatedAt      string           `json:"created_at"`
 	UpdatedAt      string           `json:"updated_at"`
 }
+
+type ChildProgressResponse struct {
+	ChildId                string                           `json:"child_id"`
+	ChildName              string                           `json:"child_name"`
+	ObservationCount       int                              `json:"observation_count"`
+	Sessions               []*ProgressSessionResponse       `json:"sessions"`
+	DomainTrends           []*DomainTrendResponse           `json:"domain_trends"`
+	AgeCategoryTransitions []*AgeCategoryTransitionResponse `json:"age_category_transitions"`
+	LatestChanges          *ProgressChangesResponse         `json:"latest_changes"`
+}
+
+type ProgressSessionResponse struct {
+	ObservationId  int              `json:"observation_id"`
+	ScheduledDate  helpers.DateOnly `json:"scheduled_date"`
+	AgeCategory    string           `json:"age_category"`
+	TherapistName  string           `json:"therapist_name"`
+	TotalScore     int              `json:"total_score"`
+	RiskLevel      string           `json:"risk_level"`
+	TherapySection string           `json:"therapy_section"`
+}
+
+type DomainTrendResponse struct {
+	Domain      string                      `json:"domain"`
+	DomainName  string                      `json:"domain_name"`
+	Points      []*DomainTrendPointResponse `json:"points"`
+	LatestDelta *int                        `json:"latest_delta"`
+}
+
+type DomainTrendPointResponse struct {
+	ObservationId int              `json:"observation_id"`
+	ScheduledDate helpers.DateOnly `json:"scheduled_date"`
+	AgeCategory   string           `json:"age_category"`
+	Score         int              `json:"score"`
+	MaxScore      int              `json:"max_score"`
+	RiskLevel     string           `json:"risk_level"`
+}
+
+type AgeCategoryTransitionResponse struct {
+	From              string           `json:"from"`
+	To                string           `json:"to"`
+	FromObservationId int              `json:"from_observation_id"`
+	ToObservationId   int              `json:"to_observation_id"`
+	ObservedOn        helpers.DateOnly `json:"observed_on"`
+}
+
+type ProgressChangesResponse struct {
+	FromObservationId int                       `json:"from_observation_id"`
+	ToObservationId   int                       `json:"to_observation_id"`
+	TotalScoreDelta   int                       `json:"total_score_delta"`
+	Questions         []*QuestionChangeResponse `json:"questions"`
+}
+
+type QuestionChangeResponse struct {
+	QuestionCode        string `json:"question_code"`
+	QuestionText        string `json:"question_text"`
+	Domain              string `json:"domain"`
+	PreviousAnswer      *bool  `json:"previous_answer"`
+	CurrentAnswer       *bool  `json:"current_answer"`
+	PreviousScoreEarned int    `json:"previous_score_earned"`
+	CurrentScoreEarned  int    `json:"current_score_earned"`
+}
+
+type CarePlanResponse struct {
+	ChildId   string                   `json:"child_id"`
+	ChildName string                   `json:"child_name"`
+	Entries   []*CarePlanEntryResponse `json:"entries"`
+}
+
+type CarePlanEntryResponse struct {
+	EntryId         int       `json:"entry_id"`
+	TherapySection  string    `json:"therapy_section"`
+	AssessmentId    *int      `json:"assessment_id"`
+	Summary         string    `json:"summary"`
+	Recommendation  string    `json:"recommendation"`
+	SessionsPerWeek *int      `json:"sessions_per_week"`
+	CreatedAt       time.Time `json:"created_at"`
+}
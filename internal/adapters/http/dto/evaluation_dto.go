@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"backend-golang/internal/helpers"
+	"time"
+)
+
+// EvaluationGoalInput records progress on a care plan goal: a new reading,
+// a change of status, or both.
+type EvaluationGoalInput struct {
+	GoalId int      `json:"goal_id" validate:"required,min=1"`
+	Value  *float64 `json:"value"`
+	Status string   `json:"status" validate:"omitempty,oneof=Active Achieved Discontinued"`
+	Note   string   `json:"note" validate:"omitempty,max=1000"`
+}
+
+type EvaluationCreateRequest struct {
+	EvaluationDate     helpers.DateOnly      `json:"evaluation_date" validate:"required"`
+	Summary            string                `json:"summary" validate:"required,max=5000"`
+	Decision           string                `json:"decision" validate:"required,oneof=Continue Change End"`
+	ProgrammeStatus    string                `json:"programme_status" validate:"required,oneof=Active Paused Graduated Discharged"`
+	NextEvaluationDate *helpers.DateOnly     `json:"next_evaluation_date"`
+	Goals              []EvaluationGoalInput `json:"goals" validate:"omitempty,max=50,dive"`
+}
+
+type EvaluationGoalResponse struct {
+	GoalId         int      `json:"goal_id"`
+	TherapySection string   `json:"therapy_section"`
+	Description    string   `json:"description"`
+	Value          *float64 `json:"value"`
+	Attainment     float64  `json:"attainment"`
+	Status         string   `json:"status"`
+	Note           string   `json:"note,omitempty"`
+}
+
+type EvaluationDomainScoreResponse struct {
+	Domain     string `json:"domain"`
+	DomainName string `json:"domain_name"`
+	Score      int    `json:"score"`
+	MaxScore   int    `json:"max_score"`
+	RiskLevel  string `json:"risk_level"`
+}
+
+type EvaluationObservationResponse struct {
+	ObservationId  int                              `json:"observation_id"`
+	ScheduledDate  helpers.DateOnly                 `json:"scheduled_date"`
+	TotalScore     int                              `json:"total_score"`
+	RiskLevel      string                           `json:"risk_level"`
+	TherapySection string                           `json:"therapy_section"`
+	DomainScores   []*EvaluationDomainScoreResponse `json:"domain_scores"`
+}
+
+// EvaluationDraftResponse is what the team looks at before evaluating: the
+// goals as they stand now and the latest completed observation.
+type EvaluationDraftResponse struct {
+	ChildId            string                         `json:"child_id"`
+	ChildName          string                         `json:"child_name"`
+	ProgrammeStatus    string                         `json:"programme_status"`
+	NextEvaluationDate *helpers.DateOnly              `json:"next_evaluation_date"`
+	LastEvaluatedOn    *helpers.DateOnly              `json:"last_evaluated_on"`
+	Goals              []*EvaluationGoalResponse      `json:"goals"`
+	Observation        *EvaluationObservationResponse `json:"observation"`
+}
+
+type EvaluationResponse struct {
+	EvaluationId       int               `json:"evaluation_id"`
+	ChildId            string            `json:"child_id"`
+	EvaluationDate     helpers.DateOnly  `json:"evaluation_date"`
+	Decision           string            `json:"decision"`
+	ProgrammeStatus    string            `json:"programme_status"`
+	NextEvaluationDate *helpers.DateOnly `json:"next_evaluation_date"`
+	Summary            string            `json:"summary"`
+	CreatedAt          time.Time         `json:"created_at"`
+}
+
+type EvaluationDetailResponse struct {
+	EvaluationResponse
+	ChildName   string                         `json:"child_name"`
+	Goals       []*EvaluationGoalResponse      `json:"goals"`
+	Observation *EvaluationObservationResponse `json:"observation"`
+}
+
+type OverdueEvaluationResponse struct {
+	ChildId            string            `json:"child_id"`
+	ChildName          string            `json:"child_name"`
+	ProgrammeStatus    string            `json:"programme_status"`
+	NextEvaluationDate *helpers.DateOnly `json:"next_evaluation_date"`
+	LastEvaluatedOn    *helpers.DateOnly `json:"last_evaluated_on"`
+	DaysOverdue        int               `json:"days_overdue"`
+}
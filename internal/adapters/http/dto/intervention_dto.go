@@ -0,0 +1,154 @@
+package dto
+
+import (
+	"backend-golang/internal/helpers"
+	"time"
+)
+
+type InterventionPlanSlotInput struct {
+	DayOfWeek *int   `json:"day_of_week" validate:"required,min=0,max=6"`
+	StartTime string `json:"start_time" validate:"required,datetime=15:04"`
+}
+
+type InterventionPlanCreateRequest struct {
+	ChildId        string                      `json:"child_id" validate:"required,len=26"`
+	TherapistId    string                      `json:"therapist_id" validate:"required,len=26"`
+	TherapySection string                      `json:"therapy_section" validate:"required,oneof=Okupasi Fisio Wicara Paedagog"`
+	StartDate      helpers.DateOnly            `json:"start_date" validate:"required"`
+	EndDate        *helpers.DateOnly           `json:"end_date"`
+	SessionMinutes int                         `json:"session_minutes" validate:"required,min=15,max=240"`
+	Slots          []InterventionPlanSlotInput `json:"slots" validate:"required,min=1,max=14,dive"`
+}
+
+type InterventionPlanUpdateRequest struct {
+	TherapistId    string                      `json:"therapist_id" validate:"required,len=26"`
+	EndDate        *helpers.DateOnly           `json:"end_date"`
+	SessionMinutes int                         `json:"session_minutes" validate:"required,min=15,max=240"`
+	IsActive       *bool                       `json:"is_active" validate:"required"`
+	Slots          []InterventionPlanSlotInput `json:"slots" validate:"required,min=1,max=14,dive"`
+}
+
+type InterventionPlanListQuery struct {
+	ListQueryRequest
+	ChildId     string `form:"child_id" validate:"omitempty,len=26"`
+	TherapistId string `form:"therapist_id" validate:"omitempty,len=26"`
+}
+
+type InterventionPlanResponse struct {
+	PlanId         int                             `json:"plan_id"`
+	ChildId        string                          `json:"child_id"`
+	ChildName      string                          `json:"child_name"`
+	TherapistId    string                          `json:"therapist_id"`
+	TherapistName  string                          `json:"therapist_name"`
+	TherapySection string                          `json:"therapy_section"`
+	StartDate      helpers.DateOnly                `json:"start_date"`
+	EndDate        *helpers.DateOnly               `json:"end_date"`
+	SessionMinutes int                             `json:"session_minutes"`
+	IsActive       bool                            `json:"is_active"`
+	Slots          []*InterventionPlanSlotResponse `json:"slots"`
+}
+
+type InterventionPlanSlotResponse struct {
+	DayOfWeek int    `json:"day_of_week"`
+	StartTime string `json:"start_time"`
+}
+
+// SessionGoalInput is a goal worked on in the session. A care plan goal may
+// be referenced instead of described, and only then can a measured Value be
+// recorded against it.
+type SessionGoalInput struct {
+	CarePlanGoalId *int     `json:"care_plan_goal_id" validate:"omitempty,min=1"`
+	Description    string   `json:"description" validate:"required_without=CarePlanGoalId,max=500"`
+	Note           string   `json:"note" validate:"omitempty,max=1000"`
+	Value          *float64 `json:"value"`
+}
+
+// RecordSessionRequest records, or corrects, the outcome of one planned
+// session identified by its plan, date and start time.
+type RecordSessionRequest struct {
+	PlanId       int                `json:"plan_id" validate:"required,min=1"`
+	SessionDate  helpers.DateOnly   `json:"session_date" validate:"required"`
+	StartTime    string             `json:"start_time" validate:"required,datetime=15:04"`
+	Status       string             `json:"status" validate:"required,oneof=Attended Cancelled NoShow"`
+	CancelReason string             `json:"cancel_reason" validate:"omitempty,max=500"`
+	Subjective   string             `json:"subjective" validate:"omitempty,max=5000"`
+	Objective    string             `json:"objective" validate:"omitempty,max=5000"`
+	Assessment   string             `json:"assessment" validate:"omitempty,max=5000"`
+	Plan         string             `json:"plan" validate:"omitempty,max=5000"`
+	Goals        []SessionGoalInput `json:"goals" validate:"omitempty,max=20,dive"`
+}
+
+// TodaySessionResponse is a planned session; SessionId is nil until it is
+// recorded.
+type TodaySessionResponse struct {
+	SessionId      *int             `json:"session_id"`
+	PlanId         int              `json:"plan_id"`
+	ChildId        string           `json:"child_id"`
+	ChildName      string           `json:"child_name"`
+	TherapySection string           `json:"therapy_section"`
+	SessionDate    helpers.DateOnly `json:"session_date"`
+	StartTime      string           `json:"start_time"`
+	SessionMinutes int              `json:"session_minutes"`
+	Status         string           `json:"status"`
+}
+
+type InterventionSessionResponse struct {
+	SessionId      int                    `json:"session_id"`
+	PlanId         int                    `json:"plan_id"`
+	ChildId        string                 `json:"child_id"`
+	ChildName      string                 `json:"child_name"`
+	TherapistId    string                 `json:"therapist_id"`
+	TherapistName  string                 `json:"therapist_name"`
+	TherapySection string                 `json:"therapy_section"`
+	SessionDate    helpers.DateOnly       `json:"session_date"`
+	StartTime      string                 `json:"start_time"`
+	Status         string                 `json:"status"`
+	CancelReason   string                 `json:"cancel_reason,omitempty"`
+	Subjective     string                 `json:"subjective"`
+	Objective      string                 `json:"objective"`
+	Assessment     string                 `json:"assessment"`
+	Plan           string                 `json:"plan"`
+	Goals          []*SessionGoalResponse `json:"goals"`
+	RecordedAt     time.Time              `json:"recorded_at"`
+}
+
+type SessionGoalResponse struct {
+	GoalId         int      `json:"goal_id"`
+	CarePlanGoalId *int     `json:"care_plan_goal_id"`
+	Description    string   `json:"description"`
+	Note           string   `json:"note"`
+	Value          *float64 `json:"value"`
+}
+
+type AttendanceReportQuery struct {
+	DateFrom    string `form:"date_from" validate:"required,datetime=2006-01-02"`
+	DateTo      string `form:"date_to" validate:"required,datetime=2006-01-02"`
+	ChildId     string `form:"child_id" validate:"omitempty,len=26"`
+	TherapistId string `form:"therapist_id" validate:"omitempty,len=26"`
+}
+
+type AttendanceCountsResponse struct {
+	Planned        int     `json:"planned"`
+	Attended       int     `json:"attended"`
+	Cancelled      int     `json:"cancelled"`
+	NoShow         int     `json:"no_show"`
+	Unrecorded     int     `json:"unrecorded"`
+	AttendanceRate float64 `json:"attendance_rate"`
+}
+
+type PlanAttendanceResponse struct {
+	PlanId         int    `json:"plan_id"`
+	ChildId        string `json:"child_id"`
+	ChildName      string `json:"child_name"`
+	TherapistId    string `json:"therapist_id"`
+	TherapistName  string `json:"therapist_name"`
+	TherapySection string `json:"therapy_section"`
+	AttendanceCountsResponse
+}
+
+type AttendanceReportResponse struct {
+	DateFrom string                    `json:"date_from"`
+	DateTo   string                    `json:"date_to"`
+	Totals   AttendanceCountsResponse  `json:"totals"`
+	Plans    []*PlanAttendanceResponse `json:"plans"`
+}
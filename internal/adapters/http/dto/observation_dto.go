@@ -2,6 +2,7 @@ package dto
 
 import (
 	"backend-golang/internal/helpers"
+	"time"
 )
 
 type ObservationsResponse struct {
@@ -14,7 +15,11 @@ type ObservationsResponse struct {
 	ParentName     string           `json:"parent_name"`
 	ParentPhone    string           `json:"parent_phone"`
 	ScheduledDate  helpers.DateOnly `json:"scheduled_date"`
+	ScheduledStart *time.Time       `json:"scheduled_start,omitempty"`
+	ScheduledEnd   *time.Time       `json:"scheduled_end,omitempty"`
 	Status         string           `json:"status"`
+	TherapistId    string           `json:"therapist_id,omitempty"`
+	TherapistName  string           `json:"therapist_name,omitempty"`
 }
 
 type DetailObservationResponse struct {
@@ -32,10 +37,71 @@ type DetailObservationResponse struct {
 	Email       string `json:"email"`
 
 	ChildComplaint string `json:"child_complaint"`
+
+	ScheduledStart *time.Time `json:"scheduled_start,omitempty"`
+	ScheduledEnd   *time.Time `json:"scheduled_end,omitempty"`
+	TherapistId    string     `json:"therapist_id,omitempty"`
+	TherapistName  string     `json:"therapist_name,omitempty"`
+
+	Status          string                              `json:"status"`
+	RescheduleCount int                                 `json:"reschedule_count"`
+	StatusHistory   []*ObservationStatusHistoryResponse `json:"status_history"`
+
+	TotalScore         int                    `json:"total_score"`
+	SuggestedRiskLevel string                 `json:"suggested_risk_level,omitempty"`
+	SuggestedSection   string                 `json:"suggested_section,omitempty"`
+	RiskLevel          string                 `json:"risk_level,omitempty"`
+	TherapySection     string                 `json:"therapy_section,omitempty"`
+	DomainScores       []*DomainScoreResponse `json:"domain_scores"`
+	Conclusion         string                 `json:"conclusion,omitempty"`
+	Recommendation     string                 `json:"recommendation,omitempty"`
+
+	Revisions []*ObservationRevisionResponse `json:"revisions"`
+}
+
+type ObservationRevisionResponse struct {
+	Revision           int                       `json:"revision"`
+	TotalScore         int                       `json:"total_score"`
+	SuggestedRiskLevel string                    `json:"suggested_risk_level,omitempty"`
+	SuggestedSection   string                    `json:"suggested_section,omitempty"`
+	RiskLevel          string                    `json:"risk_level,omitempty"`
+	TherapySection     string                    `json:"therapy_section,omitempty"`
+	Conclusion         string                    `json:"conclusion"`
+	Recommendation     string                    `json:"recommendation"`
+	Answers            []*RevisionAnswerResponse `json:"answers"`
+	DomainScores       []*DomainScoreResponse    `json:"domain_scores"`
+	Reason             string                    `json:"reason"`
+	ActorId            string                    `json:"actor_id"`
+	ActorRole          string                    `json:"actor_role"`
+	CreatedAt          string                    `json:"created_at"`
+}
+
+type RevisionAnswerResponse struct {
+	QuestionId  int    `json:"question_id"`
+	Answer      bool   `json:"answer"`
+	ScoreEarned int    `json:"score_earned"`
+	Note        string `json:"note"`
+}
+
+type ObservationStatusHistoryResponse struct {
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	Reason     string `json:"reason"`
+	ActorId    string `json:"actor_id"`
+	ActorRole  string `json:"actor_role"`
+	CreatedAt  string `json:"created_at"`
 }
 
 type UpdateObservationDateRequest struct {
 	ScheduledDate helpers.DateOnly `json:"scheduled_date" validate:"required"`
+	StartTime     string           `json:"start_time" validate:"required,datetime=15:04"`
+	TherapistId   string           `json:"therapist_id" validate:"omitempty,len=26"`
+	Reason        string           `json:"reason" validate:"omitempty,max=500"`
+}
+
+type UpdateObservationStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=InProgress Cancelled NoShow Retracted"`
+	Reason string `json:"reason" validate:"omitempty,max=500"`
 }
 
 type ObservationQuestionsResponse struct {
@@ -48,13 +114,115 @@ type ObservationQuestionsResponse struct {
 }
 
 type SubmitObservationRequest struct {
-	Answers        []AnswerInput `json:"answers" validate:"required,dive"`
+	Answers        []AnswerInput `json:"answers" validate:"required,min=1,dive"`
 	Conclusion     string        `json:"conclusion" validate:"required"`
 	Recommendation string        `json:"recommendation" validate:"required"`
+	RiskLevel      string        `json:"risk_level" validate:"omitempty,oneof=Rendah Sedang Tinggi"`
+	TherapySection string        `json:"therapy_section" validate:"omitempty,oneof=Okupasi Fisio Wicara Paedagog"`
 }
 
 type AnswerInput struct {
 	QuestionId int    `json:"question_id" validate:"required"`
-	Answer     bool   `json:"answer" validate:"required"`
+	Answer     bool   `json:"answer"`
 	Note       string `json:"note" validate:"omitempty"`
 }
+
+type SaveObservationDraftRequest struct {
+	Answers        []AnswerInput `json:"answers" validate:"omitempty,dive"`
+	Conclusion     *string       `json:"conclusion"`
+	Recommendation *string       `json:"recommendation"`
+}
+
+type FinaliseObservationRequest struct {
+	Conclusion     string `json:"conclusion"`
+	Recommendation string `json:"recommendation"`
+	RiskLevel      string `json:"risk_level" validate:"omitempty,oneof=Rendah Sedang Tinggi"`
+	TherapySection string `json:"therapy_section" validate:"omitempty,oneof=Okupasi Fisio Wicara Paedagog"`
+}
+
+type ObservationDraftResponse struct {
+	ObservationId  int                    `json:"observation_id"`
+	Status         string                 `json:"status"`
+	Conclusion     string                 `json:"conclusion"`
+	Recommendation string                 `json:"recommendation"`
+	Answers        []*DraftAnswerResponse `json:"answers"`
+	AnsweredCount  int                    `json:"answered_count"`
+	QuestionCount  int                    `json:"question_count"`
+	DraftSavedAt   *time.Time             `json:"draft_saved_at"`
+}
+
+type DraftAnswerResponse struct {
+	QuestionId int    `json:"question_id"`
+	Answer     bool   `json:"answer"`
+	Note       string `json:"note"`
+}
+
+type AmendObservationRequest struct {
+	Answers        []AnswerInput `json:"answers" validate:"omitempty,dive"`
+	Conclusion     string        `json:"conclusion"`
+	Recommendation string        `json:"recommendation"`
+	RiskLevel      string        `json:"risk_level" validate:"omitempty,oneof=Rendah Sedang Tinggi"`
+	TherapySection string        `json:"therapy_section" validate:"omitempty,oneof=Okupasi Fisio Wicara Paedagog"`
+	Reason         string        `json:"reason" validate:"required,max=500"`
+}
+
+type ObservationAnswersQuery struct {
+	CompareWith int `form:"compare_with" validate:"omitempty,min=1"`
+}
+
+type ObservationAnswerSheetResponse struct {
+	ObservationId  int                          `json:"observation_id"`
+	ScheduledDate  helpers.DateOnly             `json:"scheduled_date"`
+	AgeCategory    string                       `json:"age_category"`
+	TotalScore     int                          `json:"total_score"`
+	RiskLevel      string                       `json:"risk_level,omitempty"`
+	TherapySection string                       `json:"therapy_section,omitempty"`
+	Conclusion     string                       `json:"conclusion"`
+	Recommendation string                       `json:"recommendation"`
+	Domains        []*AnswerSheetDomainResponse `json:"domains"`
+
+	ComparedWith    *ObservationAnswerSheetResponse `json:"compared_with,omitempty"`
+	TotalScoreDelta *int                            `json:"total_score_delta,omitempty"`
+}
+
+type AnswerSheetDomainResponse struct {
+	Domain        string                     `json:"domain"`
+	DomainName    string                     `json:"domain_name"`
+	Score         int                        `json:"score"`
+	MaxScore      int                        `json:"max_score"`
+	RiskLevel     string                     `json:"risk_level"`
+	PreviousScore *int                       `json:"previous_score,omitempty"`
+	ScoreDelta    *int                       `json:"score_delta,omitempty"`
+	Answers       []*AnswerSheetItemResponse `json:"answers"`
+}
+
+type AnswerSheetItemResponse struct {
+	QuestionId          int    `json:"question_id"`
+	QuestionCode        string `json:"question_code"`
+	QuestionNumber      int    `json:"question_number"`
+	QuestionText        string `json:"question_text"`
+	Answer              bool   `json:"answer"`
+	Score               int    `json:"score"`
+	ScoreEarned         int    `json:"score_earned"`
+	Note                string `json:"note"`
+	PreviousAnswer      *bool  `json:"previous_answer,omitempty"`
+	PreviousScoreEarned *int   `json:"previous_score_earned,omitempty"`
+}
+
+type ScorePreviewRequest struct {
+	Answers []AnswerInput `json:"answers" validate:"required,min=1,dive"`
+}
+
+type ScoringResultResponse struct {
+	TotalScore       int                    `json:"total_score"`
+	RiskLevel        string                 `json:"risk_level"`
+	SuggestedSection string                 `json:"suggested_section,omitempty"`
+	DomainScores     []*DomainScoreResponse `json:"domain_scores"`
+}
+
+type DomainScoreResponse struct {
+	Domain    string `json:"domain"`
+	Score     int    `json:"score"`
+	MaxScore  int    `json:"max_score"`
+	RiskLevel string `json:"risk_level"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+type ListQueryRequest struct {
+	Page      int    `form:"page" validate:"omitempty,min=1"`
+	PageSize  int    `form:"page_size" validate:"omitempty,min=1,max=100"`
+	Cursor    string `form:"cursor" validate:"omitempty,max=512"`
+	SortBy    string `form:"sort_by" validate:"omitempty,max=50"`
+	SortOrder string `form:"sort_order" validate:"omitempty,oneof=asc desc"`
+	Search    string `form:"search" validate:"omitempty,max=100"`
+	DateFrom  string `form:"date_from" validate:"omitempty,datetime=2006-01-02"`
+	DateTo    string `form:"date_to" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type PaginationMeta struct {
+	Page       int    `json:"page,omitempty"`
+	PageSize   int    `json:"page_size"`
+	TotalItems int64  `json:"total_items"`
+	TotalPages int    `json:"total_pages"`
+	HasNext    bool   `json:"has_next"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,73 @@
+package dto
+
+import "backend-golang/internal/helpers"
+
+type ParentProfileResponse struct {
+	ParentId           string                 `json:"parent_id"`
+	Username           string                 `json:"username"`
+	Email              string                 `json:"email"`
+	RegistrationStatus string                 `json:"registration_status"`
+	ParentDetails      []ParentDetailResponse `json:"parent_details"`
+}
+
+type ParentDetailResponse struct {
+	ParentDetailId string `json:"parent_detail_id"`
+	ParentType     string `json:"parent_type"`
+	ParentName     string `json:"parent_name"`
+	ParentPhone    string `json:"parent_phone"`
+	IsPrimary      bool   `json:"is_primary"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+}
+
+type ParentDetailCreateRequest struct {
+	ParentType  string `json:"parent_type" validate:"required,oneof=Ayah Ibu Wali"`
+	ParentName  string `json:"parent_name" validate:"required,min=3,max=100"`
+	ParentPhone string `json:"parent_phone" validate:"required,min=3,max=100"`
+	IsPrimary   bool   `json:"is_primary"`
+}
+
+type ParentDetailUpdateRequest struct {
+	ParentType  string `json:"parent_type" validate:"omitempty,oneof=Ayah Ibu Wali"`
+	ParentName  string `json:"parent_name" validate:"omitempty,min=3,max=100"`
+	ParentPhone string `json:"parent_phone" validate:"omitempty,min=3,max=100"`
+}
+
+type ParentChildResponse struct {
+	ChildId           string           `json:"child_id"`
+	ChildName         string           `json:"child_name"`
+	ChildGender       string           `json:"child_gender"`
+	ChildBirthDate    helpers.DateOnly `json:"child_birth_date"`
+	ChildAge          int              `json:"child_age"`
+	ChildSchool       *string          `json:"child_school"`
+	ObservationId     int              `json:"observation_id"`
+	ObservationStatus string           `json:"observation_status"`
+	ScheduledDate     helpers.DateOnly `json:"scheduled_date"`
+	ObservationCount  int              `json:"observation_count"`
+}
+
+type ParentChildDetailResponse struct {
+	ChildId            string           `json:"child_id"`
+	ChildName          string           `json:"child_name"`
+	ChildGender        string           `json:"child_gender"`
+	ChildBirthPlace    string           `json:"child_birth_place"`
+	ChildBirthDate     helpers.DateOnly `json:"child_birth_date"`
+	ChildAge           int              `json:"child_age"`
+	ChildSchool        *string          `json:"child_school"`
+	ChildAddress       string           `json:"child_address"`
+	ChildComplaint     string           `json:"child_complaint"`
+	ChildServiceChoice string           `json:"child_service_choice"`
+
+	Observation  *ParentObservationResponse   `json:"observation"`
+	Observations []*ParentObservationResponse `json:"observations"`
+}
+
+type ParentObservationResponse struct {
+	ObservationId  int              `json:"observation_id"`
+	AgeCategory    string           `json:"age_category"`
+	ScheduledDate  helpers.DateOnly `json:"scheduled_date"`
+	Status         string           `json:"status"`
+	TotalScore     *int             `json:"total_score,omitempty"`
+	Conclusion     string           `json:"conclusion,omitempty"`
+	Recommendation string           `json:"recommendation,omitempty"`
+}
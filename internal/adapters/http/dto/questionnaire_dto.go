@@ -0,0 +1,88 @@
+package dto
+
+import "time"
+
+type QuestionnaireVersionCreateRequest struct {
+	Name              string `json:"name" validate:"required,max=100"`
+	CopyFromVersionId *int   `json:"copy_from_version_id" validate:"omitempty,min=1"`
+}
+
+type QuestionnaireVersionResponse struct {
+	VersionId   int        `json:"version_id"`
+	Version     int        `json:"version"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	PublishedAt *time.Time `json:"published_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type QuestionnaireVersionDetailResponse struct {
+	QuestionnaireVersionResponse
+	Questions []*QuestionnaireQuestionResponse `json:"questions"`
+}
+
+type QuestionnaireQuestionRequest struct {
+	QuestionCode   string `json:"question_code" validate:"required,max=10"`
+	AgeCategory    string `json:"age_category" validate:"required,max=20"`
+	Domain         string `json:"domain" validate:"required,max=5"`
+	QuestionNumber int    `json:"question_number" validate:"required,min=1"`
+	QuestionText   string `json:"question_text" validate:"required"`
+	Score          int    `json:"score" validate:"required,min=1,max=3"`
+	IsActive       *bool  `json:"is_active"`
+}
+
+type QuestionnaireQuestionResponse struct {
+	QuestionId     int    `json:"question_id"`
+	QuestionCode   string `json:"question_code"`
+	AgeCategory    string `json:"age_category"`
+	Domain         string `json:"domain"`
+	QuestionNumber int    `json:"question_number"`
+	QuestionText   string `json:"question_text"`
+	Score          int    `json:"score"`
+	IsActive       bool   `json:"is_active"`
+}
+
+type ObservationDomainCreateRequest struct {
+	Code           string `json:"code" validate:"required,max=5,alpha,uppercase"`
+	Name           string `json:"name" validate:"required,max=100"`
+	TherapySection string `json:"therapy_section" validate:"required,oneof=Okupasi Fisio Wicara Paedagog"`
+	SortOrder      int    `json:"sort_order" validate:"min=0"`
+}
+
+type ObservationDomainUpdateRequest struct {
+	Name           string `json:"name" validate:"required,max=100"`
+	TherapySection string `json:"therapy_section" validate:"required,oneof=Okupasi Fisio Wicara Paedagog"`
+	SortOrder      int    `json:"sort_order" validate:"min=0"`
+	IsActive       *bool  `json:"is_active" validate:"required"`
+}
+
+type ObservationDomainResponse struct {
+	Code           string `json:"code"`
+	Name           string `json:"name"`
+	TherapySection string `json:"therapy_section"`
+	SortOrder      int    `json:"sort_order"`
+	IsActive       bool   `json:"is_active"`
+}
+
+type AgeCategoryCreateRequest struct {
+	Name      string `json:"name" validate:"required,max=20"`
+	MinAge    *int   `json:"min_age" validate:"required,min=0"`
+	MaxAge    *int   `json:"max_age" validate:"omitempty,min=0"`
+	SortOrder int    `json:"sort_order" validate:"min=0"`
+}
+
+type AgeCategoryUpdateRequest struct {
+	MinAge    *int  `json:"min_age" validate:"required,min=0"`
+	MaxAge    *int  `json:"max_age" validate:"omitempty,min=0"`
+	SortOrder int   `json:"sort_order" validate:"min=0"`
+	IsActive  *bool `json:"is_active" validate:"required"`
+}
+
+type AgeCategoryResponse struct {
+	AgeCategoryId int    `json:"age_category_id"`
+	Name          string `json:"name"`
+	MinAge        int    `json:"min_age"`
+	MaxAge        *int   `json:"max_age"`
+	SortOrder     int    `json:"sort_order"`
+	IsActive      bool   `json:"is_active"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+type ReportFileResponse struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+	Checksum    string
+}
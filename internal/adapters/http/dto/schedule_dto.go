@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"backend-golang/internal/helpers"
+	"time"
+)
+
+type WorkingHourInput struct {
+	DayOfWeek   *int    `json:"day_of_week" validate:"required,min=0,max=6"`
+	StartTime   string  `json:"start_time" validate:"required,datetime=15:04"`
+	EndTime     string  `json:"end_time" validate:"required,datetime=15:04"`
+	SlotMinutes int     `json:"slot_minutes" validate:"required,min=15,max=240"`
+	AgeCategory *string `json:"age_category" validate:"omitempty,max=20"`
+}
+
+type WorkingHoursUpdateRequest struct {
+	WorkingHours []WorkingHourInput `json:"working_hours" validate:"max=50,dive"`
+}
+
+type WorkingHourResponse struct {
+	WorkingHourId string  `json:"working_hour_id"`
+	DayOfWeek     int     `json:"day_of_week"`
+	StartTime     string  `json:"start_time"`
+	EndTime       string  `json:"end_time"`
+	SlotMinutes   int     `json:"slot_minutes"`
+	AgeCategory   *string `json:"age_category"`
+}
+
+type TimeOffCreateRequest struct {
+	StartAt time.Time `json:"start_at" validate:"required"`
+	EndAt   time.Time `json:"end_at" validate:"required"`
+	Reason  string    `json:"reason" validate:"omitempty,max=255"`
+}
+
+type TimeOffResponse struct {
+	TimeOffId   string    `json:"time_off_id"`
+	TherapistId string    `json:"therapist_id"`
+	StartAt     time.Time `json:"start_at"`
+	EndAt       time.Time `json:"end_at"`
+	Reason      string    `json:"reason"`
+}
+
+type AvailableSlotRequest struct {
+	AgeCategory      string `form:"age_category" validate:"required_without=TherapistSection,omitempty,max=20"`
+	TherapistSection string `form:"therapist_section" validate:"omitempty,oneof=Okupasi Fisio Wicara Paedagog"`
+	DateFrom         string `form:"date_from" validate:"omitempty,datetime=2006-01-02"`
+	Days             int    `form:"days" validate:"omitempty,min=1,max=31"`
+	Limit            int    `form:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+type AvailableSlotResponse struct {
+	TherapistId      string           `json:"therapist_id"`
+	TherapistName    string           `json:"therapist_name"`
+	TherapistSection string           `json:"therapist_section"`
+	Date             helpers.DateOnly `json:"date"`
+	StartTime        string           `json:"start_time"`
+	StartAt          time.Time        `json:"start_at"`
+	EndAt            time.Time        `json:"end_at"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "time"
+
+type ScoringThresholdInput struct {
+	AgeCategory   string `json:"age_category" validate:"required,max=20"`
+	Domain        string `json:"domain" validate:"required,max=5"`
+	ModerateScore int    `json:"moderate_score" validate:"required,min=1"`
+	HighScore     int    `json:"high_score" validate:"required,min=1"`
+}
+
+type ScoringThresholdsUpdateRequest struct {
+	Thresholds []ScoringThresholdInput `json:"thresholds" validate:"required,min=1,dive"`
+}
+
+type ScoringThresholdQuery struct {
+	AgeCategory string `form:"age_category" validate:"omitempty,max=20"`
+}
+
+type ScoringThresholdResponse struct {
+	AgeCategory   string    `json:"age_category"`
+	Domain        string    `json:"domain"`
+	ModerateScore int       `json:"moderate_score"`
+	HighScore     int       `json:"high_score"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+type SearchRequest struct {
+	Query string `form:"q" validate:"required,min=2,max=100"`
+	Limit int    `form:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+type SearchResponse struct {
+	Query      string                   `json:"query"`
+	Children   []*SearchChildResult     `json:"children"`
+	Parents    []*SearchParentResult    `json:"parents"`
+	Therapists []*SearchTherapistResult `json:"therapists"`
+}
+
+type SearchChildResult struct {
+	ChildId     string  `json:"child_id"`
+	ChildName   string  `json:"child_name"`
+	ChildSchool *string `json:"child_school"`
+	ParentName  string  `json:"parent_name"`
+	Score       float64 `json:"score"`
+}
+
+type SearchParentResult struct {
+	ParentId           string   `json:"parent_id"`
+	ParentName         string   `json:"parent_name"`
+	Email              string   `json:"email"`
+	RegistrationStatus string   `json:"registration_status"`
+	ChildNames         []string `json:"child_names"`
+	Score              float64  `json:"score"`
+}
+
+type SearchTherapistResult struct {
+	TherapistId      string  `json:"therapist_id"`
+	TherapistName    string  `json:"therapist_name"`
+	TherapistSection string  `json:"therapist_section"`
+	Email            string  `json:"email"`
+	Score            float64 `json:"score"`
+}
@@ -56,7 +56,13 @@ func (h AdminHandler) CreateAdmin(c *gin.Context) {
 }
 
 func (h AdminHandler) FindAdmins(c *gin.Context) {
-	admins, err := h.FindAdminsUC.Execute(c.Request.Context())
+	req := dto.ListQueryRequest{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	admins, meta, err := h.FindAdminsUC.Execute(c.Request.Context(), &req)
 	if err != nil {
 		middlewares.AbortWithError(c, err)
 		return
@@ -66,6 +72,7 @@ func (h AdminHandler) FindAdmins(c *gin.Context) {
 		Success: true,
 		Message: "List of admins",
 		Data:    admins,
+		Meta:    meta,
 	})
 }
 
@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/assessment"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AssessmentHandler struct {
+	CreateInstrumentUC     assessment.CreateInstrumentUseCase
+	FindInstrumentsUC      assessment.FindInstrumentsUseCase
+	UpdateInstrumentUC     assessment.UpdateInstrumentUseCase
+	OpenAssessmentUC       assessment.OpenAssessmentUseCase
+	FindAssessmentsUC      assessment.FindAssessmentsUseCase
+	FindAssessmentDetailUC assessment.FindAssessmentDetailUseCase
+	ReassignAssessmentUC   assessment.ReassignAssessmentUseCase
+	CancelAssessmentUC     assessment.CancelAssessmentUseCase
+	SaveScoresUC           assessment.SaveAssessmentScoresUseCase
+	CompleteAssessmentUC   assessment.CompleteAssessmentUseCase
+}
+
+func NewAssessmentHandler(
+	createInstrumentUC assessment.CreateInstrumentUseCase,
+	findInstrumentsUC assessment.FindInstrumentsUseCase,
+	updateInstrumentUC assessment.UpdateInstrumentUseCase,
+	openAssessmentUC assessment.OpenAssessmentUseCase,
+	findAssessmentsUC assessment.FindAssessmentsUseCase,
+	findAssessmentDetailUC assessment.FindAssessmentDetailUseCase,
+	reassignAssessmentUC assessment.ReassignAssessmentUseCase,
+	cancelAssessmentUC assessment.CancelAssessmentUseCase,
+	saveScoresUC assessment.SaveAssessmentScoresUseCase,
+	completeAssessmentUC assessment.CompleteAssessmentUseCase,
+) *AssessmentHandler {
+	return &AssessmentHandler{
+		CreateInstrumentUC:     createInstrumentUC,
+		FindInstrumentsUC:      findInstrumentsUC,
+		UpdateInstrumentUC:     updateInstrumentUC,
+		OpenAssessmentUC:       openAssessmentUC,
+		FindAssessmentsUC:      findAssessmentsUC,
+		FindAssessmentDetailUC: findAssessmentDetailUC,
+		ReassignAssessmentUC:   reassignAssessmentUC,
+		CancelAssessmentUC:     cancelAssessmentUC,
+		SaveScoresUC:           saveScoresUC,
+		CompleteAssessmentUC:   completeAssessmentUC,
+	}
+}
+
+func (h *AssessmentHandler) CreateInstrument(c *gin.Context) {
+	req := dto.AssessmentInstrumentCreateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.CreateInstrumentUC.Execute(c.Request.Context(), &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Assessment instrument created successfully",
+		Data:    nil,
+	})
+}
+
+func (h *AssessmentHandler) FindInstruments(c *gin.Context) {
+	req := dto.AssessmentInstrumentQuery{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	instruments, err := h.FindInstrumentsUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of assessment instruments",
+		Data:    instruments,
+	})
+}
+
+func (h *AssessmentHandler) UpdateInstrument(c *gin.Context) {
+	instrumentId, err := strconv.Atoi(c.Param("instrument_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid instrument ID",
+		})
+		return
+	}
+
+	req := dto.AssessmentInstrumentUpdateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.UpdateInstrumentUC.Execute(c.Request.Context(), instrumentId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Assessment instrument updated successfully",
+		Data:    nil,
+	})
+}
+
+func (h *AssessmentHandler) OpenAssessment(c *gin.Context) {
+	req := dto.OpenAssessmentRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.OpenAssessmentUC.Execute(c.Request.Context(), &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Assessment opened successfully",
+		Data:    nil,
+	})
+}
+
+func (h *AssessmentHandler) FindAssessments(c *gin.Context) {
+	req := dto.AssessmentListQuery{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	assessments, meta, err := h.FindAssessmentsUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of assessments",
+		Data:    assessments,
+		Meta:    meta,
+	})
+}
+
+func (h *AssessmentHandler) FindAssessmentDetail(c *gin.Context) {
+	assessmentId, ok := assessmentParam(c)
+	if !ok {
+		return
+	}
+
+	detail, err := h.FindAssessmentDetailUC.Execute(c.Request.Context(), assessmentId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Assessment Detail Retrieved",
+		Data:    detail,
+	})
+}
+
+func (h *AssessmentHandler) ReassignAssessment(c *gin.Context) {
+	assessmentId, ok := assessmentParam(c)
+	if !ok {
+		return
+	}
+
+	req := dto.ReassignAssessmentRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.ReassignAssessmentUC.Execute(c.Request.Context(), assessmentId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Assessor updated successfully",
+		Data:    nil,
+	})
+}
+
+func (h *AssessmentHandler) CancelAssessment(c *gin.Context) {
+	assessmentId, ok := assessmentParam(c)
+	if !ok {
+		return
+	}
+
+	req := dto.CancelAssessmentRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.CancelAssessmentUC.Execute(c.Request.Context(), assessmentId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Assessment cancelled successfully",
+		Data:    nil,
+	})
+}
+
+func (h *AssessmentHandler) SaveAssessmentScores(c *gin.Context) {
+	assessmentId, ok := assessmentParam(c)
+	if !ok {
+		return
+	}
+
+	req := dto.SaveAssessmentScoresRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.SaveScoresUC.Execute(c.Request.Context(), assessmentId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Assessment scores saved successfully",
+		Data:    nil,
+	})
+}
+
+func (h *AssessmentHandler) CompleteAssessment(c *gin.Context) {
+	assessmentId, ok := assessmentParam(c)
+	if !ok {
+		return
+	}
+
+	req := dto.CompleteAssessmentRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.CompleteAssessmentUC.Execute(c.Request.Context(), assessmentId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Assessment completed successfully",
+		Data:    nil,
+	})
+}
+
+func assessmentParam(c *gin.Context) (int, bool) {
+	assessmentId, err := strconv.Atoi(c.Param("assessment_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid assessment ID",
+		})
+		return 0, false
+	}
+
+	return assessmentId, true
+}
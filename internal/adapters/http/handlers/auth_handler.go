@@ -6,6 +6,7 @@ import (
 	"backend-golang/internal/adapters/http/types"
 	"backend-golang/internal/usecases/auth"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -180,12 +181,24 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	req.UserAgent = c.Request.UserAgent()
+	req.IpAddress = c.ClientIP()
+
 	userLogin, err := h.LoginUC.Execute(c.Request.Context(), &req)
 	if err != nil {
 		middlewares.AbortWithError(c, err)
 		return
 	}
 
+	if userLogin.MfaRequired {
+		c.JSON(http.StatusOK, types.SuccessResponse{
+			Success: true,
+			Message: "MFA verification required",
+			Data:    userLogin,
+		})
+		return
+	}
+
 	c.SetCookie(
 		"refresh_token",
 		userLogin.RefreshToken,
@@ -211,12 +224,25 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 		return
 	}
 
+	req.UserAgent = c.Request.UserAgent()
+	req.IpAddress = c.ClientIP()
+
 	var refreshToken, err = h.RefreshTokenUC.Execute(c.Request.Context(), &req)
 	if err != nil {
 		middlewares.AbortWithError(c, err)
 		return
 	}
 
+	c.SetCookie(
+		"refresh_token",
+		refreshToken.RefreshToken,
+		3600*24*7,
+		"/",
+		"",
+		true,
+		true,
+	)
+
 	c.JSON(http.StatusOK, types.SuccessResponse{
 		Success: true,
 		Message: "New Access Token Generated Successfully",
@@ -231,7 +257,9 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 		return
 	}
 
-	if err := h.LogoutUC.Execute(c.Request.Context(), refreshToken); err != nil {
+	accessToken := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+
+	if err := h.LogoutUC.Execute(c.Request.Context(), refreshToken, accessToken); err != nil {
 		middlewares.AbortWithError(c, err)
 		return
 	}
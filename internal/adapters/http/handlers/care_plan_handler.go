@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/careplan"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CarePlanHandler struct {
+	CreateGoalUC         careplan.CreateGoalUseCase
+	UpdateGoalUC         careplan.UpdateGoalUseCase
+	FindGoalAttainmentUC careplan.FindGoalAttainmentUseCase
+}
+
+func NewCarePlanHandler(
+	createGoalUC careplan.CreateGoalUseCase,
+	updateGoalUC careplan.UpdateGoalUseCase,
+	findGoalAttainmentUC careplan.FindGoalAttainmentUseCase,
+) *CarePlanHandler {
+	return &CarePlanHandler{
+		CreateGoalUC:         createGoalUC,
+		UpdateGoalUC:         updateGoalUC,
+		FindGoalAttainmentUC: findGoalAttainmentUC,
+	}
+}
+
+func (h *CarePlanHandler) CreateGoal(c *gin.Context) {
+	childId := c.Param("child_id")
+
+	req := dto.CarePlanGoalCreateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.CreateGoalUC.Execute(c.Request.Context(), childId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Care plan goal created successfully",
+		Data:    nil,
+	})
+}
+
+func (h *CarePlanHandler) UpdateGoal(c *gin.Context) {
+	goalId, err := strconv.Atoi(c.Param("goal_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid goal ID",
+		})
+		return
+	}
+
+	req := dto.CarePlanGoalUpdateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.UpdateGoalUC.Execute(c.Request.Context(), goalId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Care plan goal updated successfully",
+		Data:    nil,
+	})
+}
+
+func (h *CarePlanHandler) FindGoalAttainment(c *gin.Context) {
+	childId := c.Param("child_id")
+
+	attainment, err := h.FindGoalAttainmentUC.Execute(c.Request.Context(), childId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Care Plan Goal Attainment Retrieved",
+		Data:    attainment,
+	})
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/conference"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CaseConferenceHandler struct {
+	CreateConferenceUC     conference.CreateConferenceUseCase
+	FindConferencesUC      conference.FindConferencesUseCase
+	FindConferenceDetailUC conference.FindConferenceDetailUseCase
+	UpdateConferenceUC     conference.UpdateConferenceUseCase
+}
+
+func NewCaseConferenceHandler(
+	createConferenceUC conference.CreateConferenceUseCase,
+	findConferencesUC conference.FindConferencesUseCase,
+	findConferenceDetailUC conference.FindConferenceDetailUseCase,
+	updateConferenceUC conference.UpdateConferenceUseCase,
+) *CaseConferenceHandler {
+	return &CaseConferenceHandler{
+		CreateConferenceUC:     createConferenceUC,
+		FindConferencesUC:      findConferencesUC,
+		FindConferenceDetailUC: findConferenceDetailUC,
+		UpdateConferenceUC:     updateConferenceUC,
+	}
+}
+
+func (h *CaseConferenceHandler) CreateConference(c *gin.Context) {
+	req := dto.CaseConferenceCreateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.CreateConferenceUC.Execute(c.Request.Context(), &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Case conference created successfully",
+		Data:    nil,
+	})
+}
+
+func (h *CaseConferenceHandler) FindConferences(c *gin.Context) {
+	req := dto.CaseConferenceListQuery{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	conferences, meta, err := h.FindConferencesUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of case conferences",
+		Data:    conferences,
+		Meta:    meta,
+	})
+}
+
+func (h *CaseConferenceHandler) FindConferenceDetail(c *gin.Context) {
+	conferenceId, ok := conferenceParam(c)
+	if !ok {
+		return
+	}
+
+	detail, err := h.FindConferenceDetailUC.Execute(c.Request.Context(), conferenceId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Case Conference Detail Retrieved",
+		Data:    detail,
+	})
+}
+
+func (h *CaseConferenceHandler) UpdateConference(c *gin.Context) {
+	conferenceId, ok := conferenceParam(c)
+	if !ok {
+		return
+	}
+
+	req := dto.CaseConferenceUpdateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.UpdateConferenceUC.Execute(c.Request.Context(), conferenceId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Case conference updated successfully",
+		Data:    nil,
+	})
+}
+
+func conferenceParam(c *gin.Context) (int, bool) {
+	conferenceId, err := strconv.Atoi(c.Param("conference_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid case conference ID",
+		})
+		return 0, false
+	}
+
+	return conferenceId, true
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"backend-golang/internal/adapters/http/dto"
 	"backend-golang/internal/adapters/http/middlewares"
 	"backend-golang/internal/adapters/http/types"
 	"backend-golang/internal/usecases/child"
@@ -10,20 +11,34 @@ import (
 )
 
 type ChildHandler struct {
-	FindChildsUC child.FindChildUseCase
+	FindChildsUC             child.FindChildUseCase
+	CreateChildObservationUC child.CreateChildObservationUseCase
+	FindChildProgressUC      child.FindChildProgressUseCase
+	FindChildCarePlanUC      child.FindChildCarePlanUseCase
 }
 
 func NewChildHandler(
 	findUC child.FindChildUseCase,
-
+	createChildObservationUC child.CreateChildObservationUseCase,
+	findChildProgressUC child.FindChildProgressUseCase,
+	findChildCarePlanUC child.FindChildCarePlanUseCase,
 ) *ChildHandler {
 	return &ChildHandler{
-		FindChildsUC: findUC,
+		FindChildsUC:             findUC,
+		CreateChildObservationUC: createChildObservationUC,
+		FindChildProgressUC:      findChildProgressUC,
+		FindChildCarePlanUC:      findChildCarePlanUC,
 	}
 }
 
 func (h ChildHandler) FindChilds(c *gin.Context) {
-	childs, err := h.FindChildsUC.Execute(c.Request.Context())
+	req := dto.ListQueryRequest{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	childs, meta, err := h.FindChildsUC.Execute(c.Request.Context(), &req)
 	if err != nil {
 		middlewares.AbortWithError(c, err)
 		return
@@ -33,5 +48,53 @@ func (h ChildHandler) FindChilds(c *gin.Context) {
 		Success: true,
 		Message: "List of childs",
 		Data:    childs,
+		Meta:    meta,
+	})
+}
+
+func (h ChildHandler) CreateChildObservation(c *gin.Context) {
+	childId := c.Param("child_id")
+
+	if err := h.CreateChildObservationUC.Execute(c.Request.Context(), childId); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Observation Created",
+		Data:    nil,
+	})
+}
+
+func (h ChildHandler) FindChildProgress(c *gin.Context) {
+	childId := c.Param("child_id")
+
+	progress, err := h.FindChildProgressUC.Execute(c.Request.Context(), childId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Child Progress Retrieved",
+		Data:    progress,
+	})
+}
+
+func (h ChildHandler) FindChildCarePlan(c *gin.Context) {
+	childId := c.Param("child_id")
+
+	carePlan, err := h.FindChildCarePlanUC.Execute(c.Request.Context(), childId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Child Care Plan Retrieved",
+		Data:    carePlan,
 	})
 }
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/evaluation"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EvaluationHandler struct {
+	PrepareEvaluationUC      evaluation.PrepareEvaluationUseCase
+	CreateEvaluationUC       evaluation.CreateEvaluationUseCase
+	FindChildEvaluationsUC   evaluation.FindChildEvaluationsUseCase
+	FindEvaluationDetailUC   evaluation.FindEvaluationDetailUseCase
+	FindOverdueEvaluationsUC evaluation.FindOverdueEvaluationsUseCase
+}
+
+func NewEvaluationHandler(
+	prepareEvaluationUC evaluation.PrepareEvaluationUseCase,
+	createEvaluationUC evaluation.CreateEvaluationUseCase,
+	findChildEvaluationsUC evaluation.FindChildEvaluationsUseCase,
+	findEvaluationDetailUC evaluation.FindEvaluationDetailUseCase,
+	findOverdueEvaluationsUC evaluation.FindOverdueEvaluationsUseCase,
+) *EvaluationHandler {
+	return &EvaluationHandler{
+		PrepareEvaluationUC:      prepareEvaluationUC,
+		CreateEvaluationUC:       createEvaluationUC,
+		FindChildEvaluationsUC:   findChildEvaluationsUC,
+		FindEvaluationDetailUC:   findEvaluationDetailUC,
+		FindOverdueEvaluationsUC: findOverdueEvaluationsUC,
+	}
+}
+
+func (h *EvaluationHandler) PrepareEvaluation(c *gin.Context) {
+	childId := c.Param("child_id")
+
+	draft, err := h.PrepareEvaluationUC.Execute(c.Request.Context(), childId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Evaluation Draft Retrieved",
+		Data:    draft,
+	})
+}
+
+func (h *EvaluationHandler) CreateEvaluation(c *gin.Context) {
+	childId := c.Param("child_id")
+
+	req := dto.EvaluationCreateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.CreateEvaluationUC.Execute(c.Request.Context(), childId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Evaluation recorded successfully",
+		Data:    nil,
+	})
+}
+
+func (h *EvaluationHandler) FindChildEvaluations(c *gin.Context) {
+	childId := c.Param("child_id")
+
+	evaluations, err := h.FindChildEvaluationsUC.Execute(c.Request.Context(), childId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of evaluations",
+		Data:    evaluations,
+	})
+}
+
+func (h *EvaluationHandler) FindEvaluationDetail(c *gin.Context) {
+	evaluationId, err := strconv.Atoi(c.Param("evaluation_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid evaluation ID",
+		})
+		return
+	}
+
+	evaluation, err := h.FindEvaluationDetailUC.Execute(c.Request.Context(), evaluationId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Evaluation Detail Retrieved",
+		Data:    evaluation,
+	})
+}
+
+func (h *EvaluationHandler) FindOverdueEvaluations(c *gin.Context) {
+	req := dto.ListQueryRequest{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	evaluations, meta, err := h.FindOverdueEvaluationsUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of overdue evaluations",
+		Data:    evaluations,
+		Meta:    meta,
+	})
+}
@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/intervention"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InterventionHandler struct {
+	CreatePlanUC           intervention.CreatePlanUseCase
+	FindPlansUC            intervention.FindPlansUseCase
+	UpdatePlanUC           intervention.UpdatePlanUseCase
+	FindTodaySessionsUC    intervention.FindTodaySessionsUseCase
+	RecordSessionUC        intervention.RecordSessionUseCase
+	FindSessionDetailUC    intervention.FindSessionDetailUseCase
+	FindAttendanceReportUC intervention.FindAttendanceReportUseCase
+}
+
+func NewInterventionHandler(
+	createPlanUC intervention.CreatePlanUseCase,
+	findPlansUC intervention.FindPlansUseCase,
+	updatePlanUC intervention.UpdatePlanUseCase,
+	findTodaySessionsUC intervention.FindTodaySessionsUseCase,
+	recordSessionUC intervention.RecordSessionUseCase,
+	findSessionDetailUC intervention.FindSessionDetailUseCase,
+	findAttendanceReportUC intervention.FindAttendanceReportUseCase,
+) *InterventionHandler {
+	return &InterventionHandler{
+		CreatePlanUC:           createPlanUC,
+		FindPlansUC:            findPlansUC,
+		UpdatePlanUC:           updatePlanUC,
+		FindTodaySessionsUC:    findTodaySessionsUC,
+		RecordSessionUC:        recordSessionUC,
+		FindSessionDetailUC:    findSessionDetailUC,
+		FindAttendanceReportUC: findAttendanceReportUC,
+	}
+}
+
+func (h *InterventionHandler) CreatePlan(c *gin.Context) {
+	req := dto.InterventionPlanCreateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.CreatePlanUC.Execute(c.Request.Context(), &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Intervention plan created successfully",
+		Data:    nil,
+	})
+}
+
+func (h *InterventionHandler) FindPlans(c *gin.Context) {
+	req := dto.InterventionPlanListQuery{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	plans, meta, err := h.FindPlansUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of intervention plans",
+		Data:    plans,
+		Meta:    meta,
+	})
+}
+
+func (h *InterventionHandler) UpdatePlan(c *gin.Context) {
+	planId, ok := interventionPlanParam(c)
+	if !ok {
+		return
+	}
+
+	req := dto.InterventionPlanUpdateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.UpdatePlanUC.Execute(c.Request.Context(), planId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Intervention plan updated successfully",
+		Data:    nil,
+	})
+}
+
+func (h *InterventionHandler) FindTodaySessions(c *gin.Context) {
+	sessions, err := h.FindTodaySessionsUC.Execute(c.Request.Context())
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of today's sessions",
+		Data:    sessions,
+	})
+}
+
+func (h *InterventionHandler) RecordSession(c *gin.Context) {
+	req := dto.RecordSessionRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.RecordSessionUC.Execute(c.Request.Context(), &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Intervention session recorded successfully",
+		Data:    nil,
+	})
+}
+
+func (h *InterventionHandler) FindSessionDetail(c *gin.Context) {
+	sessionId, ok := interventionSessionParam(c)
+	if !ok {
+		return
+	}
+
+	detail, err := h.FindSessionDetailUC.Execute(c.Request.Context(), sessionId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Intervention Session Detail Retrieved",
+		Data:    detail,
+	})
+}
+
+func (h *InterventionHandler) FindAttendanceReport(c *gin.Context) {
+	req := dto.AttendanceReportQuery{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	report, err := h.FindAttendanceReportUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Attendance report retrieved",
+		Data:    report,
+	})
+}
+
+func interventionPlanParam(c *gin.Context) (int, bool) {
+	planId, err := strconv.Atoi(c.Param("plan_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid intervention plan ID",
+		})
+		return 0, false
+	}
+
+	return planId, true
+}
+
+func interventionSessionParam(c *gin.Context) (int, bool) {
+	sessionId, err := strconv.Atoi(c.Param("session_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid intervention session ID",
+		})
+		return 0, false
+	}
+
+	return sessionId, true
+}
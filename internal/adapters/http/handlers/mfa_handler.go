@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/auth"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MfaHandler struct {
+	VerifyMfaLoginUC          auth.VerifyMfaLoginUseCase
+	FindMfaStatusUC           auth.FindMfaStatusUseCase
+	StartMfaEnrollmentUC      auth.StartMfaEnrollmentUseCase
+	ConfirmMfaEnrollmentUC    auth.ConfirmMfaEnrollmentUseCase
+	RegenerateRecoveryCodesUC auth.RegenerateRecoveryCodesUseCase
+	DisableMfaUC              auth.DisableMfaUseCase
+}
+
+func NewMfaHandler(
+	verifyMfaLoginUC auth.VerifyMfaLoginUseCase,
+	findMfaStatusUC auth.FindMfaStatusUseCase,
+	startMfaEnrollmentUC auth.StartMfaEnrollmentUseCase,
+	confirmMfaEnrollmentUC auth.ConfirmMfaEnrollmentUseCase,
+	regenerateRecoveryCodesUC auth.RegenerateRecoveryCodesUseCase,
+	disableMfaUC auth.DisableMfaUseCase,
+) *MfaHandler {
+	return &MfaHandler{
+		VerifyMfaLoginUC:          verifyMfaLoginUC,
+		FindMfaStatusUC:           findMfaStatusUC,
+		StartMfaEnrollmentUC:      startMfaEnrollmentUC,
+		ConfirmMfaEnrollmentUC:    confirmMfaEnrollmentUC,
+		RegenerateRecoveryCodesUC: regenerateRecoveryCodesUC,
+		DisableMfaUC:              disableMfaUC,
+	}
+}
+
+func (h *MfaHandler) VerifyMfaLogin(c *gin.Context) {
+	req := dto.MfaLoginRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	req.UserAgent = c.Request.UserAgent()
+	req.IpAddress = c.ClientIP()
+
+	userLogin, err := h.VerifyMfaLoginUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.SetCookie(
+		"refresh_token",
+		userLogin.RefreshToken,
+		3600*24*7,
+		"/",
+		"",
+		true,
+		true,
+	)
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Login Success",
+		Data:    userLogin,
+	})
+}
+
+func (h *MfaHandler) FindMfaStatus(c *gin.Context) {
+	status, err := h.FindMfaStatusUC.Execute(c.Request.Context())
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "MFA status",
+		Data:    status,
+	})
+}
+
+func (h *MfaHandler) StartMfaEnrollment(c *gin.Context) {
+	enrollment, err := h.StartMfaEnrollmentUC.Execute(c.Request.Context())
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "MFA enrollment started, confirm it with a code from your authenticator app",
+		Data:    enrollment,
+	})
+}
+
+func (h *MfaHandler) ConfirmMfaEnrollment(c *gin.Context) {
+	req := dto.MfaCodeRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	recoveryCodes, err := h.ConfirmMfaEnrollmentUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "MFA enabled successfully",
+		Data:    recoveryCodes,
+	})
+}
+
+func (h *MfaHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	req := dto.MfaCodeRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	recoveryCodes, err := h.RegenerateRecoveryCodesUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Recovery codes regenerated successfully",
+		Data:    recoveryCodes,
+	})
+}
+
+func (h *MfaHandler) DisableMfa(c *gin.Context) {
+	req := dto.MfaCodeRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.DisableMfaUC.Execute(c.Request.Context(), &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "MFA disabled successfully",
+		Data:    nil,
+	})
+}
@@ -17,8 +17,15 @@ type ObservationHandler struct {
 	FindCompleteObservationsUC  observation.FindCompletedObservationsUseCase
 	FindObservationDetailUC     observation.FindObservationDetailUseCase
 	UpdateObservationDateUC     observation.UpdateObservationDateUseCase
+	UpdateObservationStatusUC   observation.UpdateObservationStatusUseCase
 	ObservationQuestionsUC      observation.QuestionsUseCase
+	PreviewObservationScoreUC   observation.PreviewObservationScoreUseCase
 	SubmitObservationUC         observation.SubmitObservationUseCase
+	FindObservationDraftUC      observation.FindObservationDraftUseCase
+	SaveObservationDraftUC      observation.SaveObservationDraftUseCase
+	FinaliseObservationUC       observation.FinaliseObservationUseCase
+	AmendObservationUC          observation.AmendObservationUseCase
+	FindObservationAnswersUC    observation.FindObservationAnswersUseCase
 }
 
 func NewObservationHandler(
@@ -27,8 +34,15 @@ func NewObservationHandler(
 	findCompleteUC observation.FindCompletedObservationsUseCase,
 	findDetailUC observation.FindObservationDetailUseCase,
 	updateObservationDateUC observation.UpdateObservationDateUseCase,
+	updateObservationStatusUC observation.UpdateObservationStatusUseCase,
 	observationQuestionsUC observation.QuestionsUseCase,
+	previewObservationScoreUC observation.PreviewObservationScoreUseCase,
 	submitObservationUC observation.SubmitObservationUseCase,
+	findObservationDraftUC observation.FindObservationDraftUseCase,
+	saveObservationDraftUC observation.SaveObservationDraftUseCase,
+	finaliseObservationUC observation.FinaliseObservationUseCase,
+	amendObservationUC observation.AmendObservationUseCase,
+	findObservationAnswersUC observation.FindObservationAnswersUseCase,
 ) *ObservationHandler {
 	return &ObservationHandler{
 		FindPendingObservationsUC:   findPendingUC,
@@ -36,13 +50,26 @@ func NewObservationHandler(
 		FindCompleteObservationsUC:  findCompleteUC,
 		FindObservationDetailUC:     findDetailUC,
 		UpdateObservationDateUC:     updateObservationDateUC,
+		UpdateObservationStatusUC:   updateObservationStatusUC,
 		ObservationQuestionsUC:      observationQuestionsUC,
+		PreviewObservationScoreUC:   previewObservationScoreUC,
 		SubmitObservationUC:         submitObservationUC,
+		FindObservationDraftUC:      findObservationDraftUC,
+		SaveObservationDraftUC:      saveObservationDraftUC,
+		FinaliseObservationUC:       finaliseObservationUC,
+		AmendObservationUC:          amendObservationUC,
+		FindObservationAnswersUC:    findObservationAnswersUC,
 	}
 }
 
 func (h *ObservationHandler) FindPendingObservations(c *gin.Context) {
-	observations, err := h.FindPendingObservationsUC.Execute(c.Request.Context())
+	req := dto.ListQueryRequest{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	observations, meta, err := h.FindPendingObservationsUC.Execute(c.Request.Context(), &req)
 	if err != nil {
 		middlewares.AbortWithError(c, err)
 		return
@@ -52,11 +79,18 @@ func (h *ObservationHandler) FindPendingObservations(c *gin.Context) {
 		Success: true,
 		Message: "List of pending observations",
 		Data:    observations,
+		Meta:    meta,
 	})
 }
 
 func (h *ObservationHandler) FindScheduledObservations(c *gin.Context) {
-	observations, err := h.FindScheduledObservationsUC.Execute(c.Request.Context())
+	req := dto.ListQueryRequest{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	observations, meta, err := h.FindScheduledObservationsUC.Execute(c.Request.Context(), &req)
 	if err != nil {
 		middlewares.AbortWithError(c, err)
 		return
@@ -66,11 +100,18 @@ func (h *ObservationHandler) FindScheduledObservations(c *gin.Context) {
 		Success: true,
 		Message: "List of scheduled observations",
 		Data:    observations,
+		Meta:    meta,
 	})
 }
 
 func (h *ObservationHandler) FindCompletedObservations(c *gin.Context) {
-	observations, err := h.FindCompleteObservationsUC.Execute(c.Request.Context())
+	req := dto.ListQueryRequest{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	observations, meta, err := h.FindCompleteObservationsUC.Execute(c.Request.Context(), &req)
 	if err != nil {
 		middlewares.AbortWithError(c, err)
 		return
@@ -80,6 +121,7 @@ func (h *ObservationHandler) FindCompletedObservations(c *gin.Context) {
 		Success: true,
 		Message: "List of completed observations",
 		Data:    observations,
+		Meta:    meta,
 	})
 }
 
@@ -139,6 +181,36 @@ func (h *ObservationHandler) UpdateObservationDate(c *gin.Context) {
 	})
 }
 
+func (h *ObservationHandler) UpdateObservationStatus(c *gin.Context) {
+	observationIdStr := c.Param("observation_id")
+
+	observationId, err := strconv.Atoi(observationIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid observation ID",
+		})
+		return
+	}
+
+	req := dto.UpdateObservationStatusRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.UpdateObservationStatusUC.Execute(c.Request.Context(), observationId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Observation Status Updated",
+		Data:    nil,
+	})
+}
+
 func (h *ObservationHandler) ObservationQuestions(c *gin.Context) {
 	observationIdStr := c.Param("observation_id")
 
@@ -164,6 +236,37 @@ func (h *ObservationHandler) ObservationQuestions(c *gin.Context) {
 	})
 }
 
+func (h *ObservationHandler) PreviewObservationScore(c *gin.Context) {
+	observationIdStr := c.Param("observation_id")
+
+	observationId, err := strconv.Atoi(observationIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid observation ID",
+		})
+		return
+	}
+
+	req := dto.ScorePreviewRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	result, err := h.PreviewObservationScoreUC.Execute(c.Request.Context(), observationId, &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Observation Score Preview",
+		Data:    result,
+	})
+}
+
 func (h *ObservationHandler) SubmitObservation(c *gin.Context) {
 	observationIdStr := c.Param("observation_id")
 
@@ -193,3 +296,149 @@ func (h *ObservationHandler) SubmitObservation(c *gin.Context) {
 		Data:    nil,
 	})
 }
+
+func (h *ObservationHandler) FindObservationDraft(c *gin.Context) {
+	observationIdStr := c.Param("observation_id")
+
+	observationId, err := strconv.Atoi(observationIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid observation ID",
+		})
+		return
+	}
+
+	draft, err := h.FindObservationDraftUC.Execute(c.Request.Context(), observationId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Observation Draft",
+		Data:    draft,
+	})
+}
+
+func (h *ObservationHandler) SaveObservationDraft(c *gin.Context) {
+	observationIdStr := c.Param("observation_id")
+
+	observationId, err := strconv.Atoi(observationIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid observation ID",
+		})
+		return
+	}
+
+	req := dto.SaveObservationDraftRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.SaveObservationDraftUC.Execute(c.Request.Context(), observationId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Observation Draft Saved",
+		Data:    nil,
+	})
+}
+
+func (h *ObservationHandler) FinaliseObservation(c *gin.Context) {
+	observationIdStr := c.Param("observation_id")
+
+	observationId, err := strconv.Atoi(observationIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid observation ID",
+		})
+		return
+	}
+
+	req := dto.FinaliseObservationRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.FinaliseObservationUC.Execute(c.Request.Context(), observationId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Observation Finalised",
+		Data:    nil,
+	})
+}
+
+func (h *ObservationHandler) AmendObservation(c *gin.Context) {
+	observationIdStr := c.Param("observation_id")
+
+	observationId, err := strconv.Atoi(observationIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid observation ID",
+		})
+		return
+	}
+
+	req := dto.AmendObservationRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.AmendObservationUC.Execute(c.Request.Context(), observationId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Observation Amended",
+		Data:    nil,
+	})
+}
+
+func (h *ObservationHandler) FindObservationAnswers(c *gin.Context) {
+	observationIdStr := c.Param("observation_id")
+
+	observationId, err := strconv.Atoi(observationIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid observation ID",
+		})
+		return
+	}
+
+	req := dto.ObservationAnswersQuery{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	response, err := h.FindObservationAnswersUC.Execute(c.Request.Context(), observationId, &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Observation Answers Retrieved",
+		Data:    response,
+	})
+}
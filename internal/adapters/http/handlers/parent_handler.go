@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/parent"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ParentHandler struct {
+	FindParentProfileUC     parent.FindParentProfileUseCase
+	FindParentChildrenUC    parent.FindParentChildrenUseCase
+	FindParentChildDetailUC parent.FindParentChildDetailUseCase
+	FindChildObservationUC  parent.FindChildObservationUseCase
+	CreateParentDetailUC    parent.CreateParentDetailUseCase
+	FindParentDetailsUC     parent.FindParentDetailsUseCase
+	UpdateParentDetailUC    parent.UpdateParentDetailUseCase
+	DeleteParentDetailUC    parent.DeleteParentDetailUseCase
+	SetPrimaryContactUC     parent.SetPrimaryContactUseCase
+}
+
+func NewParentHandler(
+	findProfileUC parent.FindParentProfileUseCase,
+	findChildrenUC parent.FindParentChildrenUseCase,
+	findChildDetailUC parent.FindParentChildDetailUseCase,
+	findChildObservationUC parent.FindChildObservationUseCase,
+	createParentDetailUC parent.CreateParentDetailUseCase,
+	findParentDetailsUC parent.FindParentDetailsUseCase,
+	updateParentDetailUC parent.UpdateParentDetailUseCase,
+	deleteParentDetailUC parent.DeleteParentDetailUseCase,
+	setPrimaryContactUC parent.SetPrimaryContactUseCase,
+) *ParentHandler {
+	return &ParentHandler{
+		FindParentProfileUC:     findProfileUC,
+		FindParentChildrenUC:    findChildrenUC,
+		FindParentChildDetailUC: findChildDetailUC,
+		FindChildObservationUC:  findChildObservationUC,
+		CreateParentDetailUC:    createParentDetailUC,
+		FindParentDetailsUC:     findParentDetailsUC,
+		UpdateParentDetailUC:    updateParentDetailUC,
+		DeleteParentDetailUC:    deleteParentDetailUC,
+		SetPrimaryContactUC:     setPrimaryContactUC,
+	}
+}
+
+func (h *ParentHandler) FindProfile(c *gin.Context) {
+	profile, err := h.FindParentProfileUC.Execute(c.Request.Context())
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Parent Profile",
+		Data:    profile,
+	})
+}
+
+func (h *ParentHandler) FindChildren(c *gin.Context) {
+	children, err := h.FindParentChildrenUC.Execute(c.Request.Context())
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of childs",
+		Data:    children,
+	})
+}
+
+func (h *ParentHandler) FindChildDetail(c *gin.Context) {
+	childId := c.Param("child_id")
+
+	childDetail, err := h.FindParentChildDetailUC.Execute(c.Request.Context(), childId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Child Detail",
+		Data:    childDetail,
+	})
+}
+
+func (h *ParentHandler) FindChildObservation(c *gin.Context) {
+	childId := c.Param("child_id")
+
+	observation, err := h.FindChildObservationUC.Execute(c.Request.Context(), childId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Child Observation",
+		Data:    observation,
+	})
+}
+
+func (h *ParentHandler) CreateParentDetail(c *gin.Context) {
+	req := dto.ParentDetailCreateRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.CreateParentDetailUC.Execute(c.Request.Context(), &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Parent detail created successfully",
+		Data:    nil,
+	})
+}
+
+func (h *ParentHandler) FindParentDetails(c *gin.Context) {
+	parentDetails, err := h.FindParentDetailsUC.Execute(c.Request.Context())
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of parent details",
+		Data:    parentDetails,
+	})
+}
+
+func (h *ParentHandler) UpdateParentDetail(c *gin.Context) {
+	parentDetailId := c.Param("parent_detail_id")
+
+	if parentDetailId == "" {
+		middlewares.AbortWithError(c, fmt.Errorf("parentDetailId is empty"))
+		return
+	}
+
+	req := dto.ParentDetailUpdateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.UpdateParentDetailUC.Execute(c.Request.Context(), parentDetailId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Parent detail updated successfully",
+		Data:    nil,
+	})
+}
+
+func (h *ParentHandler) DeleteParentDetail(c *gin.Context) {
+	parentDetailId := c.Param("parent_detail_id")
+
+	if parentDetailId == "" {
+		middlewares.AbortWithError(c, fmt.Errorf("parentDetailId is empty"))
+		return
+	}
+
+	if err := h.DeleteParentDetailUC.Execute(c.Request.Context(), parentDetailId); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Parent detail deleted successfully",
+		Data:    nil,
+	})
+}
+
+func (h *ParentHandler) SetPrimaryContact(c *gin.Context) {
+	parentDetailId := c.Param("parent_detail_id")
+
+	if parentDetailId == "" {
+		middlewares.AbortWithError(c, fmt.Errorf("parentDetailId is empty"))
+		return
+	}
+
+	if err := h.SetPrimaryContactUC.Execute(c.Request.Context(), parentDetailId); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Primary contact updated successfully",
+		Data:    nil,
+	})
+}
@@ -0,0 +1,392 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/questionnaire"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type QuestionnaireHandler struct {
+	FindVersionsUC      questionnaire.FindVersionsUseCase
+	FindVersionDetailUC questionnaire.FindVersionDetailUseCase
+	CreateVersionUC     questionnaire.CreateVersionUseCase
+	PublishVersionUC    questionnaire.PublishVersionUseCase
+	CreateQuestionUC    questionnaire.CreateQuestionUseCase
+	UpdateQuestionUC    questionnaire.UpdateQuestionUseCase
+	DeleteQuestionUC    questionnaire.DeleteQuestionUseCase
+	FindDomainsUC       questionnaire.FindDomainsUseCase
+	CreateDomainUC      questionnaire.CreateDomainUseCase
+	UpdateDomainUC      questionnaire.UpdateDomainUseCase
+	DeleteDomainUC      questionnaire.DeleteDomainUseCase
+	FindAgeCategoriesUC questionnaire.FindAgeCategoriesUseCase
+	CreateAgeCategoryUC questionnaire.CreateAgeCategoryUseCase
+	UpdateAgeCategoryUC questionnaire.UpdateAgeCategoryUseCase
+	DeleteAgeCategoryUC questionnaire.DeleteAgeCategoryUseCase
+}
+
+func NewQuestionnaireHandler(
+	findVersionsUC questionnaire.FindVersionsUseCase,
+	findVersionDetailUC questionnaire.FindVersionDetailUseCase,
+	createVersionUC questionnaire.CreateVersionUseCase,
+	publishVersionUC questionnaire.PublishVersionUseCase,
+	createQuestionUC questionnaire.CreateQuestionUseCase,
+	updateQuestionUC questionnaire.UpdateQuestionUseCase,
+	deleteQuestionUC questionnaire.DeleteQuestionUseCase,
+	findDomainsUC questionnaire.FindDomainsUseCase,
+	createDomainUC questionnaire.CreateDomainUseCase,
+	updateDomainUC questionnaire.UpdateDomainUseCase,
+	deleteDomainUC questionnaire.DeleteDomainUseCase,
+	findAgeCategoriesUC questionnaire.FindAgeCategoriesUseCase,
+	createAgeCategoryUC questionnaire.CreateAgeCategoryUseCase,
+	updateAgeCategoryUC questionnaire.UpdateAgeCategoryUseCase,
+	deleteAgeCategoryUC questionnaire.DeleteAgeCategoryUseCase,
+) *QuestionnaireHandler {
+	return &QuestionnaireHandler{
+		FindVersionsUC:      findVersionsUC,
+		FindVersionDetailUC: findVersionDetailUC,
+		CreateVersionUC:     createVersionUC,
+		PublishVersionUC:    publishVersionUC,
+		CreateQuestionUC:    createQuestionUC,
+		UpdateQuestionUC:    updateQuestionUC,
+		DeleteQuestionUC:    deleteQuestionUC,
+		FindDomainsUC:       findDomainsUC,
+		CreateDomainUC:      createDomainUC,
+		UpdateDomainUC:      updateDomainUC,
+		DeleteDomainUC:      deleteDomainUC,
+		FindAgeCategoriesUC: findAgeCategoriesUC,
+		CreateAgeCategoryUC: createAgeCategoryUC,
+		UpdateAgeCategoryUC: updateAgeCategoryUC,
+		DeleteAgeCategoryUC: deleteAgeCategoryUC,
+	}
+}
+
+func (h *QuestionnaireHandler) FindVersions(c *gin.Context) {
+	versions, err := h.FindVersionsUC.Execute(c.Request.Context())
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of questionnaire versions",
+		Data:    versions,
+	})
+}
+
+func (h *QuestionnaireHandler) FindVersionDetail(c *gin.Context) {
+	versionId, ok := questionnaireVersionParam(c)
+	if !ok {
+		return
+	}
+
+	version, err := h.FindVersionDetailUC.Execute(c.Request.Context(), versionId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Questionnaire version detail",
+		Data:    version,
+	})
+}
+
+func (h *QuestionnaireHandler) CreateVersion(c *gin.Context) {
+	req := dto.QuestionnaireVersionCreateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	version, err := h.CreateVersionUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Questionnaire version created successfully",
+		Data:    version,
+	})
+}
+
+func (h *QuestionnaireHandler) PublishVersion(c *gin.Context) {
+	versionId, ok := questionnaireVersionParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.PublishVersionUC.Execute(c.Request.Context(), versionId); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Questionnaire version published successfully",
+		Data:    nil,
+	})
+}
+
+func (h *QuestionnaireHandler) CreateQuestion(c *gin.Context) {
+	versionId, ok := questionnaireVersionParam(c)
+	if !ok {
+		return
+	}
+
+	req := dto.QuestionnaireQuestionRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	question, err := h.CreateQuestionUC.Execute(c.Request.Context(), versionId, &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Question created successfully",
+		Data:    question,
+	})
+}
+
+func (h *QuestionnaireHandler) UpdateQuestion(c *gin.Context) {
+	versionId, ok := questionnaireVersionParam(c)
+	if !ok {
+		return
+	}
+
+	questionId, err := strconv.Atoi(c.Param("question_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid question ID",
+		})
+		return
+	}
+
+	req := dto.QuestionnaireQuestionRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.UpdateQuestionUC.Execute(c.Request.Context(), versionId, questionId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Question updated successfully",
+		Data:    nil,
+	})
+}
+
+func (h *QuestionnaireHandler) DeleteQuestion(c *gin.Context) {
+	versionId, ok := questionnaireVersionParam(c)
+	if !ok {
+		return
+	}
+
+	questionId, err := strconv.Atoi(c.Param("question_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid question ID",
+		})
+		return
+	}
+
+	if err := h.DeleteQuestionUC.Execute(c.Request.Context(), versionId, questionId); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Question deleted successfully",
+		Data:    nil,
+	})
+}
+
+func (h *QuestionnaireHandler) FindDomains(c *gin.Context) {
+	domains, err := h.FindDomainsUC.Execute(c.Request.Context())
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of observation domains",
+		Data:    domains,
+	})
+}
+
+func (h *QuestionnaireHandler) CreateDomain(c *gin.Context) {
+	req := dto.ObservationDomainCreateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.CreateDomainUC.Execute(c.Request.Context(), &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Observation domain created successfully",
+		Data:    nil,
+	})
+}
+
+func (h *QuestionnaireHandler) UpdateDomain(c *gin.Context) {
+	code := c.Param("domain_code")
+
+	req := dto.ObservationDomainUpdateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.UpdateDomainUC.Execute(c.Request.Context(), code, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Observation domain updated successfully",
+		Data:    nil,
+	})
+}
+
+func (h *QuestionnaireHandler) DeleteDomain(c *gin.Context) {
+	code := c.Param("domain_code")
+
+	if err := h.DeleteDomainUC.Execute(c.Request.Context(), code); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Observation domain deactivated successfully",
+		Data:    nil,
+	})
+}
+
+func (h *QuestionnaireHandler) FindAgeCategories(c *gin.Context) {
+	categories, err := h.FindAgeCategoriesUC.Execute(c.Request.Context())
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of age categories",
+		Data:    categories,
+	})
+}
+
+func (h *QuestionnaireHandler) CreateAgeCategory(c *gin.Context) {
+	req := dto.AgeCategoryCreateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.CreateAgeCategoryUC.Execute(c.Request.Context(), &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Age category created successfully",
+		Data:    nil,
+	})
+}
+
+func (h *QuestionnaireHandler) UpdateAgeCategory(c *gin.Context) {
+	categoryId, ok := ageCategoryParam(c)
+	if !ok {
+		return
+	}
+
+	req := dto.AgeCategoryUpdateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.UpdateAgeCategoryUC.Execute(c.Request.Context(), categoryId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Age category updated successfully",
+		Data:    nil,
+	})
+}
+
+func (h *QuestionnaireHandler) DeleteAgeCategory(c *gin.Context) {
+	categoryId, ok := ageCategoryParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.DeleteAgeCategoryUC.Execute(c.Request.Context(), categoryId); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Age category deactivated successfully",
+		Data:    nil,
+	})
+}
+
+func questionnaireVersionParam(c *gin.Context) (int, bool) {
+	versionId, err := strconv.Atoi(c.Param("version_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid questionnaire version ID",
+		})
+		return 0, false
+	}
+
+	return versionId, true
+}
+
+func ageCategoryParam(c *gin.Context) (int, bool) {
+	categoryId, err := strconv.Atoi(c.Param("age_category_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid age category ID",
+		})
+		return 0, false
+	}
+
+	return categoryId, true
+}
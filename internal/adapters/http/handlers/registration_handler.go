@@ -12,13 +12,16 @@ import (
 
 type RegistrationHandler struct {
 	RegistrationUC registration.RegistrationUseCase
+	AddChildUC     registration.AddChildUseCase
 }
 
 func NewRegistrationHandler(
 	registrationUC registration.RegistrationUseCase,
+	addChildUC registration.AddChildUseCase,
 ) *RegistrationHandler {
 	return &RegistrationHandler{
 		RegistrationUC: registrationUC,
+		AddChildUC:     addChildUC,
 	}
 }
 
@@ -42,3 +45,23 @@ func (h *RegistrationHandler) Registration(c *gin.Context) {
 		Data:    nil,
 	})
 }
+
+func (h *RegistrationHandler) AddChild(c *gin.Context) {
+	req := dto.AddChildRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.AddChildUC.Execute(c.Request.Context(), &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Child registered successfully",
+		Data:    nil,
+	})
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/report"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReportHandler struct {
+	DownloadObservationReportUC report.DownloadObservationReportUseCase
+}
+
+func NewReportHandler(
+	downloadObservationReportUC report.DownloadObservationReportUseCase,
+) *ReportHandler {
+	return &ReportHandler{
+		DownloadObservationReportUC: downloadObservationReportUC,
+	}
+}
+
+func (h *ReportHandler) DownloadObservationReport(c *gin.Context) {
+	observationIdStr := c.Param("observation_id")
+
+	observationId, err := strconv.Atoi(observationIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Success: false,
+			Message: "Invalid observation ID",
+		})
+		return
+	}
+
+	file, err := h.DownloadObservationReportUC.Execute(c.Request.Context(), observationId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.FileName))
+	c.Header("ETag", strconv.Quote(file.Checksum))
+	c.Header("Cache-Control", "private, no-cache")
+	c.Data(http.StatusOK, file.ContentType, file.Content)
+}
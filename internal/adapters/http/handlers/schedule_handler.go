@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/schedule"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ScheduleHandler struct {
+	FindWorkingHoursUC   schedule.FindWorkingHoursUseCase
+	UpdateWorkingHoursUC schedule.UpdateWorkingHoursUseCase
+	FindTimeOffsUC       schedule.FindTimeOffsUseCase
+	CreateTimeOffUC      schedule.CreateTimeOffUseCase
+	DeleteTimeOffUC      schedule.DeleteTimeOffUseCase
+	FindAvailableSlotsUC schedule.FindAvailableSlotsUseCase
+}
+
+func NewScheduleHandler(
+	findWorkingHoursUC schedule.FindWorkingHoursUseCase,
+	updateWorkingHoursUC schedule.UpdateWorkingHoursUseCase,
+	findTimeOffsUC schedule.FindTimeOffsUseCase,
+	createTimeOffUC schedule.CreateTimeOffUseCase,
+	deleteTimeOffUC schedule.DeleteTimeOffUseCase,
+	findAvailableSlotsUC schedule.FindAvailableSlotsUseCase,
+) *ScheduleHandler {
+	return &ScheduleHandler{
+		FindWorkingHoursUC:   findWorkingHoursUC,
+		UpdateWorkingHoursUC: updateWorkingHoursUC,
+		FindTimeOffsUC:       findTimeOffsUC,
+		CreateTimeOffUC:      createTimeOffUC,
+		DeleteTimeOffUC:      deleteTimeOffUC,
+		FindAvailableSlotsUC: findAvailableSlotsUC,
+	}
+}
+
+func (h *ScheduleHandler) FindWorkingHours(c *gin.Context) {
+	therapistId := c.Param("therapist_id")
+
+	workingHours, err := h.FindWorkingHoursUC.Execute(c.Request.Context(), therapistId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of working hours",
+		Data:    workingHours,
+	})
+}
+
+func (h *ScheduleHandler) UpdateWorkingHours(c *gin.Context) {
+	therapistId := c.Param("therapist_id")
+
+	req := dto.WorkingHoursUpdateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.UpdateWorkingHoursUC.Execute(c.Request.Context(), therapistId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Working hours updated successfully",
+		Data:    nil,
+	})
+}
+
+func (h *ScheduleHandler) FindTimeOffs(c *gin.Context) {
+	therapistId := c.Param("therapist_id")
+
+	timeOffs, err := h.FindTimeOffsUC.Execute(c.Request.Context(), therapistId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of time offs",
+		Data:    timeOffs,
+	})
+}
+
+func (h *ScheduleHandler) CreateTimeOff(c *gin.Context) {
+	therapistId := c.Param("therapist_id")
+
+	req := dto.TimeOffCreateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.CreateTimeOffUC.Execute(c.Request.Context(), therapistId, &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.SuccessResponse{
+		Success: true,
+		Message: "Time off created successfully",
+		Data:    nil,
+	})
+}
+
+func (h *ScheduleHandler) DeleteTimeOff(c *gin.Context) {
+	therapistId := c.Param("therapist_id")
+	timeOffId := c.Param("time_off_id")
+
+	if err := h.DeleteTimeOffUC.Execute(c.Request.Context(), therapistId, timeOffId); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Time off deleted successfully",
+		Data:    nil,
+	})
+}
+
+func (h *ScheduleHandler) FindAvailableSlots(c *gin.Context) {
+	req := dto.AvailableSlotRequest{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	slots, err := h.FindAvailableSlotsUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of available slots",
+		Data:    slots,
+	})
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/scoring"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ScoringHandler struct {
+	FindThresholdsUC   scoring.FindThresholdsUseCase
+	UpdateThresholdsUC scoring.UpdateThresholdsUseCase
+}
+
+func NewScoringHandler(
+	findThresholdsUC scoring.FindThresholdsUseCase,
+	updateThresholdsUC scoring.UpdateThresholdsUseCase,
+) *ScoringHandler {
+	return &ScoringHandler{
+		FindThresholdsUC:   findThresholdsUC,
+		UpdateThresholdsUC: updateThresholdsUC,
+	}
+}
+
+func (h *ScoringHandler) FindThresholds(c *gin.Context) {
+	req := dto.ScoringThresholdQuery{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	thresholds, err := h.FindThresholdsUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of scoring thresholds",
+		Data:    thresholds,
+	})
+}
+
+func (h *ScoringHandler) UpdateThresholds(c *gin.Context) {
+	req := dto.ScoringThresholdsUpdateRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	if err := h.UpdateThresholdsUC.Execute(c.Request.Context(), &req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Scoring thresholds updated successfully",
+		Data:    nil,
+	})
+}
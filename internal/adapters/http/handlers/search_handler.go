@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/search"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SearchHandler struct {
+	GlobalSearchUC search.GlobalSearchUseCase
+}
+
+func NewSearchHandler(
+	globalSearchUC search.GlobalSearchUseCase,
+) *SearchHandler {
+	return &SearchHandler{
+		GlobalSearchUC: globalSearchUC,
+	}
+}
+
+func (h *SearchHandler) Search(c *gin.Context) {
+	req := dto.SearchRequest{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	results, err := h.GlobalSearchUC.Execute(c.Request.Context(), &req)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Search results",
+		Data:    results,
+	})
+}
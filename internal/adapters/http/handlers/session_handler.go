@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/adapters/http/types"
+	"backend-golang/internal/usecases/auth"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SessionHandler struct {
+	FindSessionsUC        auth.FindSessionsUseCase
+	RevokeSessionUC       auth.RevokeSessionUseCase
+	RevokeOtherSessionsUC auth.RevokeOtherSessionsUseCase
+	RevokeUserSessionsUC  auth.RevokeUserSessionsUseCase
+}
+
+func NewSessionHandler(
+	findSessionsUC auth.FindSessionsUseCase,
+	revokeSessionUC auth.RevokeSessionUseCase,
+	revokeOtherSessionsUC auth.RevokeOtherSessionsUseCase,
+	revokeUserSessionsUC auth.RevokeUserSessionsUseCase,
+) *SessionHandler {
+	return &SessionHandler{
+		FindSessionsUC:        findSessionsUC,
+		RevokeSessionUC:       revokeSessionUC,
+		RevokeOtherSessionsUC: revokeOtherSessionsUC,
+		RevokeUserSessionsUC:  revokeUserSessionsUC,
+	}
+}
+
+func (h *SessionHandler) FindSessions(c *gin.Context) {
+	currentToken, _ := c.Cookie("refresh_token")
+
+	sessions, err := h.FindSessionsUC.Execute(c.Request.Context(), currentToken)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "List of sessions",
+		Data:    sessions,
+	})
+}
+
+func (h *SessionHandler) RevokeSession(c *gin.Context) {
+	sessionId := c.Param("session_id")
+
+	if err := h.RevokeSessionUC.Execute(c.Request.Context(), sessionId); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Session revoked successfully",
+		Data:    nil,
+	})
+}
+
+func (h *SessionHandler) RevokeOtherSessions(c *gin.Context) {
+	currentToken, _ := c.Cookie("refresh_token")
+
+	result, err := h.RevokeOtherSessionsUC.Execute(c.Request.Context(), currentToken)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "Other sessions revoked successfully",
+		Data:    result,
+	})
+}
+
+func (h *SessionHandler) RevokeUserSessions(c *gin.Context) {
+	userId := c.Param("user_id")
+
+	result, err := h.RevokeUserSessionsUC.Execute(c.Request.Context(), userId)
+	if err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.SuccessResponse{
+		Success: true,
+		Message: "User sessions revoked successfully",
+		Data:    result,
+	})
+}
@@ -57,7 +57,13 @@ func (h *TherapistHandler) CreateTherapist(c *gin.Context) {
 }
 
 func (h *TherapistHandler) FindTherapists(c *gin.Context) {
-	therapists, err := h.FindTherapistsUC.Execute(c.Request.Context())
+	req := dto.ListQueryRequest{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middlewares.AbortWithError(c, err)
+		return
+	}
+
+	therapists, meta, err := h.FindTherapistsUC.Execute(c.Request.Context(), &req)
 	if err != nil {
 		middlewares.AbortWithError(c, err)
 		return
@@ -67,6 +73,7 @@ func (h *TherapistHandler) FindTherapists(c *gin.Context) {
 		Success: true,
 		Message: "List of therapists",
 		Data:    therapists,
+		Meta:    meta,
 	})
 }
 
@@ -3,18 +3,24 @@ package middlewares
 import (
 	"backend-golang/internal/adapters/http/types"
 	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/services"
 	"backend-golang/internal/errors"
 	"backend-golang/internal/helpers"
-	"backend-golang/internal/infrastructure/config"
 	"context"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
 )
 
-func Authenticate() gin.HandlerFunc {
+// Authenticate accepts a valid access token that has not been revoked. When
+// the denylist cannot be read the request is refused rather than risk letting
+// a revoked token through.
+func Authenticate(client *redis.Client) gin.HandlerFunc {
+	denylist := services.NewTokenDenylistService(client)
+
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -37,17 +43,32 @@ func Authenticate() gin.HandlerFunc {
 		}
 
 		tokenString := parts[1]
-		claims := &helpers.AppClaims{}
+		claims, err := helpers.ParseAccessToken(tokenString)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, types.ErrorResponse{
+				Success: false,
+				Message: errors.ErrInvalidToken.Error(),
+				Errors:  map[string]string{"errors": "Token is invalid or has expired"},
+			})
+			return
+		}
 
-		token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-			return config.JWTKey, nil
-		})
+		revoked, err := denylist.IsRevoked(c.Request.Context(), claims.ID, claims.Subject, claims.IssuedAtTime())
+		if err != nil {
+			log.Error().Err(err).Str("userId", claims.Subject).Msg("Failed to check access token denylist")
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, types.ErrorResponse{
+				Success: false,
+				Message: "Service Unavailable",
+				Errors:  map[string]string{"errors": "Unable to verify token, please try again later"},
+			})
+			return
+		}
 
-		if err != nil || !token.Valid {
+		if revoked {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, types.ErrorResponse{
 				Success: false,
 				Message: errors.ErrInvalidToken.Error(),
-				Errors:  map[string]string{"errors": "Token is invalid or has expired"},
+				Errors:  map[string]string{"errors": "Token has been revoked"},
 			})
 			return
 		}
@@ -11,10 +11,21 @@ import (
 )
 
 type AdminRoutes struct {
-	adminHandler       *handlers.AdminHandler
-	therapistHandler   *handlers.TherapistHandler
-	childHandler       *handlers.ChildHandler
-	observationHandler *handlers.ObservationHandler
+	adminHandler         *handlers.AdminHandler
+	therapistHandler     *handlers.TherapistHandler
+	childHandler         *handlers.ChildHandler
+	observationHandler   *handlers.ObservationHandler
+	searchHandler        *handlers.SearchHandler
+	scheduleHandler      *handlers.ScheduleHandler
+	scoringHandler       *handlers.ScoringHandler
+	questionnaireHandler *handlers.QuestionnaireHandler
+	reportHandler        *handlers.ReportHandler
+	assessmentHandler    *handlers.AssessmentHandler
+	conferenceHandler    *handlers.CaseConferenceHandler
+	interventionHandler  *handlers.InterventionHandler
+	carePlanHandler      *handlers.CarePlanHandler
+	evaluationHandler    *handlers.EvaluationHandler
+	sessionHandler       *handlers.SessionHandler
 }
 
 func NewAdminRoutes(
@@ -22,12 +33,34 @@ func NewAdminRoutes(
 	therapistHandler *handlers.TherapistHandler,
 	childHandler *handlers.ChildHandler,
 	observationHandler *handlers.ObservationHandler,
+	searchHandler *handlers.SearchHandler,
+	scheduleHandler *handlers.ScheduleHandler,
+	scoringHandler *handlers.ScoringHandler,
+	questionnaireHandler *handlers.QuestionnaireHandler,
+	reportHandler *handlers.ReportHandler,
+	assessmentHandler *handlers.AssessmentHandler,
+	conferenceHandler *handlers.CaseConferenceHandler,
+	interventionHandler *handlers.InterventionHandler,
+	carePlanHandler *handlers.CarePlanHandler,
+	evaluationHandler *handlers.EvaluationHandler,
+	sessionHandler *handlers.SessionHandler,
 ) *AdminRoutes {
 	return &AdminRoutes{
-		adminHandler:       adminHandler,
-		therapistHandler:   therapistHandler,
-		childHandler:       childHandler,
-		observationHandler: observationHandler,
+		adminHandler:         adminHandler,
+		therapistHandler:     therapistHandler,
+		childHandler:         childHandler,
+		observationHandler:   observationHandler,
+		searchHandler:        searchHandler,
+		scheduleHandler:      scheduleHandler,
+		scoringHandler:       scoringHandler,
+		questionnaireHandler: questionnaireHandler,
+		reportHandler:        reportHandler,
+		assessmentHandler:    assessmentHandler,
+		conferenceHandler:    conferenceHandler,
+		interventionHandler:  interventionHandler,
+		carePlanHandler:      carePlanHandler,
+		evaluationHandler:    evaluationHandler,
+		sessionHandler:       sessionHandler,
 	}
 }
 
@@ -39,7 +72,7 @@ func (r *AdminRoutes) Setup(rg *gin.RouterGroup) {
 
 	admins := rg.Group("/admin")
 	admins.Use(
-		middlewares.Authenticate(),
+		middlewares.Authenticate(client),
 		middlewares.RateLimiterUserID(client, 1*time.Second, 100),
 		middlewares.Authorize(constants.RoleAdmin),
 	)
@@ -50,16 +83,89 @@ func (r *AdminRoutes) Setup(rg *gin.RouterGroup) {
 	admins.PUT("/admins/:admin_id", r.adminHandler.UpdateAdmin)
 	admins.PATCH("/admins/:admin_id", r.adminHandler.DeleteAdmin)
 
+	admins.DELETE("/users/:user_id/sessions", r.sessionHandler.RevokeUserSessions)
+
 	admins.POST("/therapists/", r.therapistHandler.CreateTherapist)
 	admins.GET("/therapists/", r.therapistHandler.FindTherapists)
 	admins.GET("/therapists/:therapist_id", r.therapistHandler.FindTherapistDetail)
 	admins.PUT("/therapists/:therapist_id", r.therapistHandler.UpdateTherapist)
 	admins.PATCH("/therapists/:therapist_id", r.therapistHandler.DeleteTherapist)
 
+	admins.GET("/therapists/:therapist_id/working-hours", r.scheduleHandler.FindWorkingHours)
+	admins.PUT("/therapists/:therapist_id/working-hours", r.scheduleHandler.UpdateWorkingHours)
+	admins.GET("/therapists/:therapist_id/time-offs", r.scheduleHandler.FindTimeOffs)
+	admins.POST("/therapists/:therapist_id/time-offs", r.scheduleHandler.CreateTimeOff)
+	admins.DELETE("/therapists/:therapist_id/time-offs/:time_off_id", r.scheduleHandler.DeleteTimeOff)
+
+	admins.GET("/schedule/slots", r.scheduleHandler.FindAvailableSlots)
+
+	admins.GET("/scoring/thresholds", r.scoringHandler.FindThresholds)
+	admins.PUT("/scoring/thresholds", r.scoringHandler.UpdateThresholds)
+
+	admins.GET("/questionnaires/", r.questionnaireHandler.FindVersions)
+	admins.POST("/questionnaires/", r.questionnaireHandler.CreateVersion)
+	admins.GET("/questionnaires/:version_id", r.questionnaireHandler.FindVersionDetail)
+	admins.POST("/questionnaires/:version_id/publish", r.questionnaireHandler.PublishVersion)
+	admins.POST("/questionnaires/:version_id/questions", r.questionnaireHandler.CreateQuestion)
+	admins.PUT("/questionnaires/:version_id/questions/:question_id", r.questionnaireHandler.UpdateQuestion)
+	admins.DELETE("/questionnaires/:version_id/questions/:question_id", r.questionnaireHandler.DeleteQuestion)
+
+	admins.GET("/observation-domains/", r.questionnaireHandler.FindDomains)
+	admins.POST("/observation-domains/", r.questionnaireHandler.CreateDomain)
+	admins.PUT("/observation-domains/:domain_code", r.questionnaireHandler.UpdateDomain)
+	admins.DELETE("/observation-domains/:domain_code", r.questionnaireHandler.DeleteDomain)
+
+	admins.GET("/age-categories/", r.questionnaireHandler.FindAgeCategories)
+	admins.POST("/age-categories/", r.questionnaireHandler.CreateAgeCategory)
+	admins.PUT("/age-categories/:age_category_id", r.questionnaireHandler.UpdateAgeCategory)
+	admins.DELETE("/age-categories/:age_category_id", r.questionnaireHandler.DeleteAgeCategory)
+
+	admins.GET("/search", r.searchHandler.Search)
+
 	admins.GET("/childs/", r.childHandler.FindChilds)
+	admins.POST("/childs/:child_id/observations", r.childHandler.CreateChildObservation)
+	admins.GET("/childs/:child_id/progress", r.childHandler.FindChildProgress)
+	admins.GET("/childs/:child_id/care-plan", r.childHandler.FindChildCarePlan)
+	admins.GET("/childs/:child_id/care-plan/goals", r.carePlanHandler.FindGoalAttainment)
+	admins.POST("/childs/:child_id/care-plan/goals", r.carePlanHandler.CreateGoal)
+	admins.PUT("/care-plan-goals/:goal_id", r.carePlanHandler.UpdateGoal)
+
+	admins.GET("/childs/:child_id/evaluations/draft", r.evaluationHandler.PrepareEvaluation)
+	admins.GET("/childs/:child_id/evaluations", r.evaluationHandler.FindChildEvaluations)
+	admins.POST("/childs/:child_id/evaluations", r.evaluationHandler.CreateEvaluation)
+	admins.GET("/evaluations/overdue", r.evaluationHandler.FindOverdueEvaluations)
+	admins.GET("/evaluations/:evaluation_id", r.evaluationHandler.FindEvaluationDetail)
 
 	admins.GET("/observations/pending", r.observationHandler.FindPendingObservations)
 	admins.PATCH("/observations/pending/:observation_id", r.observationHandler.UpdateObservationDate)
 	admins.GET("/observations/scheduled", r.observationHandler.FindScheduledObservations)
+	admins.PATCH("/observations/scheduled/:observation_id", r.observationHandler.UpdateObservationDate)
+	admins.GET("/observations/detail/:observation_id", r.observationHandler.FindObservationDetail)
+	admins.GET("/observations/answers/:observation_id", r.observationHandler.FindObservationAnswers)
+	admins.PATCH("/observations/status/:observation_id", r.observationHandler.UpdateObservationStatus)
+	admins.POST("/observations/amend/:observation_id", r.observationHandler.AmendObservation)
+	admins.GET("/observations/report/:observation_id", r.reportHandler.DownloadObservationReport)
+
+	admins.GET("/assessment-instruments/", r.assessmentHandler.FindInstruments)
+	admins.POST("/assessment-instruments/", r.assessmentHandler.CreateInstrument)
+	admins.PUT("/assessment-instruments/:instrument_id", r.assessmentHandler.UpdateInstrument)
+
+	admins.POST("/assessments/", r.assessmentHandler.OpenAssessment)
+	admins.GET("/assessments/", r.assessmentHandler.FindAssessments)
+	admins.GET("/assessments/:assessment_id", r.assessmentHandler.FindAssessmentDetail)
+	admins.PATCH("/assessments/:assessment_id/assessor", r.assessmentHandler.ReassignAssessment)
+	admins.PATCH("/assessments/:assessment_id/cancel", r.assessmentHandler.CancelAssessment)
+
+	admins.POST("/case-conferences/", r.conferenceHandler.CreateConference)
+	admins.GET("/case-conferences/", r.conferenceHandler.FindConferences)
+	admins.GET("/case-conferences/:conference_id", r.conferenceHandler.FindConferenceDetail)
+	admins.PUT("/case-conferences/:conference_id", r.conferenceHandler.UpdateConference)
+
+	admins.POST("/intervention-plans/", r.interventionHandler.CreatePlan)
+	admins.GET("/intervention-plans/", r.interventionHandler.FindPlans)
+	admins.PUT("/intervention-plans/:plan_id", r.interventionHandler.UpdatePlan)
+
+	admins.GET("/intervention-sessions/attendance", r.interventionHandler.FindAttendanceReport)
+	admins.GET("/intervention-sessions/:session_id", r.interventionHandler.FindSessionDetail)
 
 }
@@ -3,6 +3,7 @@ package routes
 import (
 	"backend-golang/internal/adapters/http/handlers"
 	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/constants"
 	"backend-golang/pkg/redis"
 	"time"
 
@@ -10,14 +11,20 @@ import (
 )
 
 type AuthRoutes struct {
-	authHandler *handlers.AuthHandler
+	authHandler    *handlers.AuthHandler
+	sessionHandler *handlers.SessionHandler
+	mfaHandler     *handlers.MfaHandler
 }
 
 func NewAuthRoutes(
 	authHandler *handlers.AuthHandler,
+	sessionHandler *handlers.SessionHandler,
+	mfaHandler *handlers.MfaHandler,
 ) *AuthRoutes {
 	return &AuthRoutes{
-		authHandler: authHandler,
+		authHandler:    authHandler,
+		sessionHandler: sessionHandler,
+		mfaHandler:     mfaHandler,
 	}
 }
 
@@ -31,6 +38,7 @@ func (r *AuthRoutes) Setup(rg *gin.RouterGroup) {
 	auth.Use(middlewares.RateLimiterIP(client, 1*time.Minute, 10))
 
 	auth.POST("/login", r.authHandler.Login)
+	auth.POST("/login/mfa", r.mfaHandler.VerifyMfaLogin)
 	auth.POST("/logout", r.authHandler.Logout)
 	auth.POST("/refresh", r.authHandler.RefreshToken)
 
@@ -42,4 +50,27 @@ func (r *AuthRoutes) Setup(rg *gin.RouterGroup) {
 
 	auth.GET("/resend-verification-account-email", r.authHandler.ResendVerificationAccount)
 	auth.GET("/resend-reset-password-email", r.authHandler.ResendForgetPassword)
+
+	sessions := rg.Group("/sessions")
+	sessions.Use(
+		middlewares.Authenticate(client),
+		middlewares.RateLimiterUserID(client, 1*time.Second, 100),
+	)
+
+	sessions.GET("/", r.sessionHandler.FindSessions)
+	sessions.DELETE("/others", r.sessionHandler.RevokeOtherSessions)
+	sessions.DELETE("/:session_id", r.sessionHandler.RevokeSession)
+
+	mfa := rg.Group("/mfa")
+	mfa.Use(
+		middlewares.Authenticate(client),
+		middlewares.RateLimiterUserID(client, 1*time.Second, 100),
+		middlewares.Authorize(constants.RoleAdmin, constants.RoleTherapist),
+	)
+
+	mfa.GET("/", r.mfaHandler.FindMfaStatus)
+	mfa.POST("/enrollment", r.mfaHandler.StartMfaEnrollment)
+	mfa.POST("/enrollment/confirm", r.mfaHandler.ConfirmMfaEnrollment)
+	mfa.POST("/recovery-codes", r.mfaHandler.RegenerateRecoveryCodes)
+	mfa.POST("/disable", r.mfaHandler.DisableMfa)
 }
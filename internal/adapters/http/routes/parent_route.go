@@ -0,0 +1,67 @@
+package routes
+
+import (
+	"backend-golang/internal/adapters/http/handlers"
+	"backend-golang/internal/adapters/http/middlewares"
+	"backend-golang/internal/constants"
+	"backend-golang/pkg/redis"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ParentRoutes struct {
+	parentHandler       *handlers.ParentHandler
+	registrationHandler *handlers.RegistrationHandler
+	reportHandler       *handlers.ReportHandler
+	childHandler        *handlers.ChildHandler
+	carePlanHandler     *handlers.CarePlanHandler
+}
+
+func NewParentRoutes(
+	parentHandler *handlers.ParentHandler,
+	registrationHandler *handlers.RegistrationHandler,
+	reportHandler *handlers.ReportHandler,
+	childHandler *handlers.ChildHandler,
+	carePlanHandler *handlers.CarePlanHandler,
+) *ParentRoutes {
+	return &ParentRoutes{
+		parentHandler:       parentHandler,
+		registrationHandler: registrationHandler,
+		reportHandler:       reportHandler,
+		childHandler:        childHandler,
+		carePlanHandler:     carePlanHandler,
+	}
+}
+
+func (r *ParentRoutes) Setup(rg *gin.RouterGroup) {
+	client, err := redis.GetRedisClient()
+	if err != nil {
+		panic(err)
+	}
+
+	parents := rg.Group("/parent")
+	parents.Use(
+		middlewares.Authenticate(client),
+		middlewares.RateLimiterUserID(client, 1*time.Second, 100),
+		middlewares.Authorize(constants.RoleUser),
+	)
+
+	parents.GET("/profile", r.parentHandler.FindProfile)
+
+	parents.POST("/details/", r.parentHandler.CreateParentDetail)
+	parents.GET("/details/", r.parentHandler.FindParentDetails)
+	parents.PUT("/details/:parent_detail_id", r.parentHandler.UpdateParentDetail)
+	parents.DELETE("/details/:parent_detail_id", r.parentHandler.DeleteParentDetail)
+	parents.PATCH("/details/:parent_detail_id/primary", r.parentHandler.SetPrimaryContact)
+
+	parents.POST("/childs/", r.registrationHandler.AddChild)
+	parents.GET("/childs/", r.parentHandler.FindChildren)
+	parents.GET("/childs/:child_id", r.parentHandler.FindChildDetail)
+	parents.GET("/childs/:child_id/observation", r.parentHandler.FindChildObservation)
+	parents.GET("/childs/:child_id/progress", r.childHandler.FindChildProgress)
+	parents.GET("/childs/:child_id/care-plan", r.childHandler.FindChildCarePlan)
+	parents.GET("/childs/:child_id/care-plan/goals", r.carePlanHandler.FindGoalAttainment)
+
+	parents.GET("/observations/:observation_id/report", r.reportHandler.DownloadObservationReport)
+}
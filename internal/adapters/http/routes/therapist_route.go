@@ -11,14 +11,32 @@ import (
 )
 
 type TherapistRoutes struct {
-	observationHandler *handlers.ObservationHandler
+	observationHandler  *handlers.ObservationHandler
+	childHandler        *handlers.ChildHandler
+	assessmentHandler   *handlers.AssessmentHandler
+	conferenceHandler   *handlers.CaseConferenceHandler
+	interventionHandler *handlers.InterventionHandler
+	carePlanHandler     *handlers.CarePlanHandler
+	evaluationHandler   *handlers.EvaluationHandler
 }
 
 func NewTherapistRoutes(
 	observationHandler *handlers.ObservationHandler,
+	childHandler *handlers.ChildHandler,
+	assessmentHandler *handlers.AssessmentHandler,
+	conferenceHandler *handlers.CaseConferenceHandler,
+	interventionHandler *handlers.InterventionHandler,
+	carePlanHandler *handlers.CarePlanHandler,
+	evaluationHandler *handlers.EvaluationHandler,
 ) *TherapistRoutes {
 	return &TherapistRoutes{
-		observationHandler: observationHandler,
+		observationHandler:  observationHandler,
+		childHandler:        childHandler,
+		assessmentHandler:   assessmentHandler,
+		conferenceHandler:   conferenceHandler,
+		interventionHandler: interventionHandler,
+		carePlanHandler:     carePlanHandler,
+		evaluationHandler:   evaluationHandler,
 	}
 }
 
@@ -30,7 +48,7 @@ func (r *TherapistRoutes) Setup(rg *gin.RouterGroup) {
 
 	therapists := rg.Group("/therapist")
 	therapists.Use(
-		middlewares.Authenticate(),
+		middlewares.Authenticate(client),
 		middlewares.RateLimiterUserID(client, 1*time.Second, 100),
 		middlewares.Authorize(constants.RoleTherapist),
 	)
@@ -38,9 +56,43 @@ func (r *TherapistRoutes) Setup(rg *gin.RouterGroup) {
 	therapists.GET("/observations/scheduled", r.observationHandler.FindScheduledObservations)
 	therapists.GET("/observations/scheduled/:observation_id", r.observationHandler.FindObservationDetail)
 
+	therapists.PATCH("/observations/status/:observation_id", r.observationHandler.UpdateObservationStatus)
+
 	therapists.GET("/observations/question/:observation_id", r.observationHandler.ObservationQuestions)
+	therapists.POST("/observations/score/:observation_id", r.observationHandler.PreviewObservationScore)
 	therapists.GET("/observations/submit/:observation_id", r.observationHandler.SubmitObservation)
 
+	therapists.GET("/observations/draft/:observation_id", r.observationHandler.FindObservationDraft)
+	therapists.PUT("/observations/draft/:observation_id", r.observationHandler.SaveObservationDraft)
+	therapists.POST("/observations/finalise/:observation_id", r.observationHandler.FinaliseObservation)
+
 	therapists.GET("/observations/completed", r.observationHandler.FindCompletedObservations)
 	therapists.GET("/observations/completed/:observation_id", r.observationHandler.FindObservationDetail)
+	therapists.GET("/observations/completed/:observation_id/answers", r.observationHandler.FindObservationAnswers)
+	therapists.POST("/observations/amend/:observation_id", r.observationHandler.AmendObservation)
+
+	therapists.GET("/childs/:child_id/progress", r.childHandler.FindChildProgress)
+	therapists.GET("/childs/:child_id/care-plan", r.childHandler.FindChildCarePlan)
+	therapists.GET("/childs/:child_id/care-plan/goals", r.carePlanHandler.FindGoalAttainment)
+	therapists.POST("/childs/:child_id/care-plan/goals", r.carePlanHandler.CreateGoal)
+	therapists.PUT("/care-plan-goals/:goal_id", r.carePlanHandler.UpdateGoal)
+
+	therapists.GET("/childs/:child_id/evaluations/draft", r.evaluationHandler.PrepareEvaluation)
+	therapists.GET("/childs/:child_id/evaluations", r.evaluationHandler.FindChildEvaluations)
+	therapists.POST("/childs/:child_id/evaluations", r.evaluationHandler.CreateEvaluation)
+	therapists.GET("/evaluations/:evaluation_id", r.evaluationHandler.FindEvaluationDetail)
+
+	therapists.GET("/assessments/", r.assessmentHandler.FindAssessments)
+	therapists.GET("/assessments/:assessment_id", r.assessmentHandler.FindAssessmentDetail)
+	therapists.PUT("/assessments/:assessment_id/scores", r.assessmentHandler.SaveAssessmentScores)
+	therapists.POST("/assessments/:assessment_id/complete", r.assessmentHandler.CompleteAssessment)
+
+	therapists.GET("/case-conferences/", r.conferenceHandler.FindConferences)
+	therapists.GET("/case-conferences/:conference_id", r.conferenceHandler.FindConferenceDetail)
+	therapists.PUT("/case-conferences/:conference_id", r.conferenceHandler.UpdateConference)
+
+	therapists.GET("/intervention-plans/", r.interventionHandler.FindPlans)
+	therapists.GET("/intervention-sessions/today", r.interventionHandler.FindTodaySessions)
+	therapists.POST("/intervention-sessions/", r.interventionHandler.RecordSession)
+	therapists.GET("/intervention-sessions/:session_id", r.interventionHandler.FindSessionDetail)
 }
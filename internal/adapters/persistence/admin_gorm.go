@@ -57,15 +57,30 @@ func (r *adminRepository) GetById(ctx context.Context, adminId string) (*entitie
 	return admin, nil
 }
 
-func (r *adminRepository) GetAll(ctx context.Context) ([]*entities.Admin, error) {
-	var dbAdmins []*models.Admin
+var adminListSpec = listSpec{
+	sortColumns: map[string]string{
+		"admin_name": "admins.admin_name",
+		"username":   "users.username",
+		"created_at": "admins.created_at",
+	},
+	defaultSort:   "created_at",
+	defaultOrder:  "desc",
+	searchColumns: []string{"admins.admin_name", "users.username", "users.email"},
+	dateColumn:    "admins.created_at",
+	idColumn:      "admins.id",
+}
 
-	if err := r.db.WithContext(ctx).
+func (r *adminRepository) GetAll(ctx context.Context, query entities.ListQuery) ([]*entities.Admin, *entities.PageInfo, error) {
+	baseQuery := r.db.WithContext(ctx).
+		Model(&models.Admin{}).
 		Joins("JOIN users ON users.id = admins.user_id").
-		Where("users.is_active = ?", true).
-		Preload("User").
-		Find(&dbAdmins).Error; err != nil {
-		return nil, fmt.Errorf("failed find admins: %w", err)
+		Where("users.is_active = ?", true)
+
+	dbAdmins, pageInfo, err := paginate[models.Admin](baseQuery, query, adminListSpec, func(db *gorm.DB) *gorm.DB {
+		return db.Preload("User")
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed find admins: %w", err)
 	}
 
 	admins := make([]*entities.Admin, 0, len(dbAdmins))
@@ -74,7 +89,7 @@ func (r *adminRepository) GetAll(ctx context.Context) ([]*entities.Admin, error)
 		admins = append(admins, admin)
 	}
 
-	return admins, nil
+	return admins, pageInfo, nil
 }
 
 func (r *adminRepository) Update(ctx context.Context, tx *gorm.DB, admin *entities.Admin) error {
@@ -0,0 +1,133 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type ageCategoryRepository struct {
+	db *gorm.DB
+}
+
+func NewAgeCategoryRepository(db *gorm.DB) repositories.AgeCategoryRepository {
+	return &ageCategoryRepository{
+		db: db,
+	}
+}
+
+func (r *ageCategoryRepository) Create(ctx context.Context, tx *gorm.DB, category *entities.AgeCategory) error {
+	if category == nil {
+		return errors.New("age category cannot be empty")
+	}
+
+	dbCategory := &models.AgeCategory{
+		Name:      category.Name,
+		MinAge:    category.MinAge,
+		MaxAge:    category.MaxAge,
+		SortOrder: category.SortOrder,
+		IsActive:  category.IsActive,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+
+	if err := tx.WithContext(ctx).Create(dbCategory).Error; err != nil {
+		return fmt.Errorf("failed to create age category: %w", err)
+	}
+
+	category.Id = dbCategory.Id
+	return nil
+}
+
+// Update leaves the name alone: observations, questions and thresholds refer
+// to categories by name.
+func (r *ageCategoryRepository) Update(ctx context.Context, tx *gorm.DB, category *entities.AgeCategory) error {
+	if category == nil {
+		return errors.New("age category cannot be empty")
+	}
+
+	if err := tx.WithContext(ctx).
+		Model(&models.AgeCategory{}).
+		Where("id = ?", category.Id).
+		Updates(map[string]interface{}{
+			"min_age":    category.MinAge,
+			"max_age":    category.MaxAge,
+			"sort_order": category.SortOrder,
+			"is_active":  category.IsActive,
+			"updated_at": category.UpdatedAt,
+		}).Error; err != nil {
+		return fmt.Errorf("failed to update age category: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ageCategoryRepository) GetAll(ctx context.Context) ([]*entities.AgeCategory, error) {
+	return r.find(r.db.WithContext(ctx))
+}
+
+func (r *ageCategoryRepository) GetActive(ctx context.Context) ([]*entities.AgeCategory, error) {
+	return r.find(r.db.WithContext(ctx).Where("is_active = ?", true))
+}
+
+func (r *ageCategoryRepository) GetById(ctx context.Context, categoryId int) (*entities.AgeCategory, error) {
+	var dbCategory models.AgeCategory
+
+	if err := r.db.WithContext(ctx).
+		First(&dbCategory, "id = ?", categoryId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("age category with id %d not found", categoryId)
+		}
+		return nil, fmt.Errorf("failed to get age category: %w", err)
+	}
+
+	return r.modelToEntity(&dbCategory), nil
+}
+
+func (r *ageCategoryRepository) ExistByName(ctx context.Context, name string) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).
+		Model(&models.AgeCategory{}).
+		Where("name = ?", name).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check age category: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *ageCategoryRepository) find(query *gorm.DB) ([]*entities.AgeCategory, error) {
+	var dbCategories []*models.AgeCategory
+
+	if err := query.
+		Order("sort_order asc, min_age asc").
+		Find(&dbCategories).Error; err != nil {
+		return nil, fmt.Errorf("failed to get age categories: %w", err)
+	}
+
+	categories := make([]*entities.AgeCategory, 0, len(dbCategories))
+	for _, dbCategory := range dbCategories {
+		categories = append(categories, r.modelToEntity(dbCategory))
+	}
+
+	return categories, nil
+}
+
+func (r *ageCategoryRepository) modelToEntity(dbCategory *models.AgeCategory) *entities.AgeCategory {
+	return &entities.AgeCategory{
+		Id:        dbCategory.Id,
+		Name:      dbCategory.Name,
+		MinAge:    dbCategory.MinAge,
+		MaxAge:    dbCategory.MaxAge,
+		SortOrder: dbCategory.SortOrder,
+		IsActive:  dbCategory.IsActive,
+		CreatedAt: dbCategory.CreatedAt,
+		UpdatedAt: dbCategory.UpdatedAt,
+	}
+}
@@ -0,0 +1,274 @@
+package persistence
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/helpers"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type assessmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAssessmentRepository(db *gorm.DB) repositories.AssessmentRepository {
+	return &assessmentRepository{
+		db: db,
+	}
+}
+
+var assessmentListSpec = listSpec{
+	sortColumns: map[string]string{
+		"scheduled_date":  "assessments.scheduled_date",
+		"therapy_section": "assessments.therapy_section",
+		"created_at":      "assessments.created_at",
+	},
+	defaultSort:   "scheduled_date",
+	defaultOrder:  "asc",
+	searchColumns: []string{"childrens.child_name", "therapists.therapist_name"},
+	dateColumn:    "assessments.scheduled_date",
+	idColumn:      "assessments.id",
+}
+
+func (r *assessmentRepository) Create(ctx context.Context, tx *gorm.DB, assessment *entities.Assessment) error {
+	if assessment == nil {
+		return errors.New("assessment data cannot be empty")
+	}
+
+	dbAssessment := &models.Assessment{
+		ObservationId:  assessment.ObservationId,
+		ChildId:        assessment.ChildId,
+		TherapySection: assessment.TherapySection,
+		AssessorId:     assessment.AssessorId,
+		ScheduledDate:  assessment.ScheduledDate,
+		Status:         assessment.Status,
+	}
+
+	if err := tx.WithContext(ctx).Create(dbAssessment).Error; err != nil {
+		return fmt.Errorf("failed to create assessment: %w", err)
+	}
+
+	assessment.Id = dbAssessment.Id
+	assessment.CreatedAt = dbAssessment.CreatedAt
+	assessment.UpdatedAt = dbAssessment.UpdatedAt
+	return nil
+}
+
+func (r *assessmentRepository) GetAll(ctx context.Context, query entities.ListQuery, status string, assessorId string) ([]*entities.Assessment, *entities.PageInfo, error) {
+	baseQuery := r.db.WithContext(ctx).
+		Model(&models.Assessment{}).
+		Joins("JOIN childrens ON childrens.id = assessments.child_id").
+		Joins("JOIN therapists ON therapists.id = assessments.assessor_id")
+
+	if status != "" {
+		baseQuery = baseQuery.Where("assessments.status = ?", status)
+	}
+	if assessorId != "" {
+		baseQuery = baseQuery.Where("assessments.assessor_id = ?", assessorId)
+	}
+
+	dbAssessments, pageInfo, err := paginate[models.Assessment](baseQuery, query, assessmentListSpec, func(db *gorm.DB) *gorm.DB {
+		return db.
+			Preload("Children").
+			Preload("Assessor")
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get assessments: %w", err)
+	}
+
+	assessments := make([]*entities.Assessment, 0, len(dbAssessments))
+	for _, dbAssessment := range dbAssessments {
+		assessments = append(assessments, r.modelToEntity(dbAssessment))
+	}
+
+	return assessments, pageInfo, nil
+}
+
+func (r *assessmentRepository) GetById(ctx context.Context, assessmentId int) (*entities.Assessment, error) {
+	if assessmentId == 0 {
+		return nil, errors.New("assessmentId cannot be empty")
+	}
+
+	var dbAssessment models.Assessment
+
+	if err := r.db.WithContext(ctx).
+		Preload("Children").
+		Preload("Assessor").
+		First(&dbAssessment, "id = ?", assessmentId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("assessment with id %d not found", assessmentId)
+		}
+		return nil, fmt.Errorf("failed to find assessment by id: %w", err)
+	}
+
+	return r.modelToEntity(&dbAssessment), nil
+}
+
+// LockById holds the assessment row so scores and completion are applied one
+// at a time.
+func (r *assessmentRepository) LockById(ctx context.Context, tx *gorm.DB, assessmentId int) (*entities.Assessment, error) {
+	var dbAssessment models.Assessment
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&dbAssessment, "id = ?", assessmentId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("assessment with id %d not found", assessmentId)
+		}
+		return nil, fmt.Errorf("failed to lock assessment: %w", err)
+	}
+
+	return r.modelToEntity(&dbAssessment), nil
+}
+
+func (r *assessmentRepository) ExistOpenByObservation(ctx context.Context, tx *gorm.DB, observationId int, therapySection string) (bool, error) {
+	var count int64
+
+	if err := tx.WithContext(ctx).
+		Model(&models.Assessment{}).
+		Where("observation_id = ? AND therapy_section = ?", observationId, therapySection).
+		Where("status IN ?", constants.AssessmentOpenStatuses).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check open assessments: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *assessmentRepository) UpdateAssessor(ctx context.Context, tx *gorm.DB, assessmentId int, assessorId string, scheduledDate helpers.DateOnly) error {
+	result := tx.WithContext(ctx).
+		Model(&models.Assessment{}).
+		Where("id = ? AND status IN ?", assessmentId, constants.AssessmentOpenStatuses).
+		Updates(map[string]interface{}{
+			"assessor_id":    assessorId,
+			"scheduled_date": scheduledDate,
+			"updated_at":     time.Now(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update assessor: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repositories.ErrStatusChanged
+	}
+
+	return nil
+}
+
+func (r *assessmentRepository) UpdateStatus(ctx context.Context, tx *gorm.DB, assessmentId int, from constants.AssessmentStatus, to constants.AssessmentStatus) error {
+	result := tx.WithContext(ctx).
+		Model(&models.Assessment{}).
+		Where("id = ? AND status = ?", assessmentId, from).
+		Updates(map[string]interface{}{
+			"status":     string(to),
+			"updated_at": time.Now(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update assessment status: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repositories.ErrStatusChanged
+	}
+
+	return nil
+}
+
+func (r *assessmentRepository) Complete(ctx context.Context, tx *gorm.DB, assessment *entities.Assessment) error {
+	if assessment == nil {
+		return errors.New("assessment data cannot be empty")
+	}
+
+	result := tx.WithContext(ctx).
+		Model(&models.Assessment{}).
+		Where("id = ? AND status IN ?", assessment.Id, constants.AssessmentOpenStatuses).
+		Updates(map[string]interface{}{
+			"status":            string(constants.AssessmentStatusCompleted),
+			"summary":           assessment.Summary,
+			"recommendation":    assessment.Recommendation,
+			"sessions_per_week": assessment.SessionsPerWeek,
+			"completed_at":      assessment.CompletedAt,
+			"updated_at":        time.Now(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to complete assessment: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repositories.ErrStatusChanged
+	}
+
+	return nil
+}
+
+func (r *assessmentRepository) Cancel(ctx context.Context, tx *gorm.DB, assessmentId int, reason string) error {
+	result := tx.WithContext(ctx).
+		Model(&models.Assessment{}).
+		Where("id = ? AND status IN ?", assessmentId, constants.AssessmentOpenStatuses).
+		Updates(map[string]interface{}{
+			"status":        string(constants.AssessmentStatusCancelled),
+			"cancel_reason": reason,
+			"updated_at":    time.Now(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to cancel assessment: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repositories.ErrStatusChanged
+	}
+
+	return nil
+}
+
+func (r *assessmentRepository) modelToEntity(dbAssessment *models.Assessment) *entities.Assessment {
+	assessment := &entities.Assessment{
+		Id:              dbAssessment.Id,
+		ObservationId:   dbAssessment.ObservationId,
+		ChildId:         dbAssessment.ChildId,
+		TherapySection:  dbAssessment.TherapySection,
+		AssessorId:      dbAssessment.AssessorId,
+		ScheduledDate:   dbAssessment.ScheduledDate,
+		Status:          dbAssessment.Status,
+		Summary:         dbAssessment.Summary,
+		Recommendation:  dbAssessment.Recommendation,
+		SessionsPerWeek: dbAssessment.SessionsPerWeek,
+		CancelReason:    dbAssessment.CancelReason,
+		CompletedAt:     dbAssessment.CompletedAt,
+		CreatedAt:       dbAssessment.CreatedAt,
+		UpdatedAt:       dbAssessment.UpdatedAt,
+	}
+
+	if dbAssessment.Children != nil {
+		assessment.Children = &entities.Children{
+			Id:             dbAssessment.Children.Id,
+			ParentId:       dbAssessment.Children.ParentId,
+			ChildName:      dbAssessment.Children.ChildName,
+			ChildGender:    dbAssessment.Children.ChildGender,
+			ChildBirthDate: dbAssessment.Children.ChildBirthDate,
+		}
+	}
+
+	if dbAssessment.Assessor != nil {
+		assessment.Assessor = &entities.Therapist{
+			Id:               dbAssessment.Assessor.Id,
+			UserId:           dbAssessment.Assessor.UserId,
+			TherapistName:    dbAssessment.Assessor.TherapistName,
+			TherapistSection: dbAssessment.Assessor.TherapistSection,
+		}
+	}
+
+	return assessment
+}
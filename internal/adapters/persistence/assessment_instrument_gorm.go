@@ -0,0 +1,171 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type assessmentInstrumentRepository struct {
+	db *gorm.DB
+}
+
+func NewAssessmentInstrumentRepository(db *gorm.DB) repositories.AssessmentInstrumentRepository {
+	return &assessmentInstrumentRepository{
+		db: db,
+	}
+}
+
+func (r *assessmentInstrumentRepository) Create(ctx context.Context, tx *gorm.DB, instrument *entities.AssessmentInstrument) error {
+	if instrument == nil {
+		return errors.New("instrument data cannot be empty")
+	}
+
+	dbInstrument := &models.AssessmentInstrument{
+		Code:           instrument.Code,
+		Name:           instrument.Name,
+		TherapySection: instrument.TherapySection,
+		Description:    instrument.Description,
+		SortOrder:      instrument.SortOrder,
+		IsActive:       instrument.IsActive,
+		CreatedAt:      instrument.CreatedAt,
+		UpdatedAt:      instrument.UpdatedAt,
+	}
+
+	for _, item := range instrument.Items {
+		dbInstrument.Items = append(dbInstrument.Items, models.AssessmentInstrumentItem{
+			ItemNumber: item.ItemNumber,
+			ItemText:   item.ItemText,
+			MaxScore:   item.MaxScore,
+		})
+	}
+
+	if err := tx.WithContext(ctx).Create(dbInstrument).Error; err != nil {
+		return fmt.Errorf("failed to create assessment instrument: %w", err)
+	}
+
+	instrument.Id = dbInstrument.Id
+	return nil
+}
+
+// Update leaves the items alone; scores already recorded refer to them.
+func (r *assessmentInstrumentRepository) Update(ctx context.Context, tx *gorm.DB, instrument *entities.AssessmentInstrument) error {
+	if instrument == nil {
+		return errors.New("instrument data cannot be empty")
+	}
+
+	if err := tx.WithContext(ctx).
+		Model(&models.AssessmentInstrument{}).
+		Where("id = ?", instrument.Id).
+		Updates(map[string]interface{}{
+			"name":        instrument.Name,
+			"description": instrument.Description,
+			"sort_order":  instrument.SortOrder,
+			"is_active":   instrument.IsActive,
+			"updated_at":  instrument.UpdatedAt,
+		}).Error; err != nil {
+		return fmt.Errorf("failed to update assessment instrument: %w", err)
+	}
+
+	return nil
+}
+
+func (r *assessmentInstrumentRepository) GetAll(ctx context.Context, therapySection string) ([]*entities.AssessmentInstrument, error) {
+	query := r.db.WithContext(ctx).Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("item_number asc")
+	})
+	if therapySection != "" {
+		query = query.Where("therapy_section = ?", therapySection)
+	}
+
+	return r.find(query)
+}
+
+func (r *assessmentInstrumentRepository) GetActiveBySection(ctx context.Context, therapySection string) ([]*entities.AssessmentInstrument, error) {
+	query := r.db.WithContext(ctx).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("item_number asc")
+		}).
+		Where("therapy_section = ? AND is_active = ?", therapySection, true)
+
+	return r.find(query)
+}
+
+func (r *assessmentInstrumentRepository) GetById(ctx context.Context, instrumentId int) (*entities.AssessmentInstrument, error) {
+	var dbInstrument models.AssessmentInstrument
+
+	if err := r.db.WithContext(ctx).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("item_number asc")
+		}).
+		First(&dbInstrument, "id = ?", instrumentId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("assessment instrument %d not found", instrumentId)
+		}
+		return nil, fmt.Errorf("failed to get assessment instrument: %w", err)
+	}
+
+	return r.modelToEntity(&dbInstrument), nil
+}
+
+func (r *assessmentInstrumentRepository) ExistByCode(ctx context.Context, code string) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).
+		Model(&models.AssessmentInstrument{}).
+		Where("code = ?", code).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check assessment instrument: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *assessmentInstrumentRepository) find(query *gorm.DB) ([]*entities.AssessmentInstrument, error) {
+	var dbInstruments []*models.AssessmentInstrument
+
+	if err := query.
+		Order("therapy_section asc, sort_order asc, code asc").
+		Find(&dbInstruments).Error; err != nil {
+		return nil, fmt.Errorf("failed to get assessment instruments: %w", err)
+	}
+
+	instruments := make([]*entities.AssessmentInstrument, 0, len(dbInstruments))
+	for _, dbInstrument := range dbInstruments {
+		instruments = append(instruments, r.modelToEntity(dbInstrument))
+	}
+
+	return instruments, nil
+}
+
+func (r *assessmentInstrumentRepository) modelToEntity(dbInstrument *models.AssessmentInstrument) *entities.AssessmentInstrument {
+	instrument := &entities.AssessmentInstrument{
+		Id:             dbInstrument.Id,
+		Code:           dbInstrument.Code,
+		Name:           dbInstrument.Name,
+		TherapySection: dbInstrument.TherapySection,
+		Description:    dbInstrument.Description,
+		SortOrder:      dbInstrument.SortOrder,
+		IsActive:       dbInstrument.IsActive,
+		CreatedAt:      dbInstrument.CreatedAt,
+		UpdatedAt:      dbInstrument.UpdatedAt,
+		Items:          make([]entities.AssessmentInstrumentItem, 0, len(dbInstrument.Items)),
+	}
+
+	for _, dbItem := range dbInstrument.Items {
+		instrument.Items = append(instrument.Items, entities.AssessmentInstrumentItem{
+			Id:           dbItem.Id,
+			InstrumentId: dbItem.InstrumentId,
+			ItemNumber:   dbItem.ItemNumber,
+			ItemText:     dbItem.ItemText,
+			MaxScore:     dbItem.MaxScore,
+		})
+	}
+
+	return instrument
+}
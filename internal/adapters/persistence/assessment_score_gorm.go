@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type assessmentScoreRepository struct {
+	db *gorm.DB
+}
+
+func NewAssessmentScoreRepository(db *gorm.DB) repositories.AssessmentScoreRepository {
+	return &assessmentScoreRepository{
+		db: db,
+	}
+}
+
+// Upsert keeps one score per item, so scores can be saved as the therapist
+// works through the instruments.
+func (r *assessmentScoreRepository) Upsert(ctx context.Context, tx *gorm.DB, scores []*entities.AssessmentScore) error {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	dbScores := make([]*models.AssessmentScore, 0, len(scores))
+	for _, score := range scores {
+		dbScores = append(dbScores, &models.AssessmentScore{
+			AssessmentId: score.AssessmentId,
+			ItemId:       score.ItemId,
+			Score:        score.Score,
+			Note:         score.Note,
+		})
+	}
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "assessment_id"}, {Name: "item_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"score", "note", "updated_at"}),
+		}).
+		Create(&dbScores).Error; err != nil {
+		return fmt.Errorf("failed to save assessment scores: %w", err)
+	}
+
+	return nil
+}
+
+func (r *assessmentScoreRepository) GetByAssessmentId(ctx context.Context, assessmentId int) ([]*entities.AssessmentScore, error) {
+	var dbScores []*models.AssessmentScore
+
+	if err := r.db.WithContext(ctx).
+		Where("assessment_id = ?", assessmentId).
+		Find(&dbScores).Error; err != nil {
+		return nil, fmt.Errorf("failed to get assessment scores: %w", err)
+	}
+
+	scores := make([]*entities.AssessmentScore, 0, len(dbScores))
+	for _, dbScore := range dbScores {
+		scores = append(scores, &entities.AssessmentScore{
+			Id:           dbScore.Id,
+			AssessmentId: dbScore.AssessmentId,
+			ItemId:       dbScore.ItemId,
+			Score:        dbScore.Score,
+			Note:         dbScore.Note,
+			UpdatedAt:    dbScore.UpdatedAt,
+		})
+	}
+
+	return scores, nil
+}
@@ -0,0 +1,400 @@
+package persistence
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type carePlanRepository struct {
+	db *gorm.DB
+}
+
+func NewCarePlanRepository(db *gorm.DB) repositories.CarePlanRepository {
+	return &carePlanRepository{
+		db: db,
+	}
+}
+
+// GetOrCreateByChildId relies on the unique child_id so two transactions
+// opening the plan at once end up with the same row, which is then locked.
+func (r *carePlanRepository) GetOrCreateByChildId(ctx context.Context, tx *gorm.DB, childId string) (*entities.CarePlan, error) {
+	if childId == "" {
+		return nil, errors.New("childId cannot be empty")
+	}
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.CarePlan{ChildId: childId}).Error; err != nil {
+		return nil, fmt.Errorf("failed to open care plan: %w", err)
+	}
+
+	var dbPlan models.CarePlan
+	if err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&dbPlan, "child_id = ?", childId).Error; err != nil {
+		return nil, fmt.Errorf("failed to get care plan: %w", err)
+	}
+
+	return r.modelToEntity(&dbPlan), nil
+}
+
+func (r *carePlanRepository) AddEntry(ctx context.Context, tx *gorm.DB, entry *entities.CarePlanEntry) error {
+	if entry == nil {
+		return errors.New("care plan entry cannot be empty")
+	}
+
+	dbEntry := &models.CarePlanEntry{
+		CarePlanId:      entry.CarePlanId,
+		TherapySection:  entry.TherapySection,
+		AssessmentId:    entry.AssessmentId,
+		Summary:         entry.Summary,
+		Recommendation:  entry.Recommendation,
+		SessionsPerWeek: entry.SessionsPerWeek,
+	}
+
+	if err := tx.WithContext(ctx).Create(dbEntry).Error; err != nil {
+		return fmt.Errorf("failed to add care plan entry: %w", err)
+	}
+
+	entry.Id = dbEntry.Id
+	entry.CreatedAt = dbEntry.CreatedAt
+	return nil
+}
+
+func (r *carePlanRepository) GetByChildId(ctx context.Context, childId string) (*entities.CarePlan, error) {
+	var dbPlan models.CarePlan
+
+	if err := r.db.WithContext(ctx).
+		Preload("Entries", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at asc, id asc")
+		}).
+		First(&dbPlan, "child_id = ?", childId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get care plan: %w", err)
+	}
+
+	return r.modelToEntity(&dbPlan), nil
+}
+
+var overdueEvaluationListSpec = listSpec{
+	sortColumns: map[string]string{
+		"next_evaluation_date": "care_plans.next_evaluation_date",
+		"child_name":           "childrens.child_name",
+	},
+	defaultSort:   "next_evaluation_date",
+	defaultOrder:  "asc",
+	searchColumns: []string{"childrens.child_name"},
+	dateColumn:    "care_plans.next_evaluation_date",
+	idColumn:      "care_plans.id",
+}
+
+func (r *carePlanRepository) UpdateProgramme(ctx context.Context, tx *gorm.DB, carePlan *entities.CarePlan) error {
+	if carePlan == nil {
+		return errors.New("care plan cannot be empty")
+	}
+
+	if err := tx.WithContext(ctx).
+		Model(&models.CarePlan{}).
+		Where("id = ?", carePlan.Id).
+		Updates(map[string]interface{}{
+			"programme_status":     carePlan.ProgrammeStatus,
+			"next_evaluation_date": carePlan.NextEvaluationDate,
+			"last_evaluated_on":    carePlan.LastEvaluatedOn,
+			"updated_at":           time.Now(),
+		}).Error; err != nil {
+		return fmt.Errorf("failed to update care plan programme: %w", err)
+	}
+
+	return nil
+}
+
+func (r *carePlanRepository) GetOverdue(ctx context.Context, query entities.ListQuery, today time.Time) ([]*entities.CarePlan, *entities.PageInfo, error) {
+	baseQuery := r.db.WithContext(ctx).
+		Model(&models.CarePlan{}).
+		Joins("JOIN childrens ON childrens.id = care_plans.child_id").
+		Where("care_plans.programme_status IN ?", constants.ProgrammeOngoingStatuses).
+		Where("care_plans.next_evaluation_date < ?", today.Format(entities.DateLayout))
+
+	dbPlans, pageInfo, err := paginate[models.CarePlan](baseQuery, query, overdueEvaluationListSpec, func(db *gorm.DB) *gorm.DB {
+		return db.Preload("Children")
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get overdue evaluations: %w", err)
+	}
+
+	plans := make([]*entities.CarePlan, 0, len(dbPlans))
+	for _, dbPlan := range dbPlans {
+		plans = append(plans, r.modelToEntity(dbPlan))
+	}
+
+	return plans, pageInfo, nil
+}
+
+func (r *carePlanRepository) AddGoal(ctx context.Context, tx *gorm.DB, goal *entities.CarePlanGoal) error {
+	if goal == nil {
+		return errors.New("care plan goal cannot be empty")
+	}
+
+	dbGoal := &models.CarePlanGoal{
+		CarePlanId:          goal.CarePlanId,
+		TherapySection:      goal.TherapySection,
+		Description:         goal.Description,
+		Baseline:            goal.Baseline,
+		Target:              goal.Target,
+		MeasurementCriteria: goal.MeasurementCriteria,
+		ReviewDate:          goal.ReviewDate,
+		Status:              goal.Status,
+	}
+
+	if err := tx.WithContext(ctx).Create(dbGoal).Error; err != nil {
+		return fmt.Errorf("failed to add care plan goal: %w", err)
+	}
+
+	goal.Id = dbGoal.Id
+	goal.CreatedAt = dbGoal.CreatedAt
+	goal.UpdatedAt = dbGoal.UpdatedAt
+	return nil
+}
+
+func (r *carePlanRepository) UpdateGoal(ctx context.Context, tx *gorm.DB, goal *entities.CarePlanGoal) error {
+	if goal == nil {
+		return errors.New("care plan goal cannot be empty")
+	}
+
+	if err := tx.WithContext(ctx).
+		Model(&models.CarePlanGoal{}).
+		Where("id = ?", goal.Id).
+		Updates(map[string]interface{}{
+			"description":          goal.Description,
+			"baseline":             goal.Baseline,
+			"target":               goal.Target,
+			"measurement_criteria": goal.MeasurementCriteria,
+			"review_date":          goal.ReviewDate,
+			"status":               goal.Status,
+			"updated_at":           time.Now(),
+		}).Error; err != nil {
+		return fmt.Errorf("failed to update care plan goal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *carePlanRepository) GetGoalById(ctx context.Context, goalId int) (*entities.CarePlanGoal, error) {
+	if goalId == 0 {
+		return nil, errors.New("goalId cannot be empty")
+	}
+
+	var dbGoal models.CarePlanGoal
+
+	if err := r.db.WithContext(ctx).
+		Preload("CarePlan").
+		First(&dbGoal, "id = ?", goalId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("care plan goal with id %d not found", goalId)
+		}
+		return nil, fmt.Errorf("failed to find care plan goal by id: %w", err)
+	}
+
+	goal := r.goalToEntity(&dbGoal)
+	if err := r.attachLatest(ctx, []*entities.CarePlanGoal{goal}); err != nil {
+		return nil, err
+	}
+
+	return goal, nil
+}
+
+func (r *carePlanRepository) GetGoalsByChildId(ctx context.Context, childId string) ([]*entities.CarePlanGoal, error) {
+	var dbGoals []*models.CarePlanGoal
+
+	if err := r.db.WithContext(ctx).
+		Joins("CarePlan").
+		Where("CarePlan.child_id = ?", childId).
+		Order("care_plan_goals.therapy_section, care_plan_goals.id").
+		Find(&dbGoals).Error; err != nil {
+		return nil, fmt.Errorf("failed to get care plan goals: %w", err)
+	}
+
+	goals := make([]*entities.CarePlanGoal, 0, len(dbGoals))
+	for _, dbGoal := range dbGoals {
+		goals = append(goals, r.goalToEntity(dbGoal))
+	}
+
+	if err := r.attachLatest(ctx, goals); err != nil {
+		return nil, err
+	}
+
+	return goals, nil
+}
+
+func (r *carePlanRepository) ReplaceSessionMeasurements(ctx context.Context, tx *gorm.DB, sessionId int, measurements []entities.CarePlanGoalMeasurement) error {
+	if err := tx.WithContext(ctx).
+		Where("session_id = ?", sessionId).
+		Delete(&models.CarePlanGoalMeasurement{}).Error; err != nil {
+		return fmt.Errorf("failed to clear session measurements: %w", err)
+	}
+
+	if len(measurements) == 0 {
+		return nil
+	}
+
+	dbMeasurements := make([]models.CarePlanGoalMeasurement, 0, len(measurements))
+	for _, measurement := range measurements {
+		dbMeasurements = append(dbMeasurements, models.CarePlanGoalMeasurement{
+			GoalId:      measurement.GoalId,
+			SessionId:   &sessionId,
+			TherapistId: measurement.TherapistId,
+			Value:       measurement.Value,
+			Note:        measurement.Note,
+			MeasuredOn:  measurement.MeasuredOn,
+		})
+	}
+
+	if err := tx.WithContext(ctx).Create(&dbMeasurements).Error; err != nil {
+		return fmt.Errorf("failed to save session measurements: %w", err)
+	}
+
+	return nil
+}
+
+func (r *carePlanRepository) AddMeasurements(ctx context.Context, tx *gorm.DB, measurements []entities.CarePlanGoalMeasurement) error {
+	if len(measurements) == 0 {
+		return nil
+	}
+
+	dbMeasurements := make([]models.CarePlanGoalMeasurement, 0, len(measurements))
+	for _, measurement := range measurements {
+		dbMeasurements = append(dbMeasurements, models.CarePlanGoalMeasurement{
+			GoalId:       measurement.GoalId,
+			SessionId:    measurement.SessionId,
+			EvaluationId: measurement.EvaluationId,
+			TherapistId:  measurement.TherapistId,
+			Value:        measurement.Value,
+			Note:         measurement.Note,
+			MeasuredOn:   measurement.MeasuredOn,
+		})
+	}
+
+	if err := tx.WithContext(ctx).Create(&dbMeasurements).Error; err != nil {
+		return fmt.Errorf("failed to save goal measurements: %w", err)
+	}
+
+	return nil
+}
+
+// attachLatest sets each goal's most recent measurement; readings on the same
+// day are ordered by when they were saved.
+func (r *carePlanRepository) attachLatest(ctx context.Context, goals []*entities.CarePlanGoal) error {
+	if len(goals) == 0 {
+		return nil
+	}
+
+	byId := make(map[int]*entities.CarePlanGoal, len(goals))
+	goalIds := make([]int, 0, len(goals))
+	for _, goal := range goals {
+		byId[goal.Id] = goal
+		goalIds = append(goalIds, goal.Id)
+	}
+
+	var dbMeasurements []*models.CarePlanGoalMeasurement
+	if err := r.db.WithContext(ctx).
+		Where("goal_id IN ?", goalIds).
+		Order("measured_on desc, id desc").
+		Find(&dbMeasurements).Error; err != nil {
+		return fmt.Errorf("failed to get goal measurements: %w", err)
+	}
+
+	for _, dbMeasurement := range dbMeasurements {
+		goal := byId[dbMeasurement.GoalId]
+		if goal.Latest != nil {
+			continue
+		}
+		goal.Latest = measurementToEntity(dbMeasurement)
+	}
+
+	return nil
+}
+
+func (r *carePlanRepository) modelToEntity(dbPlan *models.CarePlan) *entities.CarePlan {
+	plan := &entities.CarePlan{
+		Id:                 dbPlan.Id,
+		ChildId:            dbPlan.ChildId,
+		ProgrammeStatus:    dbPlan.ProgrammeStatus,
+		NextEvaluationDate: dbPlan.NextEvaluationDate,
+		LastEvaluatedOn:    dbPlan.LastEvaluatedOn,
+		CreatedAt:          dbPlan.CreatedAt,
+		UpdatedAt:          dbPlan.UpdatedAt,
+		Entries:            make([]entities.CarePlanEntry, 0, len(dbPlan.Entries)),
+	}
+
+	if dbPlan.Children != nil {
+		plan.Children = &entities.Children{
+			Id:             dbPlan.Children.Id,
+			ParentId:       dbPlan.Children.ParentId,
+			ChildName:      dbPlan.Children.ChildName,
+			ChildGender:    dbPlan.Children.ChildGender,
+			ChildBirthDate: dbPlan.Children.ChildBirthDate,
+		}
+	}
+
+	for _, dbEntry := range dbPlan.Entries {
+		plan.Entries = append(plan.Entries, entities.CarePlanEntry{
+			Id:              dbEntry.Id,
+			CarePlanId:      dbEntry.CarePlanId,
+			TherapySection:  dbEntry.TherapySection,
+			AssessmentId:    dbEntry.AssessmentId,
+			Summary:         dbEntry.Summary,
+			Recommendation:  dbEntry.Recommendation,
+			SessionsPerWeek: dbEntry.SessionsPerWeek,
+			CreatedAt:       dbEntry.CreatedAt,
+		})
+	}
+
+	return plan
+}
+
+func (r *carePlanRepository) goalToEntity(dbGoal *models.CarePlanGoal) *entities.CarePlanGoal {
+	goal := &entities.CarePlanGoal{
+		Id:                  dbGoal.Id,
+		CarePlanId:          dbGoal.CarePlanId,
+		TherapySection:      dbGoal.TherapySection,
+		Description:         dbGoal.Description,
+		Baseline:            dbGoal.Baseline,
+		Target:              dbGoal.Target,
+		MeasurementCriteria: dbGoal.MeasurementCriteria,
+		ReviewDate:          dbGoal.ReviewDate,
+		Status:              dbGoal.Status,
+		CreatedAt:           dbGoal.CreatedAt,
+		UpdatedAt:           dbGoal.UpdatedAt,
+	}
+
+	if dbGoal.CarePlan != nil {
+		goal.ChildId = dbGoal.CarePlan.ChildId
+	}
+
+	return goal
+}
+
+func measurementToEntity(dbMeasurement *models.CarePlanGoalMeasurement) *entities.CarePlanGoalMeasurement {
+	return &entities.CarePlanGoalMeasurement{
+		Id:           dbMeasurement.Id,
+		GoalId:       dbMeasurement.GoalId,
+		SessionId:    dbMeasurement.SessionId,
+		EvaluationId: dbMeasurement.EvaluationId,
+		TherapistId:  dbMeasurement.TherapistId,
+		Value:        dbMeasurement.Value,
+		Note:         dbMeasurement.Note,
+		MeasuredOn:   dbMeasurement.MeasuredOn,
+		CreatedAt:    dbMeasurement.CreatedAt,
+	}
+}
@@ -0,0 +1,282 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type caseConferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewCaseConferenceRepository(db *gorm.DB) repositories.CaseConferenceRepository {
+	return &caseConferenceRepository{
+		db: db,
+	}
+}
+
+var caseConferenceListSpec = listSpec{
+	sortColumns: map[string]string{
+		"scheduled_at": "case_conferences.scheduled_at",
+		"created_at":   "case_conferences.created_at",
+	},
+	defaultSort:   "scheduled_at",
+	defaultOrder:  "desc",
+	searchColumns: []string{"childrens.child_name", "case_conferences.location"},
+	dateColumn:    "case_conferences.scheduled_at",
+	idColumn:      "case_conferences.id",
+}
+
+func (r *caseConferenceRepository) Create(ctx context.Context, tx *gorm.DB, conference *entities.CaseConference) error {
+	if conference == nil {
+		return errors.New("case conference data cannot be empty")
+	}
+
+	dbConference := &models.CaseConference{
+		ChildId:       conference.ChildId,
+		ScheduledAt:   conference.ScheduledAt,
+		Location:      conference.Location,
+		Status:        conference.Status,
+		Agenda:        conference.Agenda,
+		ParentConsent: conference.ParentConsent,
+	}
+
+	for _, participant := range conference.Participants {
+		dbConference.Participants = append(dbConference.Participants, models.CaseConferenceParticipant{
+			TherapistId: participant.TherapistId,
+		})
+	}
+
+	for _, section := range conference.Sections {
+		dbConference.Sections = append(dbConference.Sections, models.CaseConferenceSection{
+			TherapySection:  section.TherapySection,
+			SessionsPerWeek: section.SessionsPerWeek,
+		})
+	}
+
+	if err := tx.WithContext(ctx).Create(dbConference).Error; err != nil {
+		return fmt.Errorf("failed to create case conference: %w", err)
+	}
+
+	conference.Id = dbConference.Id
+	conference.CreatedAt = dbConference.CreatedAt
+	conference.UpdatedAt = dbConference.UpdatedAt
+	return nil
+}
+
+func (r *caseConferenceRepository) Update(ctx context.Context, tx *gorm.DB, conference *entities.CaseConference) error {
+	if conference == nil {
+		return errors.New("case conference data cannot be empty")
+	}
+
+	result := tx.WithContext(ctx).
+		Model(&models.CaseConference{}).
+		Where("id = ?", conference.Id).
+		Updates(map[string]interface{}{
+			"scheduled_at":        conference.ScheduledAt,
+			"location":            conference.Location,
+			"status":              conference.Status,
+			"agenda":              conference.Agenda,
+			"decisions":           conference.Decisions,
+			"parent_consent":      conference.ParentConsent,
+			"consent_note":        conference.ConsentNote,
+			"consent_recorded_at": conference.ConsentRecordedAt,
+			"updated_at":          time.Now(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update case conference: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *caseConferenceRepository) ReplaceParticipants(ctx context.Context, tx *gorm.DB, conferenceId int, therapistIds []string) error {
+	if err := tx.WithContext(ctx).
+		Where("conference_id = ?", conferenceId).
+		Delete(&models.CaseConferenceParticipant{}).Error; err != nil {
+		return fmt.Errorf("failed to clear case conference participants: %w", err)
+	}
+
+	if len(therapistIds) == 0 {
+		return nil
+	}
+
+	dbParticipants := make([]models.CaseConferenceParticipant, 0, len(therapistIds))
+	for _, therapistId := range therapistIds {
+		dbParticipants = append(dbParticipants, models.CaseConferenceParticipant{
+			ConferenceId: conferenceId,
+			TherapistId:  therapistId,
+		})
+	}
+
+	if err := tx.WithContext(ctx).Create(&dbParticipants).Error; err != nil {
+		return fmt.Errorf("failed to save case conference participants: %w", err)
+	}
+
+	return nil
+}
+
+func (r *caseConferenceRepository) ReplaceSections(ctx context.Context, tx *gorm.DB, conferenceId int, sections []entities.CaseConferenceSection) error {
+	if err := tx.WithContext(ctx).
+		Where("conference_id = ?", conferenceId).
+		Delete(&models.CaseConferenceSection{}).Error; err != nil {
+		return fmt.Errorf("failed to clear case conference sections: %w", err)
+	}
+
+	if len(sections) == 0 {
+		return nil
+	}
+
+	dbSections := make([]models.CaseConferenceSection, 0, len(sections))
+	for _, section := range sections {
+		dbSections = append(dbSections, models.CaseConferenceSection{
+			ConferenceId:    conferenceId,
+			TherapySection:  section.TherapySection,
+			SessionsPerWeek: section.SessionsPerWeek,
+		})
+	}
+
+	if err := tx.WithContext(ctx).Create(&dbSections).Error; err != nil {
+		return fmt.Errorf("failed to save case conference sections: %w", err)
+	}
+
+	return nil
+}
+
+func (r *caseConferenceRepository) GetAll(ctx context.Context, query entities.ListQuery, childId string, status string, therapistId string) ([]*entities.CaseConference, *entities.PageInfo, error) {
+	baseQuery := r.db.WithContext(ctx).
+		Model(&models.CaseConference{}).
+		Joins("JOIN childrens ON childrens.id = case_conferences.child_id")
+
+	if childId != "" {
+		baseQuery = baseQuery.Where("case_conferences.child_id = ?", childId)
+	}
+	if status != "" {
+		baseQuery = baseQuery.Where("case_conferences.status = ?", status)
+	}
+	if therapistId != "" {
+		baseQuery = baseQuery.Where(
+			"EXISTS (SELECT 1 FROM case_conference_participants p WHERE p.conference_id = case_conferences.id AND p.therapist_id = ?)",
+			therapistId,
+		)
+	}
+
+	dbConferences, pageInfo, err := paginate[models.CaseConference](baseQuery, query, caseConferenceListSpec, func(db *gorm.DB) *gorm.DB {
+		return db.
+			Preload("Children").
+			Preload("Participants.Therapist").
+			Preload("Sections")
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get case conferences: %w", err)
+	}
+
+	conferences := make([]*entities.CaseConference, 0, len(dbConferences))
+	for _, dbConference := range dbConferences {
+		conferences = append(conferences, r.modelToEntity(dbConference))
+	}
+
+	return conferences, pageInfo, nil
+}
+
+func (r *caseConferenceRepository) GetById(ctx context.Context, conferenceId int) (*entities.CaseConference, error) {
+	if conferenceId == 0 {
+		return nil, errors.New("conferenceId cannot be empty")
+	}
+
+	var dbConference models.CaseConference
+
+	if err := r.db.WithContext(ctx).
+		Preload("Children").
+		Preload("Participants.Therapist").
+		Preload("Sections").
+		First(&dbConference, "id = ?", conferenceId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("case conference with id %d not found", conferenceId)
+		}
+		return nil, fmt.Errorf("failed to find case conference by id: %w", err)
+	}
+
+	return r.modelToEntity(&dbConference), nil
+}
+
+// LockById holds the conference row so concurrent edits of the minutes are
+// applied one after the other.
+func (r *caseConferenceRepository) LockById(ctx context.Context, tx *gorm.DB, conferenceId int) (*entities.CaseConference, error) {
+	var dbConference models.CaseConference
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&dbConference, "id = ?", conferenceId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("case conference with id %d not found", conferenceId)
+		}
+		return nil, fmt.Errorf("failed to lock case conference: %w", err)
+	}
+
+	return r.modelToEntity(&dbConference), nil
+}
+
+func (r *caseConferenceRepository) modelToEntity(dbConference *models.CaseConference) *entities.CaseConference {
+	conference := &entities.CaseConference{
+		Id:                dbConference.Id,
+		ChildId:           dbConference.ChildId,
+		ScheduledAt:       dbConference.ScheduledAt,
+		Location:          dbConference.Location,
+		Status:            dbConference.Status,
+		Agenda:            dbConference.Agenda,
+		Decisions:         dbConference.Decisions,
+		ParentConsent:     dbConference.ParentConsent,
+		ConsentNote:       dbConference.ConsentNote,
+		ConsentRecordedAt: dbConference.ConsentRecordedAt,
+		CreatedAt:         dbConference.CreatedAt,
+		UpdatedAt:         dbConference.UpdatedAt,
+	}
+
+	if dbConference.Children != nil {
+		conference.Children = &entities.Children{
+			Id:             dbConference.Children.Id,
+			ParentId:       dbConference.Children.ParentId,
+			ChildName:      dbConference.Children.ChildName,
+			ChildGender:    dbConference.Children.ChildGender,
+			ChildBirthDate: dbConference.Children.ChildBirthDate,
+		}
+	}
+
+	for _, dbParticipant := range dbConference.Participants {
+		participant := entities.CaseConferenceParticipant{
+			ConferenceId: dbParticipant.ConferenceId,
+			TherapistId:  dbParticipant.TherapistId,
+		}
+
+		if dbParticipant.Therapist != nil {
+			participant.Therapist = &entities.Therapist{
+				Id:               dbParticipant.Therapist.Id,
+				UserId:           dbParticipant.Therapist.UserId,
+				TherapistName:    dbParticipant.Therapist.TherapistName,
+				TherapistSection: dbParticipant.Therapist.TherapistSection,
+			}
+		}
+
+		conference.Participants = append(conference.Participants, participant)
+	}
+
+	for _, dbSection := range dbConference.Sections {
+		conference.Sections = append(conference.Sections, entities.CaseConferenceSection{
+			ConferenceId:    dbSection.ConferenceId,
+			TherapySection:  dbSection.TherapySection,
+			SessionsPerWeek: dbSection.SessionsPerWeek,
+		})
+	}
+
+	return conference
+}
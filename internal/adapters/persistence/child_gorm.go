@@ -4,11 +4,13 @@ import (
 	"backend-golang/internal/domain/entities"
 	"backend-golang/internal/domain/repositories"
 	models2 "backend-golang/internal/infrastructure/database/models"
+	"errors"
 	"fmt"
 
 	"context"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type childRepository struct {
@@ -30,6 +32,7 @@ func (r *childRepository) Create(ctx context.Context, tx *gorm.DB, child *entiti
 		ChildBirthPlace:    child.ChildBirthPlace,
 		ChildBirthDate:     child.ChildBirthDate,
 		ChildAddress:       child.ChildAddress,
+		ChildAddressIndex:  child.ChildAddressIndex,
 		ChildComplaint:     child.ChildComplaint,
 		ChildSchool:        child.ChildSchool,
 		ChildServiceChoice: child.ChildServiceChoice,
@@ -44,37 +47,89 @@ func (r *childRepository) Create(ctx context.Context, tx *gorm.DB, child *entiti
 	return nil
 }
 
-//func (r *childRepository) GetById(ctx context.Context, childId string) (*entities.Children, error) {
-//	var dbChild *models.Children
-//
-//	if err := r.db.WithContext(ctx).
-//		Preload("Parent").
-//		Preload("ParentDetail").
-//		Where("child_id = ?", childId).
-//		First(&dbChild).Error; err != nil {
-//		if errors.Is(err, gorm.ErrRecordNotFound) {
-//			return nil, fmt.Errorf("child not found")
-//		}
-//		return nil, fmt.Errorf("failed to get children by id: %w", err)
-//	}
-//
-//	child := r.modelToEntity(dbChild)
-//	if child == nil {
-//		return nil, fmt.Errorf("failed to get children by id")
-//	}
-//
-//	return child, nil
-//}
-
-func (r *childRepository) GetAll(ctx context.Context) ([]*entities.Children, error) {
-	var dbChilds []*models2.Children
+// A verified parent's email lives on their user; temp_email only holds it
+// until the parent is verified.
+var childListSpec = listSpec{
+	sortColumns: map[string]string{
+		"child_name":       "childrens.child_name",
+		"child_birth_date": "childrens.child_birth_date",
+		"created_at":       "childrens.created_at",
+	},
+	defaultSort:   "created_at",
+	defaultOrder:  "desc",
+	searchColumns: []string{"childrens.child_name", "childrens.child_school", "users.email", "parents.temp_email"},
+	dateColumn:    "childrens.created_at",
+	idColumn:      "childrens.id",
+}
+
+func (r *childRepository) GetAll(ctx context.Context, query entities.ListQuery) ([]*entities.Children, *entities.PageInfo, error) {
+	baseQuery := r.db.WithContext(ctx).
+		Model(&models2.Children{}).
+		Joins("JOIN parents ON parents.id = childrens.parent_id").
+		Joins("LEFT JOIN users ON users.id = parents.user_id")
+
+	dbChilds, pageInfo, err := paginate[models2.Children](baseQuery, query, childListSpec, func(db *gorm.DB) *gorm.DB {
+		return db.
+			Preload("Parent").
+			Preload("Parent.ParentDetail")
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get children: %w", err)
+	}
+
+	children := make([]*entities.Children, 0, len(dbChilds))
+	for _, dbChild := range dbChilds {
+		child := r.modelToEntity(dbChild)
+		children = append(children, child)
+	}
+
+	return children, pageInfo, nil
+}
+
+func (r *childRepository) GetById(ctx context.Context, childId string) (*entities.Children, error) {
+	if childId == "" {
+		return nil, errors.New("childId cannot be empty")
+	}
+
+	var dbChild models2.Children
 
 	if err := r.db.WithContext(ctx).
 		Preload("Parent").
 		Preload("Parent.ParentDetail").
-		Order("created_at desc").
+		Preload("Observations", func(db *gorm.DB) *gorm.DB {
+			return db.Order("scheduled_date asc, id asc")
+		}).
+		Where("id = ?", childId).
+		First(&dbChild).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("child not found")
+		}
+		return nil, fmt.Errorf("failed to get children by id: %w", err)
+	}
+
+	child := r.modelToEntity(&dbChild)
+	if child == nil {
+		return nil, fmt.Errorf("failed to get children by id")
+	}
+
+	return child, nil
+}
+
+func (r *childRepository) GetByParentId(ctx context.Context, parentId string) ([]*entities.Children, error) {
+	if parentId == "" {
+		return nil, errors.New("parentId cannot be empty")
+	}
+
+	var dbChilds []*models2.Children
+
+	if err := r.db.WithContext(ctx).
+		Preload("Observations", func(db *gorm.DB) *gorm.DB {
+			return db.Order("scheduled_date asc, id asc")
+		}).
+		Where("parent_id = ?", parentId).
+		Order("created_at asc").
 		Find(&dbChilds).Error; err != nil {
-		return nil, fmt.Errorf("failed to get children: %w", err)
+		return nil, fmt.Errorf("failed to get children by parent id: %w", err)
 	}
 
 	children := make([]*entities.Children, 0, len(dbChilds))
@@ -86,6 +141,24 @@ func (r *childRepository) GetAll(ctx context.Context) ([]*entities.Children, err
 	return children, nil
 }
 
+// LockById holds the child row so observations for the same child are opened
+// one at a time.
+func (r *childRepository) LockById(ctx context.Context, tx *gorm.DB, childId string) error {
+	var dbChild models2.Children
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		First(&dbChild, "id = ?", childId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("child not found")
+		}
+		return fmt.Errorf("failed to lock child: %w", err)
+	}
+
+	return nil
+}
+
 func (r *childRepository) modelToEntity(dbChildren *models2.Children) *entities.Children {
 	child := &entities.Children{
 		Id:                 dbChildren.Id,
@@ -106,12 +179,18 @@ func (r *childRepository) modelToEntity(dbChildren *models2.Children) *entities.
 		child.Parent = r.modelToParentEntity(dbChildren.Parent)
 	}
 
+	child.Observations = make([]*entities.Observation, 0, len(dbChildren.Observations))
+	for i := range dbChildren.Observations {
+		child.Observations = append(child.Observations, r.modelToObservationEntity(&dbChildren.Observations[i]))
+	}
+
 	return child
 }
 
 func (r *childRepository) modelToParentEntity(dbParent *models2.Parent) *entities.Parent {
 	parent := &entities.Parent{
 		Id:                 dbParent.Id,
+		UserId:             dbParent.UserId,
 		TempEmail:          dbParent.TempEmail,
 		RegistrationStatus: dbParent.RegistrationStatus,
 		CreatedAt:          dbParent.CreatedAt,
@@ -138,9 +217,28 @@ func (r *childRepository) modelToParentDetailEntity(dbParentDetail *models2.Pare
 		ParentType:  dbParentDetail.ParentType,
 		ParentName:  dbParentDetail.ParentName,
 		ParentPhone: dbParentDetail.ParentPhone,
+		IsPrimary:   dbParentDetail.IsPrimary,
 		CreatedAt:   dbParentDetail.CreatedAt,
 		UpdatedAt:   dbParentDetail.UpdatedAt,
 	}
 
 	return parentDetail
 }
+
+func (r *childRepository) modelToObservationEntity(dbObservation *models2.Observation) *entities.Observation {
+	return &entities.Observation{
+		Id:             dbObservation.Id,
+		ChildId:        dbObservation.ChildId,
+		TherapistId:    stringValue(dbObservation.TherapistId),
+		ScheduledDate:  dbObservation.ScheduledDate,
+		AgeCategory:    dbObservation.AgeCategory,
+		TotalScore:     dbObservation.TotalScore,
+		RiskLevel:      dbObservation.RiskLevel,
+		TherapySection: dbObservation.TherapySection,
+		Conclusion:     dbObservation.Conclusion,
+		Recommendation: dbObservation.Recommendation,
+		Status:         dbObservation.Status,
+		CreatedAt:      dbObservation.CreatedAt,
+		UpdatedAt:      dbObservation.UpdatedAt,
+	}
+}
@@ -0,0 +1,139 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type evaluationRepository struct {
+	db *gorm.DB
+}
+
+func NewEvaluationRepository(db *gorm.DB) repositories.EvaluationRepository {
+	return &evaluationRepository{
+		db: db,
+	}
+}
+
+func (r *evaluationRepository) Create(ctx context.Context, tx *gorm.DB, evaluation *entities.Evaluation) error {
+	if evaluation == nil {
+		return errors.New("evaluation data cannot be empty")
+	}
+
+	dbEvaluation := &models.Evaluation{
+		ChildId:            evaluation.ChildId,
+		EvaluationDate:     evaluation.EvaluationDate,
+		Summary:            evaluation.Summary,
+		Decision:           evaluation.Decision,
+		ProgrammeStatus:    evaluation.ProgrammeStatus,
+		NextEvaluationDate: evaluation.NextEvaluationDate,
+		ObservationId:      evaluation.ObservationId,
+		RecordedBy:         evaluation.RecordedBy,
+	}
+
+	for _, goal := range evaluation.Goals {
+		dbEvaluation.Goals = append(dbEvaluation.Goals, models.EvaluationGoal{
+			GoalId:         goal.GoalId,
+			TherapySection: goal.TherapySection,
+			Description:    goal.Description,
+			Value:          goal.Value,
+			Attainment:     goal.Attainment,
+			Status:         goal.Status,
+			Note:           goal.Note,
+		})
+	}
+
+	if err := tx.WithContext(ctx).Create(dbEvaluation).Error; err != nil {
+		return fmt.Errorf("failed to create evaluation: %w", err)
+	}
+
+	evaluation.Id = dbEvaluation.Id
+	evaluation.CreatedAt = dbEvaluation.CreatedAt
+	return nil
+}
+
+func (r *evaluationRepository) GetById(ctx context.Context, evaluationId int) (*entities.Evaluation, error) {
+	if evaluationId == 0 {
+		return nil, errors.New("evaluationId cannot be empty")
+	}
+
+	var dbEvaluation models.Evaluation
+
+	if err := r.db.WithContext(ctx).
+		Preload("Children").
+		Preload("Goals", func(db *gorm.DB) *gorm.DB {
+			return db.Order("therapy_section, goal_id")
+		}).
+		First(&dbEvaluation, "id = ?", evaluationId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("evaluation with id %d not found", evaluationId)
+		}
+		return nil, fmt.Errorf("failed to find evaluation by id: %w", err)
+	}
+
+	return r.modelToEntity(&dbEvaluation), nil
+}
+
+func (r *evaluationRepository) GetByChildId(ctx context.Context, childId string) ([]*entities.Evaluation, error) {
+	var dbEvaluations []*models.Evaluation
+
+	if err := r.db.WithContext(ctx).
+		Where("child_id = ?", childId).
+		Order("evaluation_date desc, id desc").
+		Find(&dbEvaluations).Error; err != nil {
+		return nil, fmt.Errorf("failed to get evaluations: %w", err)
+	}
+
+	evaluations := make([]*entities.Evaluation, 0, len(dbEvaluations))
+	for _, dbEvaluation := range dbEvaluations {
+		evaluations = append(evaluations, r.modelToEntity(dbEvaluation))
+	}
+
+	return evaluations, nil
+}
+
+func (r *evaluationRepository) modelToEntity(dbEvaluation *models.Evaluation) *entities.Evaluation {
+	evaluation := &entities.Evaluation{
+		Id:                 dbEvaluation.Id,
+		ChildId:            dbEvaluation.ChildId,
+		EvaluationDate:     dbEvaluation.EvaluationDate,
+		Summary:            dbEvaluation.Summary,
+		Decision:           dbEvaluation.Decision,
+		ProgrammeStatus:    dbEvaluation.ProgrammeStatus,
+		NextEvaluationDate: dbEvaluation.NextEvaluationDate,
+		ObservationId:      dbEvaluation.ObservationId,
+		RecordedBy:         dbEvaluation.RecordedBy,
+		CreatedAt:          dbEvaluation.CreatedAt,
+	}
+
+	if dbEvaluation.Children != nil {
+		evaluation.Children = &entities.Children{
+			Id:             dbEvaluation.Children.Id,
+			ParentId:       dbEvaluation.Children.ParentId,
+			ChildName:      dbEvaluation.Children.ChildName,
+			ChildGender:    dbEvaluation.Children.ChildGender,
+			ChildBirthDate: dbEvaluation.Children.ChildBirthDate,
+		}
+	}
+
+	for _, dbGoal := range dbEvaluation.Goals {
+		evaluation.Goals = append(evaluation.Goals, entities.EvaluationGoal{
+			EvaluationId:   dbGoal.EvaluationId,
+			GoalId:         dbGoal.GoalId,
+			TherapySection: dbGoal.TherapySection,
+			Description:    dbGoal.Description,
+			Value:          dbGoal.Value,
+			Attainment:     dbGoal.Attainment,
+			Status:         dbGoal.Status,
+			Note:           dbGoal.Note,
+		})
+	}
+
+	return evaluation
+}
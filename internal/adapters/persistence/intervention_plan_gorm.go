@@ -0,0 +1,294 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type interventionPlanRepository struct {
+	db *gorm.DB
+}
+
+func NewInterventionPlanRepository(db *gorm.DB) repositories.InterventionPlanRepository {
+	return &interventionPlanRepository{
+		db: db,
+	}
+}
+
+var interventionPlanListSpec = listSpec{
+	sortColumns: map[string]string{
+		"start_date":      "intervention_plans.start_date",
+		"therapy_section": "intervention_plans.therapy_section",
+		"created_at":      "intervention_plans.created_at",
+	},
+	defaultSort:   "created_at",
+	defaultOrder:  "desc",
+	searchColumns: []string{"childrens.child_name", "therapists.therapist_name"},
+	dateColumn:    "intervention_plans.start_date",
+	idColumn:      "intervention_plans.id",
+}
+
+func (r *interventionPlanRepository) Create(ctx context.Context, tx *gorm.DB, plan *entities.InterventionPlan) error {
+	if plan == nil {
+		return errors.New("intervention plan data cannot be empty")
+	}
+
+	dbPlan := &models.InterventionPlan{
+		ChildId:        plan.ChildId,
+		TherapistId:    plan.TherapistId,
+		TherapySection: plan.TherapySection,
+		StartDate:      plan.StartDate,
+		EndDate:        plan.EndDate,
+		SessionMinutes: plan.SessionMinutes,
+		IsActive:       plan.IsActive,
+		Slots:          slotModels(0, plan.Slots),
+	}
+
+	if err := tx.WithContext(ctx).Create(dbPlan).Error; err != nil {
+		return fmt.Errorf("failed to create intervention plan: %w", err)
+	}
+
+	plan.Id = dbPlan.Id
+	plan.CreatedAt = dbPlan.CreatedAt
+	plan.UpdatedAt = dbPlan.UpdatedAt
+	return nil
+}
+
+func (r *interventionPlanRepository) Update(ctx context.Context, tx *gorm.DB, plan *entities.InterventionPlan) error {
+	if plan == nil {
+		return errors.New("intervention plan data cannot be empty")
+	}
+
+	if err := tx.WithContext(ctx).
+		Model(&models.InterventionPlan{}).
+		Where("id = ?", plan.Id).
+		Updates(map[string]interface{}{
+			"therapist_id":    plan.TherapistId,
+			"end_date":        plan.EndDate,
+			"session_minutes": plan.SessionMinutes,
+			"is_active":       plan.IsActive,
+			"updated_at":      time.Now(),
+		}).Error; err != nil {
+		return fmt.Errorf("failed to update intervention plan: %w", err)
+	}
+
+	if err := tx.WithContext(ctx).
+		Where("plan_id = ?", plan.Id).
+		Delete(&models.InterventionPlanSlot{}).Error; err != nil {
+		return fmt.Errorf("failed to clear intervention plan slots: %w", err)
+	}
+
+	if len(plan.Slots) == 0 {
+		return nil
+	}
+
+	dbSlots := slotModels(plan.Id, plan.Slots)
+	if err := tx.WithContext(ctx).Create(&dbSlots).Error; err != nil {
+		return fmt.Errorf("failed to save intervention plan slots: %w", err)
+	}
+
+	return nil
+}
+
+func (r *interventionPlanRepository) GetAll(ctx context.Context, query entities.ListQuery, childId string, therapistId string) ([]*entities.InterventionPlan, *entities.PageInfo, error) {
+	baseQuery := r.db.WithContext(ctx).
+		Model(&models.InterventionPlan{}).
+		Joins("JOIN childrens ON childrens.id = intervention_plans.child_id").
+		Joins("JOIN therapists ON therapists.id = intervention_plans.therapist_id")
+
+	if childId != "" {
+		baseQuery = baseQuery.Where("intervention_plans.child_id = ?", childId)
+	}
+	if therapistId != "" {
+		baseQuery = baseQuery.Where("intervention_plans.therapist_id = ?", therapistId)
+	}
+
+	dbPlans, pageInfo, err := paginate[models.InterventionPlan](baseQuery, query, interventionPlanListSpec, preloadPlan)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get intervention plans: %w", err)
+	}
+
+	plans := make([]*entities.InterventionPlan, 0, len(dbPlans))
+	for _, dbPlan := range dbPlans {
+		plans = append(plans, r.modelToEntity(dbPlan))
+	}
+
+	return plans, pageInfo, nil
+}
+
+func (r *interventionPlanRepository) GetById(ctx context.Context, planId int) (*entities.InterventionPlan, error) {
+	if planId == 0 {
+		return nil, errors.New("planId cannot be empty")
+	}
+
+	var dbPlan models.InterventionPlan
+
+	if err := preloadPlan(r.db.WithContext(ctx)).
+		First(&dbPlan, "id = ?", planId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("intervention plan with id %d not found", planId)
+		}
+		return nil, fmt.Errorf("failed to find intervention plan by id: %w", err)
+	}
+
+	return r.modelToEntity(&dbPlan), nil
+}
+
+// LockById holds the plan row so sessions of the plan are recorded one at a
+// time.
+func (r *interventionPlanRepository) LockById(ctx context.Context, tx *gorm.DB, planId int) (*entities.InterventionPlan, error) {
+	var dbPlan models.InterventionPlan
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Slots").
+		First(&dbPlan, "id = ?", planId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("intervention plan with id %d not found", planId)
+		}
+		return nil, fmt.Errorf("failed to lock intervention plan: %w", err)
+	}
+
+	return r.modelToEntity(&dbPlan), nil
+}
+
+func (r *interventionPlanRepository) ExistActiveByChildSection(ctx context.Context, tx *gorm.DB, childId string, therapySection string, excludePlanId int) (bool, error) {
+	var count int64
+
+	if err := tx.WithContext(ctx).
+		Model(&models.InterventionPlan{}).
+		Where("child_id = ? AND therapy_section = ? AND is_active = ?", childId, therapySection, true).
+		Where("id <> ?", excludePlanId).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check active intervention plans: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *interventionPlanRepository) ExistByChildTherapist(ctx context.Context, childId string, therapistId string) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).
+		Model(&models.InterventionPlan{}).
+		Where("child_id = ? AND therapist_id = ?", childId, therapistId).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check intervention plans: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *interventionPlanRepository) DeactivateByChild(ctx context.Context, tx *gorm.DB, childId string) error {
+	if err := tx.WithContext(ctx).
+		Model(&models.InterventionPlan{}).
+		Where("child_id = ? AND is_active = ?", childId, true).
+		Updates(map[string]interface{}{
+			"is_active":  false,
+			"updated_at": time.Now(),
+		}).Error; err != nil {
+		return fmt.Errorf("failed to deactivate intervention plans: %w", err)
+	}
+
+	return nil
+}
+
+func (r *interventionPlanRepository) GetInRange(ctx context.Context, from time.Time, to time.Time, childId string, therapistId string) ([]*entities.InterventionPlan, error) {
+	query := r.db.WithContext(ctx).
+		Where("start_date <= ?", to.Format(entities.DateLayout)).
+		Where("(end_date IS NULL OR end_date >= ?)", from.Format(entities.DateLayout))
+
+	if childId != "" {
+		query = query.Where("child_id = ?", childId)
+	}
+	if therapistId != "" {
+		query = query.Where("therapist_id = ?", therapistId)
+	}
+
+	var dbPlans []*models.InterventionPlan
+	if err := preloadPlan(query).Order("id").Find(&dbPlans).Error; err != nil {
+		return nil, fmt.Errorf("failed to get intervention plans: %w", err)
+	}
+
+	plans := make([]*entities.InterventionPlan, 0, len(dbPlans))
+	for _, dbPlan := range dbPlans {
+		plans = append(plans, r.modelToEntity(dbPlan))
+	}
+
+	return plans, nil
+}
+
+func preloadPlan(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Children").
+		Preload("Therapist").
+		Preload("Slots", func(db *gorm.DB) *gorm.DB {
+			return db.Order("day_of_week, start_time")
+		})
+}
+
+func slotModels(planId int, slots []entities.InterventionPlanSlot) []models.InterventionPlanSlot {
+	dbSlots := make([]models.InterventionPlanSlot, 0, len(slots))
+	for _, slot := range slots {
+		dbSlots = append(dbSlots, models.InterventionPlanSlot{
+			PlanId:    planId,
+			DayOfWeek: slot.DayOfWeek,
+			StartTime: slot.StartTime,
+		})
+	}
+
+	return dbSlots
+}
+
+func (r *interventionPlanRepository) modelToEntity(dbPlan *models.InterventionPlan) *entities.InterventionPlan {
+	plan := &entities.InterventionPlan{
+		Id:             dbPlan.Id,
+		ChildId:        dbPlan.ChildId,
+		TherapistId:    dbPlan.TherapistId,
+		TherapySection: dbPlan.TherapySection,
+		StartDate:      dbPlan.StartDate,
+		EndDate:        dbPlan.EndDate,
+		SessionMinutes: dbPlan.SessionMinutes,
+		IsActive:       dbPlan.IsActive,
+		CreatedAt:      dbPlan.CreatedAt,
+		UpdatedAt:      dbPlan.UpdatedAt,
+	}
+
+	if dbPlan.Children != nil {
+		plan.Children = &entities.Children{
+			Id:             dbPlan.Children.Id,
+			ParentId:       dbPlan.Children.ParentId,
+			ChildName:      dbPlan.Children.ChildName,
+			ChildGender:    dbPlan.Children.ChildGender,
+			ChildBirthDate: dbPlan.Children.ChildBirthDate,
+		}
+	}
+
+	if dbPlan.Therapist != nil {
+		plan.Therapist = &entities.Therapist{
+			Id:               dbPlan.Therapist.Id,
+			UserId:           dbPlan.Therapist.UserId,
+			TherapistName:    dbPlan.Therapist.TherapistName,
+			TherapistSection: dbPlan.Therapist.TherapistSection,
+		}
+	}
+
+	for _, dbSlot := range dbPlan.Slots {
+		plan.Slots = append(plan.Slots, entities.InterventionPlanSlot{
+			Id:        dbSlot.Id,
+			PlanId:    dbSlot.PlanId,
+			DayOfWeek: dbSlot.DayOfWeek,
+			StartTime: trimSeconds(dbSlot.StartTime),
+		})
+	}
+
+	return plan
+}
@@ -0,0 +1,229 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/helpers"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type interventionSessionRepository struct {
+	db *gorm.DB
+}
+
+func NewInterventionSessionRepository(db *gorm.DB) repositories.InterventionSessionRepository {
+	return &interventionSessionRepository{
+		db: db,
+	}
+}
+
+func (r *interventionSessionRepository) Create(ctx context.Context, tx *gorm.DB, session *entities.InterventionSession) error {
+	if session == nil {
+		return errors.New("intervention session data cannot be empty")
+	}
+
+	dbSession := &models.InterventionSession{
+		PlanId:         session.PlanId,
+		ChildId:        session.ChildId,
+		TherapistId:    session.TherapistId,
+		TherapySection: session.TherapySection,
+		SessionDate:    session.SessionDate,
+		StartTime:      session.StartTime,
+		Status:         session.Status,
+		CancelReason:   session.CancelReason,
+		Subjective:     session.Subjective,
+		Objective:      session.Objective,
+		Assessment:     session.Assessment,
+		Plan:           session.Plan,
+		RecordedAt:     session.RecordedAt,
+	}
+
+	if err := tx.WithContext(ctx).Create(dbSession).Error; err != nil {
+		return fmt.Errorf("failed to create intervention session: %w", err)
+	}
+
+	session.Id = dbSession.Id
+	session.CreatedAt = dbSession.CreatedAt
+	session.UpdatedAt = dbSession.UpdatedAt
+	return nil
+}
+
+func (r *interventionSessionRepository) Update(ctx context.Context, tx *gorm.DB, session *entities.InterventionSession) error {
+	if session == nil {
+		return errors.New("intervention session data cannot be empty")
+	}
+
+	if err := tx.WithContext(ctx).
+		Model(&models.InterventionSession{}).
+		Where("id = ?", session.Id).
+		Updates(map[string]interface{}{
+			"therapist_id":  session.TherapistId,
+			"status":        session.Status,
+			"cancel_reason": session.CancelReason,
+			"subjective":    session.Subjective,
+			"objective":     session.Objective,
+			"assessment":    session.Assessment,
+			"plan":          session.Plan,
+			"recorded_at":   session.RecordedAt,
+			"updated_at":    time.Now(),
+		}).Error; err != nil {
+		return fmt.Errorf("failed to update intervention session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *interventionSessionRepository) ReplaceGoals(ctx context.Context, tx *gorm.DB, sessionId int, goals []entities.InterventionSessionGoal) error {
+	if err := tx.WithContext(ctx).
+		Where("session_id = ?", sessionId).
+		Delete(&models.InterventionSessionGoal{}).Error; err != nil {
+		return fmt.Errorf("failed to clear session goals: %w", err)
+	}
+
+	if len(goals) == 0 {
+		return nil
+	}
+
+	dbGoals := make([]models.InterventionSessionGoal, 0, len(goals))
+	for _, goal := range goals {
+		dbGoals = append(dbGoals, models.InterventionSessionGoal{
+			SessionId:      sessionId,
+			CarePlanGoalId: goal.CarePlanGoalId,
+			Description:    goal.Description,
+			Note:           goal.Note,
+		})
+	}
+
+	if err := tx.WithContext(ctx).Create(&dbGoals).Error; err != nil {
+		return fmt.Errorf("failed to save session goals: %w", err)
+	}
+
+	return nil
+}
+
+func (r *interventionSessionRepository) GetById(ctx context.Context, sessionId int) (*entities.InterventionSession, error) {
+	if sessionId == 0 {
+		return nil, errors.New("sessionId cannot be empty")
+	}
+
+	var dbSession models.InterventionSession
+
+	if err := r.db.WithContext(ctx).
+		Preload("Children").
+		Preload("Therapist").
+		Preload("Goals", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
+		}).
+		Preload("Measurements").
+		First(&dbSession, "id = ?", sessionId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("intervention session with id %d not found", sessionId)
+		}
+		return nil, fmt.Errorf("failed to find intervention session by id: %w", err)
+	}
+
+	return r.modelToEntity(&dbSession), nil
+}
+
+func (r *interventionSessionRepository) GetByOccurrence(ctx context.Context, tx *gorm.DB, planId int, sessionDate helpers.DateOnly, startTime string) (*entities.InterventionSession, error) {
+	var dbSession models.InterventionSession
+
+	if err := tx.WithContext(ctx).
+		Where("plan_id = ? AND session_date = ? AND start_time = ?", planId, sessionDate, startTime).
+		First(&dbSession).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find intervention session: %w", err)
+	}
+
+	return r.modelToEntity(&dbSession), nil
+}
+
+func (r *interventionSessionRepository) GetByPlans(ctx context.Context, planIds []int, from time.Time, to time.Time) ([]*entities.InterventionSession, error) {
+	if len(planIds) == 0 {
+		return []*entities.InterventionSession{}, nil
+	}
+
+	var dbSessions []*models.InterventionSession
+
+	if err := r.db.WithContext(ctx).
+		Preload("Goals", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
+		}).
+		Where("plan_id IN ?", planIds).
+		Where("session_date BETWEEN ? AND ?", from.Format(entities.DateLayout), to.Format(entities.DateLayout)).
+		Order("session_date, start_time").
+		Find(&dbSessions).Error; err != nil {
+		return nil, fmt.Errorf("failed to get intervention sessions: %w", err)
+	}
+
+	sessions := make([]*entities.InterventionSession, 0, len(dbSessions))
+	for _, dbSession := range dbSessions {
+		sessions = append(sessions, r.modelToEntity(dbSession))
+	}
+
+	return sessions, nil
+}
+
+func (r *interventionSessionRepository) modelToEntity(dbSession *models.InterventionSession) *entities.InterventionSession {
+	session := &entities.InterventionSession{
+		Id:             dbSession.Id,
+		PlanId:         dbSession.PlanId,
+		ChildId:        dbSession.ChildId,
+		TherapistId:    dbSession.TherapistId,
+		TherapySection: dbSession.TherapySection,
+		SessionDate:    dbSession.SessionDate,
+		StartTime:      trimSeconds(dbSession.StartTime),
+		Status:         dbSession.Status,
+		CancelReason:   dbSession.CancelReason,
+		Subjective:     dbSession.Subjective,
+		Objective:      dbSession.Objective,
+		Assessment:     dbSession.Assessment,
+		Plan:           dbSession.Plan,
+		RecordedAt:     dbSession.RecordedAt,
+		CreatedAt:      dbSession.CreatedAt,
+		UpdatedAt:      dbSession.UpdatedAt,
+	}
+
+	if dbSession.Children != nil {
+		session.Children = &entities.Children{
+			Id:             dbSession.Children.Id,
+			ParentId:       dbSession.Children.ParentId,
+			ChildName:      dbSession.Children.ChildName,
+			ChildGender:    dbSession.Children.ChildGender,
+			ChildBirthDate: dbSession.Children.ChildBirthDate,
+		}
+	}
+
+	if dbSession.Therapist != nil {
+		session.Therapist = &entities.Therapist{
+			Id:               dbSession.Therapist.Id,
+			UserId:           dbSession.Therapist.UserId,
+			TherapistName:    dbSession.Therapist.TherapistName,
+			TherapistSection: dbSession.Therapist.TherapistSection,
+		}
+	}
+
+	for _, dbGoal := range dbSession.Goals {
+		session.Goals = append(session.Goals, entities.InterventionSessionGoal{
+			Id:             dbGoal.Id,
+			SessionId:      dbGoal.SessionId,
+			CarePlanGoalId: dbGoal.CarePlanGoalId,
+			Description:    dbGoal.Description,
+			Note:           dbGoal.Note,
+		})
+	}
+
+	for _, dbMeasurement := range dbSession.Measurements {
+		session.Measurements = append(session.Measurements, *measurementToEntity(&dbMeasurement))
+	}
+
+	return session
+}
@@ -0,0 +1,213 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type mfaRepository struct {
+	db *gorm.DB
+}
+
+func NewMfaRepository(db *gorm.DB) repositories.MfaRepository {
+	return &mfaRepository{
+		db: db,
+	}
+}
+
+// GetByUserId returns nil without an error when the user never enrolled.
+func (r *mfaRepository) GetByUserId(ctx context.Context, userId string) (*entities.MfaEnrollment, error) {
+	if userId == "" {
+		return nil, errors.New("userId cannot be empty")
+	}
+
+	var dbEnrollment models.MfaEnrollment
+
+	if err := r.db.WithContext(ctx).
+		First(&dbEnrollment, "user_id = ?", userId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find mfa enrollment: %w", err)
+	}
+
+	return r.modelToEntity(&dbEnrollment), nil
+}
+
+// LockByUserId returns nil without an error when the user never enrolled.
+func (r *mfaRepository) LockByUserId(ctx context.Context, tx *gorm.DB, userId string) (*entities.MfaEnrollment, error) {
+	if userId == "" {
+		return nil, errors.New("userId cannot be empty")
+	}
+
+	var dbEnrollment models.MfaEnrollment
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&dbEnrollment, "user_id = ?", userId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to lock mfa enrollment: %w", err)
+	}
+
+	return r.modelToEntity(&dbEnrollment), nil
+}
+
+// Create adds a pending enrollment unless the user already has one, which is
+// then left untouched.
+func (r *mfaRepository) Create(ctx context.Context, tx *gorm.DB, enrollment *entities.MfaEnrollment) error {
+	if enrollment == nil {
+		return errors.New("mfa enrollment data cannot be empty")
+	}
+
+	dbEnrollment := &models.MfaEnrollment{
+		UserId:       enrollment.UserId,
+		Secret:       enrollment.Secret,
+		EnabledAt:    enrollment.EnabledAt,
+		LastUsedStep: enrollment.LastUsedStep,
+	}
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(dbEnrollment).Error; err != nil {
+		return fmt.Errorf("failed to create mfa enrollment: %w", err)
+	}
+
+	return nil
+}
+
+// Save replaces whatever enrollment the user had, pending or not.
+func (r *mfaRepository) Save(ctx context.Context, tx *gorm.DB, enrollment *entities.MfaEnrollment) error {
+	if enrollment == nil {
+		return errors.New("mfa enrollment data cannot be empty")
+	}
+
+	dbEnrollment := &models.MfaEnrollment{
+		UserId:       enrollment.UserId,
+		Secret:       enrollment.Secret,
+		EnabledAt:    enrollment.EnabledAt,
+		LastUsedStep: enrollment.LastUsedStep,
+	}
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"secret", "enabled_at", "last_used_step", "updated_at"}),
+		}).
+		Create(dbEnrollment).Error; err != nil {
+		return fmt.Errorf("failed to save mfa enrollment: %w", err)
+	}
+
+	enrollment.CreatedAt = dbEnrollment.CreatedAt
+	enrollment.UpdatedAt = dbEnrollment.UpdatedAt
+	return nil
+}
+
+func (r *mfaRepository) Update(ctx context.Context, tx *gorm.DB, enrollment *entities.MfaEnrollment) error {
+	if enrollment == nil {
+		return errors.New("mfa enrollment data cannot be empty")
+	}
+
+	if err := tx.WithContext(ctx).
+		Model(&models.MfaEnrollment{}).
+		Where("user_id = ?", enrollment.UserId).
+		Updates(map[string]interface{}{
+			"enabled_at":     enrollment.EnabledAt,
+			"last_used_step": enrollment.LastUsedStep,
+			"updated_at":     time.Now(),
+		}).Error; err != nil {
+		return fmt.Errorf("failed to update mfa enrollment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *mfaRepository) Delete(ctx context.Context, tx *gorm.DB, userId string) error {
+	if err := tx.WithContext(ctx).
+		Where("user_id = ?", userId).
+		Delete(&models.MfaRecoveryCode{}).Error; err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	if err := tx.WithContext(ctx).
+		Where("user_id = ?", userId).
+		Delete(&models.MfaEnrollment{}).Error; err != nil {
+		return fmt.Errorf("failed to delete mfa enrollment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *mfaRepository) ReplaceRecoveryCodes(ctx context.Context, tx *gorm.DB, userId string, codes []entities.MfaRecoveryCode) error {
+	if err := tx.WithContext(ctx).
+		Where("user_id = ?", userId).
+		Delete(&models.MfaRecoveryCode{}).Error; err != nil {
+		return fmt.Errorf("failed to clear recovery codes: %w", err)
+	}
+
+	if len(codes) == 0 {
+		return nil
+	}
+
+	dbCodes := make([]models.MfaRecoveryCode, 0, len(codes))
+	for _, code := range codes {
+		dbCodes = append(dbCodes, models.MfaRecoveryCode{
+			UserId:   userId,
+			CodeHash: code.CodeHash,
+		})
+	}
+
+	if err := tx.WithContext(ctx).Create(&dbCodes).Error; err != nil {
+		return fmt.Errorf("failed to save recovery codes: %w", err)
+	}
+
+	return nil
+}
+
+// UseRecoveryCode marks the code used and reports whether it was still
+// unused, so two requests racing with the same code cannot both succeed.
+func (r *mfaRepository) UseRecoveryCode(ctx context.Context, tx *gorm.DB, userId string, codeHash string) (bool, error) {
+	result := tx.WithContext(ctx).
+		Model(&models.MfaRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userId, codeHash).
+		Update("used_at", time.Now())
+
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to use recovery code: %w", result.Error)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r *mfaRepository) CountRecoveryCodes(ctx context.Context, userId string) (int64, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).
+		Model(&models.MfaRecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userId).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count recovery codes: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *mfaRepository) modelToEntity(dbEnrollment *models.MfaEnrollment) *entities.MfaEnrollment {
+	return &entities.MfaEnrollment{
+		UserId:       dbEnrollment.UserId,
+		Secret:       dbEnrollment.Secret,
+		EnabledAt:    dbEnrollment.EnabledAt,
+		LastUsedStep: dbEnrollment.LastUsedStep,
+		CreatedAt:    dbEnrollment.CreatedAt,
+		UpdatedAt:    dbEnrollment.UpdatedAt,
+	}
+}
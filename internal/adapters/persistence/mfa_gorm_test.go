@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestUseRecoveryCodeSucceedsOnce(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	db := openMockDB(t, conn)
+	repo := NewMfaRepository(db)
+
+	// The used_at check is in the UPDATE itself, so of two requests racing
+	// with one code only the first changes a row.
+	update := regexp.QuoteMeta("UPDATE `mfa_recovery_codes` SET `used_at`=? WHERE user_id = ? AND code_hash = ? AND used_at IS NULL")
+	mock.ExpectBegin()
+	mock.ExpectExec(update).WithArgs(sqlmock.AnyArg(), "user-1", "hash").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(update).WithArgs(sqlmock.AnyArg(), "user-1", "hash").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	tx := db.Begin()
+	for i, want := range []bool{true, false} {
+		got, err := repo.UseRecoveryCode(context.Background(), tx, "user-1", "hash")
+		if err != nil {
+			t.Fatalf("use %d: %v", i+1, err)
+		}
+		if got != want {
+			t.Fatalf("use %d = %v, want %v", i+1, got, want)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expectations: %v", err)
+	}
+}
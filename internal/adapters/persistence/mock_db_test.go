@@ -0,0 +1,26 @@
+package persistence
+
+import (
+	"database/sql"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// openMockDB opens gorm on the MySQL dialect over a sqlmock connection, so a
+// test sees the statements a repository would send to the server.
+func openMockDB(t *testing.T, conn *sql.DB) *gorm.DB {
+	t.Helper()
+	t.Cleanup(func() { conn.Close() })
+
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: conn, SkipInitializeWithVersion: true}), &gorm.Config{
+		Logger: logger.Discard,
+	})
+	if err != nil {
+		t.Fatalf("gorm: %v", err)
+	}
+
+	return db
+}
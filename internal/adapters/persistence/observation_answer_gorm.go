@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type observationAnswerRepository struct {
@@ -42,6 +43,65 @@ func (r *observationAnswerRepository) Create(ctx context.Context, tx *gorm.DB, a
 	return nil
 }
 
+// Upsert overwrites an earlier answer to the same question, which is how
+// drafts are saved incrementally.
+func (r *observationAnswerRepository) Upsert(ctx context.Context, tx *gorm.DB, answers []*entities.ObservationAnswer) error {
+	if len(answers) == 0 {
+		return nil
+	}
+
+	dbAnswers := make([]*models.ObservationAnswer, 0, len(answers))
+	for _, answer := range answers {
+		if answer == nil {
+			return errors.New("answer cannot be nil")
+		}
+		dbAnswers = append(dbAnswers, r.entityToModel(answer))
+	}
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "observation_id"}, {Name: "question_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"answer", "score_earned", "note"}),
+		}).
+		Create(dbAnswers).Error; err != nil {
+		return fmt.Errorf("failed to upsert observation_answers: %w", err)
+	}
+
+	return nil
+}
+
+func (r *observationAnswerRepository) GetByObservationId(ctx context.Context, observationId int) ([]*entities.ObservationAnswer, error) {
+	if observationId == 0 {
+		return nil, errors.New("observationId cannot be empty")
+	}
+
+	var dbAnswers []*models.ObservationAnswer
+	if err := r.db.WithContext(ctx).
+		Where("observation_id = ?", observationId).
+		Order("question_id ASC").
+		Find(&dbAnswers).Error; err != nil {
+		return nil, fmt.Errorf("failed to get observation_answers: %w", err)
+	}
+
+	answers := make([]*entities.ObservationAnswer, 0, len(dbAnswers))
+	for _, dbAnswer := range dbAnswers {
+		answers = append(answers, r.modelToEntity(dbAnswer))
+	}
+
+	return answers, nil
+}
+
+func (r *observationAnswerRepository) modelToEntity(answer *models.ObservationAnswer) *entities.ObservationAnswer {
+	return &entities.ObservationAnswer{
+		Id:            answer.Id,
+		ObservationId: answer.ObservationId,
+		QuestionId:    answer.QuestionId,
+		Answer:        answer.Answer,
+		ScoreEarned:   answer.ScoreEarned,
+		Note:          answer.Note,
+	}
+}
+
 func (r *observationAnswerRepository) entityToModel(answer *entities.ObservationAnswer) *models.ObservationAnswer {
 	return &models.ObservationAnswer{
 		Id:            answer.Id,
@@ -0,0 +1,124 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type observationDomainRepository struct {
+	db *gorm.DB
+}
+
+func NewObservationDomainRepository(db *gorm.DB) repositories.ObservationDomainRepository {
+	return &observationDomainRepository{
+		db: db,
+	}
+}
+
+func (r *observationDomainRepository) Create(ctx context.Context, tx *gorm.DB, domain *entities.ObservationDomain) error {
+	if domain == nil {
+		return errors.New("domain data cannot be empty")
+	}
+
+	dbDomain := &models.ObservationDomain{
+		Code:           domain.Code,
+		Name:           domain.Name,
+		TherapySection: domain.TherapySection,
+		SortOrder:      domain.SortOrder,
+		IsActive:       domain.IsActive,
+		CreatedAt:      domain.CreatedAt,
+		UpdatedAt:      domain.UpdatedAt,
+	}
+
+	if err := tx.WithContext(ctx).Create(dbDomain).Error; err != nil {
+		return fmt.Errorf("failed to create observation domain: %w", err)
+	}
+
+	domain.Id = dbDomain.Id
+	return nil
+}
+
+func (r *observationDomainRepository) Update(ctx context.Context, tx *gorm.DB, domain *entities.ObservationDomain) error {
+	if domain == nil {
+		return errors.New("domain data cannot be empty")
+	}
+
+	if err := tx.WithContext(ctx).
+		Model(&models.ObservationDomain{}).
+		Where("code = ?", domain.Code).
+		Updates(map[string]interface{}{
+			"name":            domain.Name,
+			"therapy_section": domain.TherapySection,
+			"sort_order":      domain.SortOrder,
+			"is_active":       domain.IsActive,
+			"updated_at":      domain.UpdatedAt,
+		}).Error; err != nil {
+		return fmt.Errorf("failed to update observation domain: %w", err)
+	}
+
+	return nil
+}
+
+func (r *observationDomainRepository) GetAll(ctx context.Context) ([]*entities.ObservationDomain, error) {
+	var dbDomains []*models.ObservationDomain
+
+	if err := r.db.WithContext(ctx).
+		Order("sort_order asc, code asc").
+		Find(&dbDomains).Error; err != nil {
+		return nil, fmt.Errorf("failed to get observation domains: %w", err)
+	}
+
+	domains := make([]*entities.ObservationDomain, 0, len(dbDomains))
+	for _, dbDomain := range dbDomains {
+		domains = append(domains, r.modelToEntity(dbDomain))
+	}
+
+	return domains, nil
+}
+
+func (r *observationDomainRepository) GetByCode(ctx context.Context, code string) (*entities.ObservationDomain, error) {
+	var dbDomain models.ObservationDomain
+
+	if err := r.db.WithContext(ctx).
+		Where("code = ?", code).
+		First(&dbDomain).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("observation domain %s not found", code)
+		}
+		return nil, fmt.Errorf("failed to get observation domain: %w", err)
+	}
+
+	return r.modelToEntity(&dbDomain), nil
+}
+
+func (r *observationDomainRepository) ExistByCode(ctx context.Context, code string) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).
+		Model(&models.ObservationDomain{}).
+		Where("code = ?", code).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check observation domain: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *observationDomainRepository) modelToEntity(dbDomain *models.ObservationDomain) *entities.ObservationDomain {
+	return &entities.ObservationDomain{
+		Id:             dbDomain.Id,
+		Code:           dbDomain.Code,
+		Name:           dbDomain.Name,
+		TherapySection: dbDomain.TherapySection,
+		SortOrder:      dbDomain.SortOrder,
+		IsActive:       dbDomain.IsActive,
+		CreatedAt:      dbDomain.CreatedAt,
+		UpdatedAt:      dbDomain.UpdatedAt,
+	}
+}
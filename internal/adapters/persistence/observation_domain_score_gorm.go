@@ -0,0 +1,86 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type observationDomainScoreRepository struct {
+	db *gorm.DB
+}
+
+func NewObservationDomainScoreRepository(db *gorm.DB) repositories.ObservationDomainScoreRepository {
+	return &observationDomainScoreRepository{
+		db: db,
+	}
+}
+
+func (r *observationDomainScoreRepository) ReplaceByObservationId(ctx context.Context, tx *gorm.DB, observationId int, scores []entities.ObservationDomainScore) error {
+	if observationId == 0 {
+		return errors.New("observationId cannot be empty")
+	}
+
+	if err := tx.WithContext(ctx).
+		Where("observation_id = ?", observationId).
+		Delete(&models.ObservationDomainScore{}).Error; err != nil {
+		return fmt.Errorf("failed to clear domain scores: %w", err)
+	}
+
+	if len(scores) == 0 {
+		return nil
+	}
+
+	dbScores := make([]*models.ObservationDomainScore, 0, len(scores))
+	for _, score := range scores {
+		dbScores = append(dbScores, &models.ObservationDomainScore{
+			ObservationId: observationId,
+			Domain:        score.Domain,
+			Score:         score.Score,
+			MaxScore:      score.MaxScore,
+			RiskLevel:     score.RiskLevel,
+			CreatedAt:     score.CreatedAt,
+		})
+	}
+
+	if err := tx.WithContext(ctx).Create(&dbScores).Error; err != nil {
+		return fmt.Errorf("failed to create domain scores: %w", err)
+	}
+
+	return nil
+}
+
+func (r *observationDomainScoreRepository) GetByObservationId(ctx context.Context, observationId int) ([]entities.ObservationDomainScore, error) {
+	if observationId == 0 {
+		return nil, errors.New("observationId cannot be empty")
+	}
+
+	var dbScores []*models.ObservationDomainScore
+
+	if err := r.db.WithContext(ctx).
+		Where("observation_id = ?", observationId).
+		Order("id asc").
+		Find(&dbScores).Error; err != nil {
+		return nil, fmt.Errorf("failed to get domain scores: %w", err)
+	}
+
+	scores := make([]entities.ObservationDomainScore, 0, len(dbScores))
+	for _, dbScore := range dbScores {
+		scores = append(scores, entities.ObservationDomainScore{
+			Id:            dbScore.Id,
+			ObservationId: dbScore.ObservationId,
+			Domain:        dbScore.Domain,
+			Score:         dbScore.Score,
+			MaxScore:      dbScore.MaxScore,
+			RiskLevel:     dbScore.RiskLevel,
+			CreatedAt:     dbScore.CreatedAt,
+		})
+	}
+
+	return scores, nil
+}
@@ -13,6 +13,7 @@ import (
 	"context"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type observationRepository struct {
@@ -29,74 +30,102 @@ func (r *observationRepository) Create(ctx context.Context, tx *gorm.DB, observa
 	dbObservation := &models.Observation{
 		Id:            observation.Id,
 		ChildId:       observation.ChildId,
+		TherapistId:   optionalString(observation.TherapistId),
 		ScheduledDate: observation.ScheduledDate,
 		AgeCategory:   observation.AgeCategory,
 		Status:        observation.Status,
 		CreatedAt:     observation.CreatedAt,
 		UpdatedAt:     observation.UpdatedAt,
 	}
-	if err := tx.WithContext(ctx).Create(&dbObservation).Error; err != nil {
+	// The scoring columns are enums and only get a value once the
+	// observation is submitted; an empty string would be rejected.
+	if err := tx.WithContext(ctx).
+		Omit("suggested_risk_level", "suggested_section", "risk_level", "therapy_section").
+		Create(&dbObservation).Error; err != nil {
 		return fmt.Errorf("failed to create observation: %w", err)
 	}
 
 	return nil
 }
 
-func (r *observationRepository) GetByPendingStatus(ctx context.Context) ([]*entities.Observation, error) {
-	var dbObservations []*models.Observation
+// Unscored observations sort with a total score of 0, which is also the value
+// their cursor carries, so paging does not stop at the first NULL.
+var observationListSpec = listSpec{
+	sortColumns: map[string]string{
+		"scheduled_date": "observations.scheduled_date",
+		"age_category":   "observations.age_category",
+		"total_score":    "COALESCE(observations.total_score, 0)",
+		"created_at":     "observations.created_at",
+	},
+	defaultSort:   "scheduled_date",
+	defaultOrder:  "asc",
+	searchColumns: []string{"childrens.child_name", "observations.age_category"},
+	dateColumn:    "observations.scheduled_date",
+	idColumn:      "observations.id",
+}
 
-	if err := r.db.WithContext(ctx).
-		Preload("Children").
-		Preload("Children.Parent").
-		Preload("Children.Parent.ParentDetail").
-		Where("status = ?", "Pending").
-		Order("scheduled_date desc").
-		Find(&dbObservations).Error; err != nil {
-		return nil, fmt.Errorf("failed to get pending observations: %w", err)
+func (r *observationRepository) GetByPendingStatus(ctx context.Context, query entities.ListQuery) ([]*entities.Observation, *entities.PageInfo, error) {
+	spec := observationListSpec
+	spec.defaultOrder = "desc"
+
+	observations, pageInfo, err := r.getByStatus(ctx, constants.ObservationUnscheduledStatuses, "", query, spec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get pending observations: %w", err)
 	}
 
-	observations := make([]*entities.Observation, 0, len(dbObservations))
-	for _, dbObservation := range dbObservations {
-		observation := r.modelToEntity(dbObservation)
-		observations = append(observations, observation)
+	return observations, pageInfo, nil
+}
+
+func (r *observationRepository) GetByScheduledStatus(ctx context.Context, query entities.ListQuery) ([]*entities.Observation, *entities.PageInfo, error) {
+	observations, pageInfo, err := r.getByStatus(ctx, constants.ObservationBookedStatuses, "", query, observationListSpec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get scheduled observations: %w", err)
 	}
 
-	return observations, nil
+	return observations, pageInfo, nil
 }
 
-func (r *observationRepository) GetByScheduledStatus(ctx context.Context) ([]*entities.Observation, error) {
-	var dbObservations []*models.Observation
+func (r *observationRepository) GetScheduledByTherapistId(ctx context.Context, therapistId string, query entities.ListQuery) ([]*entities.Observation, *entities.PageInfo, error) {
+	if therapistId == "" {
+		return nil, nil, errors.New("therapistId cannot be empty")
+	}
 
-	if err := r.db.WithContext(ctx).
-		Preload("Children").
-		Preload("Children.Parent").
-		Preload("Children.Parent.ParentDetail").
-		Where("status = ?", "Scheduled").
-		Order("scheduled_date asc").
-		Find(&dbObservations).Error; err != nil {
-		return nil, fmt.Errorf("failed to get scheduled observations: %w", err)
+	observations, pageInfo, err := r.getByStatus(ctx, constants.ObservationBookedStatuses, therapistId, query, observationListSpec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get scheduled observations for therapist: %w", err)
 	}
 
-	observations := make([]*entities.Observation, 0, len(dbObservations))
-	for _, dbObservation := range dbObservations {
-		observation := r.modelToEntity(dbObservation)
-		observations = append(observations, observation)
+	return observations, pageInfo, nil
+}
+
+func (r *observationRepository) GetByCompletedStatus(ctx context.Context, query entities.ListQuery) ([]*entities.Observation, *entities.PageInfo, error) {
+	observations, pageInfo, err := r.getByStatus(ctx, []constants.ObservationStatus{constants.ObservationStatusCompleted}, "", query, observationListSpec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get completed observations: %w", err)
 	}
 
-	return observations, nil
+	return observations, pageInfo, nil
 }
 
-func (r *observationRepository) GetByCompletedStatus(ctx context.Context) ([]*entities.Observation, error) {
-	var dbObservations []*models.Observation
+func (r *observationRepository) getByStatus(ctx context.Context, statuses []constants.ObservationStatus, therapistId string, query entities.ListQuery, spec listSpec) ([]*entities.Observation, *entities.PageInfo, error) {
+	baseQuery := r.db.WithContext(ctx).
+		Model(&models.Observation{}).
+		Joins("JOIN childrens ON childrens.id = observations.child_id").
+		Where("observations.status IN ?", statuses)
 
-	if err := r.db.WithContext(ctx).
-		Preload("Children").
-		Preload("Children.Parent").
-		Preload("Children.Parent.ParentDetail").
-		Where("status = ?", "Complete").
-		Order("scheduled_date asc").
-		Find(&dbObservations).Error; err != nil {
-		return nil, fmt.Errorf("failed to get completed observations: %w", err)
+	if therapistId != "" {
+		baseQuery = baseQuery.Where("observations.therapist_id = ?", therapistId)
+	}
+
+	dbObservations, pageInfo, err := paginate[models.Observation](baseQuery, query, spec, func(db *gorm.DB) *gorm.DB {
+		return db.
+			Preload("Children").
+			Preload("Children.Parent").
+			Preload("Children.Parent.ParentDetail").
+			Preload("Therapist")
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
 	observations := make([]*entities.Observation, 0, len(dbObservations))
@@ -105,7 +134,7 @@ func (r *observationRepository) GetByCompletedStatus(ctx context.Context) ([]*en
 		observations = append(observations, observation)
 	}
 
-	return observations, nil
+	return observations, pageInfo, nil
 }
 
 func (r *observationRepository) GetById(ctx context.Context, observationId int) (*entities.Observation, error) {
@@ -119,6 +148,7 @@ func (r *observationRepository) GetById(ctx context.Context, observationId int)
 		Preload("Children").
 		Preload("Children.Parent").
 		Preload("Children.Parent.ParentDetail").
+		Preload("Therapist").
 		First(&dbObservation, "id = ?", observationId).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.New("observation not found")
@@ -134,18 +164,79 @@ func (r *observationRepository) GetById(ctx context.Context, observationId int)
 	return observation, nil
 }
 
-func (r *observationRepository) UpdateScheduledDate(ctx context.Context, observationId int, date helpers.DateOnly) error {
+func (r *observationRepository) GetByChildId(ctx context.Context, childId string) ([]*entities.Observation, error) {
+	var dbObservations []*models.Observation
+
+	if err := r.db.WithContext(ctx).
+		Preload("Therapist").
+		Where("child_id = ?", childId).
+		Order("scheduled_date asc, id asc").
+		Find(&dbObservations).Error; err != nil {
+		return nil, fmt.Errorf("failed to find observations by child id: %w", err)
+	}
+
+	observations := make([]*entities.Observation, 0, len(dbObservations))
+	for _, dbObservation := range dbObservations {
+		observations = append(observations, r.modelToEntity(dbObservation))
+	}
+
+	return observations, nil
+}
+
+// LockById holds the observation row so amendments are applied one at a time.
+func (r *observationRepository) LockById(ctx context.Context, tx *gorm.DB, observationId int) (*entities.Observation, error) {
+	var dbObservation models.Observation
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&dbObservation, "id = ?", observationId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("observation with id %d not found", observationId)
+		}
+		return nil, fmt.Errorf("failed to lock observation: %w", err)
+	}
+
+	return r.modelToEntity(&dbObservation), nil
+}
+
+func (r *observationRepository) GetBookedByTherapistIds(ctx context.Context, therapistIds []string, from time.Time, to time.Time) ([]*entities.Observation, error) {
+	if len(therapistIds) == 0 {
+		return []*entities.Observation{}, nil
+	}
+
+	var dbObservations []*models.Observation
+
+	if err := r.db.WithContext(ctx).
+		Where("therapist_id IN ?", therapistIds).
+		Where("status IN ?", constants.ObservationBookedStatuses).
+		Where("scheduled_start < ? AND scheduled_end > ?", to, from).
+		Order("scheduled_start asc").
+		Find(&dbObservations).Error; err != nil {
+		return nil, fmt.Errorf("failed to get booked observations: %w", err)
+	}
+
+	observations := make([]*entities.Observation, 0, len(dbObservations))
+	for _, dbObservation := range dbObservations {
+		observations = append(observations, r.modelToEntity(dbObservation))
+	}
+
+	return observations, nil
+}
+
+func (r *observationRepository) UpdateScheduledDate(ctx context.Context, tx *gorm.DB, observationId int, date helpers.DateOnly, period entities.TimeRange, therapistId string) error {
 	if observationId == 0 {
 		return errors.New("observation is nil")
 	}
 
-	result := r.db.WithContext(ctx).
+	result := tx.WithContext(ctx).
 		Model(&models.Observation{}).
 		Where("id = ?", observationId).
 		Updates(map[string]interface{}{
-			"scheduled_date": date,
-			"updated_at":     time.Now(),
-			"status":         string(constants.ObservationStatusScheduled),
+			"scheduled_date":  date,
+			"scheduled_start": period.Start,
+			"scheduled_end":   period.End,
+			"therapist_id":    therapistId,
+			"updated_at":      time.Now(),
 		})
 
 	if result.Error != nil {
@@ -159,21 +250,98 @@ func (r *observationRepository) UpdateScheduledDate(ctx context.Context, observa
 	return nil
 }
 
-func (r *observationRepository) UpdateAfterObservation(ctx context.Context, tx *gorm.DB, observationId int, therapistId string, totalScore int, conclusion string, recommendation string) error {
+// UpdateStatus only applies when the stored status still equals from, so two
+// concurrent transitions cannot both succeed.
+func (r *observationRepository) UpdateStatus(ctx context.Context, tx *gorm.DB, observationId int, from constants.ObservationStatus, to constants.ObservationStatus) error {
 	if observationId == 0 {
 		return errors.New("observation is nil")
 	}
 
 	result := tx.WithContext(ctx).
 		Model(&models.Observation{}).
-		Where("id = ?", observationId).
+		Where("id = ? AND status = ?", observationId, from).
+		Updates(map[string]interface{}{
+			"status":     string(to),
+			"updated_at": time.Now(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update observation status: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repositories.ErrStatusChanged
+	}
+
+	return nil
+}
+
+// PinQuestionnaireVersion only sets the version once so an observation keeps
+// the questionnaire it was started with.
+func (r *observationRepository) PinQuestionnaireVersion(ctx context.Context, tx *gorm.DB, observationId int, versionId int) error {
+	if err := tx.WithContext(ctx).
+		Model(&models.Observation{}).
+		Where("id = ? AND questionnaire_version_id IS NULL", observationId).
+		Update("questionnaire_version_id", versionId).Error; err != nil {
+		return fmt.Errorf("failed to pin questionnaire version: %w", err)
+	}
+
+	return nil
+}
+
+// SaveDraft only writes the notes that were sent and refuses once the
+// observation has left InProgress, so a late save cannot touch a finalised one.
+func (r *observationRepository) SaveDraft(ctx context.Context, tx *gorm.DB, observationId int, conclusion *string, recommendation *string) error {
+	if observationId == 0 {
+		return errors.New("observation is nil")
+	}
+
+	now := time.Now()
+	updates := map[string]interface{}{
+		"draft_saved_at": now,
+		"updated_at":     now,
+	}
+	if conclusion != nil {
+		updates["conclusion"] = *conclusion
+	}
+	if recommendation != nil {
+		updates["recommendation"] = *recommendation
+	}
+
+	result := tx.WithContext(ctx).
+		Model(&models.Observation{}).
+		Where("id = ? AND status = ?", observationId, constants.ObservationStatusInProgress).
+		Updates(updates)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to save observation draft: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repositories.ErrStatusChanged
+	}
+
+	return nil
+}
+
+func (r *observationRepository) UpdateAfterObservation(ctx context.Context, tx *gorm.DB, observation *entities.Observation) error {
+	if observation == nil || observation.Id == 0 {
+		return errors.New("observation is nil")
+	}
+
+	result := tx.WithContext(ctx).
+		Model(&models.Observation{}).
+		Where("id = ?", observation.Id).
 		Updates(map[string]interface{}{
-			"therapist_id":   therapistId,
-			"total_score":    totalScore,
-			"conclusion":     conclusion,
-			"recommendation": recommendation,
-			"status":         string(constants.ObservationStatusCompleted),
-			"updated_at":     time.Now(),
+			"therapist_id":         nullIfEmpty(observation.TherapistId),
+			"total_score":          observation.TotalScore,
+			"conclusion":           observation.Conclusion,
+			"recommendation":       observation.Recommendation,
+			"suggested_risk_level": nullIfEmpty(observation.SuggestedRiskLevel),
+			"suggested_section":    nullIfEmpty(observation.SuggestedSection),
+			"risk_level":           nullIfEmpty(observation.RiskLevel),
+			"therapy_section":      nullIfEmpty(observation.TherapySection),
+			"updated_at":           time.Now(),
 		})
 
 	if result.Error != nil {
@@ -187,41 +355,94 @@ func (r *observationRepository) UpdateAfterObservation(ctx context.Context, tx *
 	return nil
 }
 
+func (r *observationRepository) ExistOpenByChildId(ctx context.Context, tx *gorm.DB, childId string) (bool, error) {
+	var count int64
+
+	if err := tx.WithContext(ctx).
+		Model(&models.Observation{}).
+		Where("child_id = ?", childId).
+		Where("status IN ?", constants.ObservationOpenStatuses).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check open observations: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *observationRepository) ExistOverlapping(ctx context.Context, tx *gorm.DB, therapistId string, period entities.TimeRange, excludeObservationId int) (bool, error) {
+	var count int64
+
+	if err := tx.WithContext(ctx).
+		Model(&models.Observation{}).
+		Where("therapist_id = ?", therapistId).
+		Where("status IN ?", constants.ObservationBookedStatuses).
+		Where("id <> ?", excludeObservationId).
+		Where("scheduled_start < ? AND scheduled_end > ?", period.End, period.Start).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check overlapping observations: %w", err)
+	}
+
+	return count > 0, nil
+}
+
 func (r *observationRepository) modelToEntity(dbObservation *models.Observation) *entities.Observation {
 	observation := &entities.Observation{
-		Id:             dbObservation.Id,
-		ChildId:        dbObservation.ChildId,
-		TherapistId:    dbObservation.TherapistId,
-		ScheduledDate:  dbObservation.ScheduledDate,
-		AgeCategory:    dbObservation.AgeCategory,
-		TotalScore:     dbObservation.TotalScore,
-		Conclusion:     dbObservation.Conclusion,
-		Recommendation: dbObservation.Recommendation,
-		Status:         dbObservation.Status,
-		CreatedAt:      dbObservation.CreatedAt,
-		UpdatedAt:      dbObservation.UpdatedAt,
+		Id:                     dbObservation.Id,
+		ChildId:                dbObservation.ChildId,
+		TherapistId:            stringValue(dbObservation.TherapistId),
+		ScheduledDate:          dbObservation.ScheduledDate,
+		ScheduledStart:         dbObservation.ScheduledStart,
+		ScheduledEnd:           dbObservation.ScheduledEnd,
+		AgeCategory:            dbObservation.AgeCategory,
+		QuestionnaireVersionId: dbObservation.QuestionnaireVersionId,
+		TotalScore:             dbObservation.TotalScore,
+		Conclusion:             dbObservation.Conclusion,
+		Recommendation:         dbObservation.Recommendation,
+		Status:                 dbObservation.Status,
+		CreatedAt:              dbObservation.CreatedAt,
+		UpdatedAt:              dbObservation.UpdatedAt,
+
+		SuggestedRiskLevel: dbObservation.SuggestedRiskLevel,
+		SuggestedSection:   dbObservation.SuggestedSection,
+		RiskLevel:          dbObservation.RiskLevel,
+		TherapySection:     dbObservation.TherapySection,
+		DraftSavedAt:       dbObservation.DraftSavedAt,
 	}
 
 	if dbObservation.Children != nil {
 		observation.Children = r.modelToChildrenEntity(dbObservation.Children)
 	}
 
+	if dbObservation.Therapist != nil {
+		observation.Therapist = &entities.Therapist{
+			Id:               dbObservation.Therapist.Id,
+			UserId:           dbObservation.Therapist.UserId,
+			TherapistName:    dbObservation.Therapist.TherapistName,
+			TherapistSection: dbObservation.Therapist.TherapistSection,
+			CreatedAt:        dbObservation.Therapist.CreatedAt,
+			UpdatedAt:        dbObservation.Therapist.UpdatedAt,
+		}
+	}
+
 	return observation
 }
 
 func (r *observationRepository) entityToModel(observation *entities.Observation) *models.Observation {
 	return &models.Observation{
-		Id:             observation.Id,
-		ChildId:        observation.ChildId,
-		TherapistId:    observation.TherapistId,
-		ScheduledDate:  observation.ScheduledDate,
-		AgeCategory:    observation.AgeCategory,
-		TotalScore:     observation.TotalScore,
-		Conclusion:     observation.Conclusion,
-		Recommendation: observation.Recommendation,
-		Status:         observation.Status,
-		CreatedAt:      observation.CreatedAt,
-		UpdatedAt:      observation.UpdatedAt,
+		Id:                     observation.Id,
+		ChildId:                observation.ChildId,
+		TherapistId:            optionalString(observation.TherapistId),
+		ScheduledDate:          observation.ScheduledDate,
+		ScheduledStart:         observation.ScheduledStart,
+		ScheduledEnd:           observation.ScheduledEnd,
+		AgeCategory:            observation.AgeCategory,
+		QuestionnaireVersionId: observation.QuestionnaireVersionId,
+		TotalScore:             observation.TotalScore,
+		Conclusion:             observation.Conclusion,
+		Recommendation:         observation.Recommendation,
+		Status:                 observation.Status,
+		CreatedAt:              observation.CreatedAt,
+		UpdatedAt:              observation.UpdatedAt,
 	}
 }
 
@@ -281,6 +502,7 @@ func (r *observationRepository) modelToParentDetailEntity(dbParentDetail *models
 		ParentType:  dbParentDetail.ParentType,
 		ParentName:  dbParentDetail.ParentName,
 		ParentPhone: dbParentDetail.ParentPhone,
+		IsPrimary:   dbParentDetail.IsPrimary,
 		CreatedAt:   dbParentDetail.CreatedAt,
 		UpdatedAt:   dbParentDetail.UpdatedAt,
 	}
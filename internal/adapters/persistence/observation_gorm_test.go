@@ -0,0 +1,88 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// recordingConverter keeps every argument the driver is handed, in order.
+type recordingConverter struct {
+	values []driver.Value
+}
+
+func (c *recordingConverter) ConvertValue(value interface{}) (driver.Value, error) {
+	converted, err := driver.DefaultParameterConverter.ConvertValue(value)
+	if err != nil {
+		if valuer, ok := value.(driver.Valuer); ok {
+			converted, err = valuer.Value()
+		}
+	}
+	c.values = append(c.values, converted)
+	return converted, err
+}
+
+var insertColumnsPattern = regexp.MustCompile("INSERT INTO `observations` \\(([^)]*)\\)")
+
+func TestObservationCreateInsertsUnassignedObservation(t *testing.T) {
+	converter := &recordingConverter{}
+	var statement string
+	conn, mock, err := sqlmock.New(
+		sqlmock.ValueConverterOption(converter),
+		sqlmock.QueryMatcherOption(sqlmock.QueryMatcherFunc(func(expected, actual string) error {
+			statement = actual
+			return sqlmock.QueryMatcherRegexp.Match(expected, actual)
+		})),
+	)
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	db := openMockDB(t, conn)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `observations`").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	observation := &entities.Observation{
+		ChildId:     "01K5TESTCHILD0000000000000",
+		AgeCategory: "Balita",
+		Status:      "Pending",
+	}
+	if err := NewObservationRepository(db).Create(context.Background(), db, observation); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expectations: %v", err)
+	}
+
+	match := insertColumnsPattern.FindStringSubmatch(statement)
+	if match == nil {
+		t.Fatalf("unexpected statement: %s", statement)
+	}
+	columns := strings.Split(strings.ReplaceAll(match[1], "`", ""), ",")
+	if len(columns) != len(converter.values) {
+		t.Fatalf("%d columns but %d values", len(columns), len(converter.values))
+	}
+
+	values := make(map[string]driver.Value, len(columns))
+	for i, column := range columns {
+		values[column] = converter.values[i]
+	}
+
+	// therapist_id references therapists, so an empty id would break the
+	// foreign key; the scoring enums reject empty strings in strict mode.
+	for _, column := range []string{"therapist_id", "suggested_risk_level", "suggested_section", "risk_level", "therapy_section"} {
+		if value, ok := values[column]; ok && value != nil {
+			t.Errorf("%s = %#v, want NULL", column, value)
+		}
+	}
+
+	if values["child_id"] != observation.ChildId {
+		t.Errorf("child_id = %#v, want %q", values["child_id"], observation.ChildId)
+	}
+}
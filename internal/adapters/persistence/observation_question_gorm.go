@@ -21,10 +21,76 @@ func NewObservationQuestionRepository(db *gorm.DB) repositories.ObservationQuest
 	}
 }
 
+func (r *observationQuestionRepository) Create(ctx context.Context, tx *gorm.DB, question *entities.ObservationQuestion) error {
+	if question == nil {
+		return errors.New("question data cannot be empty")
+	}
+
+	dbQuestion := r.entityToModel(question)
+	if err := tx.WithContext(ctx).Create(dbQuestion).Error; err != nil {
+		return fmt.Errorf("failed to create question: %w", err)
+	}
+
+	question.Id = dbQuestion.Id
+	return nil
+}
+
+func (r *observationQuestionRepository) Update(ctx context.Context, tx *gorm.DB, question *entities.ObservationQuestion) error {
+	if question == nil || question.Id == 0 {
+		return errors.New("question data cannot be empty")
+	}
+
+	result := tx.WithContext(ctx).
+		Model(&models.ObservationQuestion{}).
+		Where("id = ?", question.Id).
+		Updates(map[string]interface{}{
+			"question_code":   question.QuestionCode,
+			"age_category":    question.AgeCategory,
+			"domain":          question.Domain,
+			"question_number": question.QuestionNumber,
+			"question_text":   question.QuestionText,
+			"score":           question.Score,
+			"is_active":       question.IsActive,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update question: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("question not found")
+	}
+
+	return nil
+}
+
+func (r *observationQuestionRepository) Delete(ctx context.Context, tx *gorm.DB, questionId int) error {
+	if err := tx.WithContext(ctx).
+		Where("id = ?", questionId).
+		Delete(&models.ObservationQuestion{}).Error; err != nil {
+		return fmt.Errorf("failed to delete question: %w", err)
+	}
+
+	return nil
+}
+
+func (r *observationQuestionRepository) CopyToVersion(ctx context.Context, tx *gorm.DB, fromVersionId int, toVersionId int) error {
+	if err := tx.WithContext(ctx).Exec(`
+		INSERT INTO observation_questions
+			(questionnaire_version_id, question_code, age_category, domain, question_number, question_text, score, is_active)
+		SELECT ?, question_code, age_category, domain, question_number, question_text, score, is_active
+		FROM observation_questions
+		WHERE questionnaire_version_id = ?`, toVersionId, fromVersionId).Error; err != nil {
+		return fmt.Errorf("failed to copy questions: %w", err)
+	}
+
+	return nil
+}
+
 func (r *observationQuestionRepository) GetById(ctx context.Context, questionId int) (*entities.ObservationQuestion, error) {
 	var dbQuestion models.ObservationQuestion
 
-	if err := r.db.First(&dbQuestion, "id = ?", questionId).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&dbQuestion, "id = ?", questionId).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fmt.Errorf("ObservationQuestion Not Found")
 		}
@@ -39,14 +105,41 @@ func (r *observationQuestionRepository) GetById(ctx context.Context, questionId
 	return question, nil
 }
 
-func (r *observationQuestionRepository) GetByAgeCategory(ctx context.Context, ageCategory string) ([]*entities.ObservationQuestion, error) {
+func (r *observationQuestionRepository) GetByAgeCategory(ctx context.Context, versionId int, ageCategory string) ([]*entities.ObservationQuestion, error) {
 	if ageCategory == "" {
 		return nil, errors.New("age category is required")
 	}
 
-	var dbObservationQuestions []*models.ObservationQuestion
+	return r.find(r.db.WithContext(ctx).
+		Where("questionnaire_version_id = ?", versionId).
+		Where("age_category = ?", ageCategory))
+}
+
+func (r *observationQuestionRepository) GetByVersionId(ctx context.Context, versionId int) ([]*entities.ObservationQuestion, error) {
+	return r.find(r.db.WithContext(ctx).
+		Where("questionnaire_version_id = ?", versionId).
+		Order("age_category asc"))
+}
+
+// ExistConflicting reports whether another question in the same version
+// already uses the code, or the number within the age category.
+func (r *observationQuestionRepository) ExistConflicting(ctx context.Context, question *entities.ObservationQuestion) (bool, error) {
+	var count int64
+
 	if err := r.db.WithContext(ctx).
-		Where("age_category = ?", ageCategory).
+		Model(&models.ObservationQuestion{}).
+		Where("questionnaire_version_id = ? AND id <> ?", question.QuestionnaireVersionId, question.Id).
+		Where("question_code = ? OR (age_category = ? AND question_number = ?)", question.QuestionCode, question.AgeCategory, question.QuestionNumber).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check question conflicts: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *observationQuestionRepository) find(query *gorm.DB) ([]*entities.ObservationQuestion, error) {
+	var dbObservationQuestions []*models.ObservationQuestion
+	if err := query.
 		Order("question_number asc").
 		Find(&dbObservationQuestions).Error; err != nil {
 		return nil, fmt.Errorf("failed to get question: %w", err)
@@ -64,16 +157,33 @@ func (r *observationQuestionRepository) GetByAgeCategory(ctx context.Context, ag
 	return questions, nil
 }
 
+func (r *observationQuestionRepository) entityToModel(question *entities.ObservationQuestion) *models.ObservationQuestion {
+	return &models.ObservationQuestion{
+		Id:                     question.Id,
+		QuestionnaireVersionId: question.QuestionnaireVersionId,
+		QuestionCode:           question.QuestionCode,
+		AgeCategory:            question.AgeCategory,
+		Domain:                 question.Domain,
+		QuestionNumber:         question.QuestionNumber,
+		QuestionText:           question.QuestionText,
+		Score:                  question.Score,
+		IsActive:               question.IsActive,
+		CreatedAt:              question.CreatedAt,
+	}
+}
+
 func (r *observationQuestionRepository) modelToEntity(dbObservationQuestion *models.ObservationQuestion) *entities.ObservationQuestion {
 	observationQuestion := &entities.ObservationQuestion{
-		Id:             dbObservationQuestion.Id,
-		QuestionCode:   dbObservationQuestion.QuestionCode,
-		AgeCategory:    dbObservationQuestion.AgeCategory,
-		QuestionNumber: dbObservationQuestion.QuestionNumber,
-		QuestionText:   dbObservationQuestion.QuestionText,
-		Score:          dbObservationQuestion.Score,
-		IsActive:       dbObservationQuestion.IsActive,
-		CreatedAt:      dbObservationQuestion.CreatedAt,
+		Id:                     dbObservationQuestion.Id,
+		QuestionnaireVersionId: dbObservationQuestion.QuestionnaireVersionId,
+		QuestionCode:           dbObservationQuestion.QuestionCode,
+		AgeCategory:            dbObservationQuestion.AgeCategory,
+		Domain:                 dbObservationQuestion.Domain,
+		QuestionNumber:         dbObservationQuestion.QuestionNumber,
+		QuestionText:           dbObservationQuestion.QuestionText,
+		Score:                  dbObservationQuestion.Score,
+		IsActive:               dbObservationQuestion.IsActive,
+		CreatedAt:              dbObservationQuestion.CreatedAt,
 	}
 
 	return observationQuestion
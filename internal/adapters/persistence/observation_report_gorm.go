@@ -0,0 +1,79 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type observationReportRepository struct {
+	db *gorm.DB
+}
+
+func NewObservationReportRepository(db *gorm.DB) repositories.ObservationReportRepository {
+	return &observationReportRepository{
+		db: db,
+	}
+}
+
+// Upsert keeps one report per observation, replacing an outdated one.
+func (r *observationReportRepository) Upsert(ctx context.Context, tx *gorm.DB, report *entities.ObservationReport) error {
+	if report == nil || report.ObservationId == 0 {
+		return errors.New("report cannot be empty")
+	}
+
+	dbReport := &models.ObservationReport{
+		ObservationId:   report.ObservationId,
+		FileName:        report.FileName,
+		Content:         report.Content,
+		Checksum:        report.Checksum,
+		SourceUpdatedAt: report.SourceUpdatedAt,
+		SourceChecksum:  report.SourceChecksum,
+		GeneratedAt:     report.GeneratedAt,
+	}
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "observation_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"file_name", "content", "checksum", "source_updated_at", "source_checksum", "generated_at"}),
+		}).
+		Create(dbReport).Error; err != nil {
+		return fmt.Errorf("failed to save observation report: %w", err)
+	}
+
+	return nil
+}
+
+// GetByObservationId returns nil without an error when no report was stored yet.
+func (r *observationReportRepository) GetByObservationId(ctx context.Context, observationId int) (*entities.ObservationReport, error) {
+	if observationId == 0 {
+		return nil, errors.New("observationId cannot be empty")
+	}
+
+	var dbReport models.ObservationReport
+
+	if err := r.db.WithContext(ctx).
+		First(&dbReport, "observation_id = ?", observationId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get observation report: %w", err)
+	}
+
+	return &entities.ObservationReport{
+		Id:              dbReport.Id,
+		ObservationId:   dbReport.ObservationId,
+		FileName:        dbReport.FileName,
+		Content:         dbReport.Content,
+		Checksum:        dbReport.Checksum,
+		SourceUpdatedAt: dbReport.SourceUpdatedAt,
+		SourceChecksum:  dbReport.SourceChecksum,
+		GeneratedAt:     dbReport.GeneratedAt,
+	}, nil
+}
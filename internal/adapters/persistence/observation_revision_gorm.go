@@ -0,0 +1,216 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type observationRevisionRepository struct {
+	db *gorm.DB
+}
+
+// revisionAnswer and revisionDomainScore are the JSON shapes of a revision's
+// snapshot columns.
+type revisionAnswer struct {
+	QuestionId  int     `json:"question_id"`
+	Answer      bool    `json:"answer"`
+	ScoreEarned int     `json:"score_earned"`
+	Note        *string `json:"note,omitempty"`
+}
+
+type revisionDomainScore struct {
+	Domain    string `json:"domain"`
+	Score     int    `json:"score"`
+	MaxScore  int    `json:"max_score"`
+	RiskLevel string `json:"risk_level"`
+}
+
+func NewObservationRevisionRepository(db *gorm.DB) repositories.ObservationRevisionRepository {
+	return &observationRevisionRepository{
+		db: db,
+	}
+}
+
+func (r *observationRevisionRepository) Create(ctx context.Context, tx *gorm.DB, revision *entities.ObservationRevision) error {
+	if revision == nil {
+		return errors.New("revision cannot be empty")
+	}
+
+	dbRevision, err := r.entityToModel(revision)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.WithContext(ctx).Create(dbRevision).Error; err != nil {
+		return fmt.Errorf("failed to create observation revision: %w", err)
+	}
+
+	revision.Id = dbRevision.Id
+	return nil
+}
+
+func (r *observationRevisionRepository) NextRevisionNumber(ctx context.Context, tx *gorm.DB, observationId int) (int, error) {
+	var latest int
+
+	if err := tx.WithContext(ctx).
+		Model(&models.ObservationRevision{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("COALESCE(MAX(revision), 0)").
+		Where("observation_id = ?", observationId).
+		Scan(&latest).Error; err != nil {
+		return 0, fmt.Errorf("failed to get latest observation revision: %w", err)
+	}
+
+	return latest + 1, nil
+}
+
+func (r *observationRevisionRepository) GetByObservationId(ctx context.Context, observationId int) ([]*entities.ObservationRevision, error) {
+	if observationId == 0 {
+		return nil, errors.New("observationId cannot be empty")
+	}
+
+	var dbRevisions []*models.ObservationRevision
+
+	if err := r.db.WithContext(ctx).
+		Where("observation_id = ?", observationId).
+		Order("revision asc").
+		Find(&dbRevisions).Error; err != nil {
+		return nil, fmt.Errorf("failed to get observation revisions: %w", err)
+	}
+
+	revisions := make([]*entities.ObservationRevision, 0, len(dbRevisions))
+	for _, dbRevision := range dbRevisions {
+		revision, err := r.modelToEntity(dbRevision)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+func (r *observationRevisionRepository) entityToModel(revision *entities.ObservationRevision) (*models.ObservationRevision, error) {
+	answers := make([]revisionAnswer, 0, len(revision.Answers))
+	for _, answer := range revision.Answers {
+		answers = append(answers, revisionAnswer{
+			QuestionId:  answer.QuestionId,
+			Answer:      answer.Answer,
+			ScoreEarned: answer.ScoreEarned,
+			Note:        answer.Note,
+		})
+	}
+
+	domainScores := make([]revisionDomainScore, 0, len(revision.DomainScores))
+	for _, score := range revision.DomainScores {
+		domainScores = append(domainScores, revisionDomainScore{
+			Domain:    score.Domain,
+			Score:     score.Score,
+			MaxScore:  score.MaxScore,
+			RiskLevel: score.RiskLevel,
+		})
+	}
+
+	answersJSON, err := json.Marshal(answers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode revision answers: %w", err)
+	}
+
+	domainScoresJSON, err := json.Marshal(domainScores)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode revision domain scores: %w", err)
+	}
+
+	return &models.ObservationRevision{
+		ObservationId:      revision.ObservationId,
+		Revision:           revision.Revision,
+		TotalScore:         revision.TotalScore,
+		Conclusion:         revision.Conclusion,
+		Recommendation:     revision.Recommendation,
+		SuggestedRiskLevel: optionalString(revision.SuggestedRiskLevel),
+		SuggestedSection:   optionalString(revision.SuggestedSection),
+		RiskLevel:          optionalString(revision.RiskLevel),
+		TherapySection:     optionalString(revision.TherapySection),
+		Answers:            string(answersJSON),
+		DomainScores:       string(domainScoresJSON),
+		Reason:             revision.Reason,
+		ActorId:            revision.ActorId,
+		ActorRole:          revision.ActorRole,
+		CreatedAt:          revision.CreatedAt,
+	}, nil
+}
+
+func (r *observationRevisionRepository) modelToEntity(dbRevision *models.ObservationRevision) (*entities.ObservationRevision, error) {
+	var answers []revisionAnswer
+	if err := json.Unmarshal([]byte(dbRevision.Answers), &answers); err != nil {
+		return nil, fmt.Errorf("failed to decode revision answers: %w", err)
+	}
+
+	var domainScores []revisionDomainScore
+	if err := json.Unmarshal([]byte(dbRevision.DomainScores), &domainScores); err != nil {
+		return nil, fmt.Errorf("failed to decode revision domain scores: %w", err)
+	}
+
+	revision := &entities.ObservationRevision{
+		Id:                 dbRevision.Id,
+		ObservationId:      dbRevision.ObservationId,
+		Revision:           dbRevision.Revision,
+		TotalScore:         dbRevision.TotalScore,
+		Conclusion:         dbRevision.Conclusion,
+		Recommendation:     dbRevision.Recommendation,
+		SuggestedRiskLevel: stringValue(dbRevision.SuggestedRiskLevel),
+		SuggestedSection:   stringValue(dbRevision.SuggestedSection),
+		RiskLevel:          stringValue(dbRevision.RiskLevel),
+		TherapySection:     stringValue(dbRevision.TherapySection),
+		Answers:            make([]entities.ObservationAnswer, 0, len(answers)),
+		DomainScores:       make([]entities.ObservationDomainScore, 0, len(domainScores)),
+		Reason:             dbRevision.Reason,
+		ActorId:            dbRevision.ActorId,
+		ActorRole:          dbRevision.ActorRole,
+		CreatedAt:          dbRevision.CreatedAt,
+	}
+
+	for _, answer := range answers {
+		revision.Answers = append(revision.Answers, entities.ObservationAnswer{
+			ObservationId: dbRevision.ObservationId,
+			QuestionId:    answer.QuestionId,
+			Answer:        answer.Answer,
+			ScoreEarned:   answer.ScoreEarned,
+			Note:          answer.Note,
+		})
+	}
+
+	for _, score := range domainScores {
+		revision.DomainScores = append(revision.DomainScores, entities.ObservationDomainScore{
+			ObservationId: dbRevision.ObservationId,
+			Domain:        score.Domain,
+			Score:         score.Score,
+			MaxScore:      score.MaxScore,
+			RiskLevel:     score.RiskLevel,
+		})
+	}
+
+	return revision, nil
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,76 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type observationStatusHistoryRepository struct {
+	db *gorm.DB
+}
+
+func NewObservationStatusHistoryRepository(db *gorm.DB) repositories.ObservationStatusHistoryRepository {
+	return &observationStatusHistoryRepository{
+		db: db,
+	}
+}
+
+func (r *observationStatusHistoryRepository) Create(ctx context.Context, tx *gorm.DB, history *entities.ObservationStatusHistory) error {
+	if history == nil {
+		return errors.New("status history cannot be empty")
+	}
+
+	dbHistory := &models.ObservationStatusHistory{
+		ObservationId: history.ObservationId,
+		FromStatus:    history.FromStatus,
+		ToStatus:      history.ToStatus,
+		Reason:        history.Reason,
+		ActorId:       history.ActorId,
+		ActorRole:     history.ActorRole,
+		CreatedAt:     history.CreatedAt,
+	}
+
+	if err := tx.WithContext(ctx).Create(dbHistory).Error; err != nil {
+		return fmt.Errorf("failed to create observation status history: %w", err)
+	}
+
+	history.Id = dbHistory.Id
+	return nil
+}
+
+func (r *observationStatusHistoryRepository) GetByObservationId(ctx context.Context, observationId int) ([]*entities.ObservationStatusHistory, error) {
+	if observationId == 0 {
+		return nil, errors.New("observationId cannot be empty")
+	}
+
+	var dbHistories []*models.ObservationStatusHistory
+
+	if err := r.db.WithContext(ctx).
+		Where("observation_id = ?", observationId).
+		Order("created_at asc, id asc").
+		Find(&dbHistories).Error; err != nil {
+		return nil, fmt.Errorf("failed to get observation status history: %w", err)
+	}
+
+	histories := make([]*entities.ObservationStatusHistory, 0, len(dbHistories))
+	for _, dbHistory := range dbHistories {
+		histories = append(histories, &entities.ObservationStatusHistory{
+			Id:            dbHistory.Id,
+			ObservationId: dbHistory.ObservationId,
+			FromStatus:    dbHistory.FromStatus,
+			ToStatus:      dbHistory.ToStatus,
+			Reason:        dbHistory.Reason,
+			ActorId:       dbHistory.ActorId,
+			ActorRole:     dbHistory.ActorRole,
+			CreatedAt:     dbHistory.CreatedAt,
+		})
+	}
+
+	return histories, nil
+}
@@ -0,0 +1,255 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+type listSpec struct {
+	sortColumns   map[string]string
+	defaultSort   string
+	defaultOrder  string
+	searchColumns []string
+	dateColumn    string
+	idColumn      string
+}
+
+type listCursor struct {
+	Value  any    `json:"v"`
+	IsTime bool   `json:"t,omitempty"`
+	Id     any    `json:"id"`
+	SortBy string `json:"s"`
+}
+
+// paginate applies search, date range, sorting and page or cursor limits to
+// query, which must already be scoped to its model. Preloads are attached
+// after counting so the total does not trigger them.
+func paginate[T any](
+	query *gorm.DB,
+	listQuery entities.ListQuery,
+	spec listSpec,
+	preload func(*gorm.DB) *gorm.DB,
+) ([]*T, *entities.PageInfo, error) {
+	if search := strings.TrimSpace(listQuery.Search); search != "" && len(spec.searchColumns) > 0 {
+		pattern := "%" + escapeLike(search) + "%"
+		conditions := make([]string, 0, len(spec.searchColumns))
+		args := make([]any, 0, len(spec.searchColumns))
+		for _, column := range spec.searchColumns {
+			conditions = append(conditions, column+" LIKE ?")
+			args = append(args, pattern)
+		}
+		query = query.Where("("+strings.Join(conditions, " OR ")+")", args...)
+	}
+
+	if spec.dateColumn != "" {
+		if listQuery.DateFrom != nil {
+			query = query.Where(spec.dateColumn+" >= ?", *listQuery.DateFrom)
+		}
+		if listQuery.DateTo != nil {
+			query = query.Where(spec.dateColumn+" < ?", listQuery.DateTo.AddDate(0, 0, 1))
+		}
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to count records: %w", err)
+	}
+
+	sortBy := listQuery.SortBy
+	sortColumn, ok := spec.sortColumns[sortBy]
+	if !ok {
+		sortBy = spec.defaultSort
+		sortColumn = spec.sortColumns[sortBy]
+	}
+
+	sortOrder := strings.ToLower(listQuery.SortOrder)
+	if sortOrder != "asc" && sortOrder != "desc" {
+		sortOrder = spec.defaultOrder
+	}
+
+	limit := listQuery.Limit()
+	pageInfo := &entities.PageInfo{
+		PageSize:   limit,
+		TotalItems: total,
+	}
+
+	if listQuery.Cursor != "" {
+		cursor, err := decodeCursor(listQuery.Cursor)
+		if err != nil || cursor.SortBy != sortBy {
+			return nil, nil, repositories.ErrInvalidCursor
+		}
+
+		comparator := ">"
+		if sortOrder == "desc" {
+			comparator = "<"
+		}
+		query = query.Where(
+			fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", sortColumn, comparator, sortColumn, spec.idColumn, comparator),
+			cursor.Value, cursor.Value, cursor.Id,
+		)
+	} else {
+		pageInfo.Page = max(listQuery.Page, 1)
+		query = query.Offset(listQuery.Offset())
+	}
+
+	query = query.
+		Order(fmt.Sprintf("%s %s", sortColumn, sortOrder)).
+		Order(fmt.Sprintf("%s %s", spec.idColumn, sortOrder)).
+		Limit(limit + 1)
+
+	if preload != nil {
+		query = preload(query)
+	}
+
+	var items []*T
+	result := query.Find(&items)
+	if result.Error != nil {
+		return nil, nil, fmt.Errorf("failed to find records: %w", result.Error)
+	}
+
+	if len(items) > limit {
+		items = items[:limit]
+		pageInfo.HasNext = true
+
+		nextCursor, err := encodeCursor(result, items[len(items)-1], sortBy, sortColumn, spec.idColumn)
+		if err != nil {
+			return nil, nil, err
+		}
+		pageInfo.NextCursor = nextCursor
+	}
+
+	return items, pageInfo, nil
+}
+
+func encodeCursor(result *gorm.DB, item any, sortBy, sortColumn, idColumn string) (string, error) {
+	if result.Statement.Schema == nil {
+		return "", errors.New("failed to build cursor: unknown schema")
+	}
+
+	ctx := result.Statement.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	value, err := fieldValue(ctx, result, item, sortColumn)
+	if err != nil {
+		return "", err
+	}
+	id, err := fieldValue(ctx, result, item, idColumn)
+	if err != nil {
+		return "", err
+	}
+
+	cursor := listCursor{Value: value, Id: id, SortBy: sortBy}
+	if t, ok := value.(time.Time); ok {
+		cursor.Value = t.Format(time.RFC3339Nano)
+		cursor.IsTime = true
+	}
+
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to build cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeCursor(encoded string) (*listCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor listCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, err
+	}
+
+	if cursor.IsTime {
+		s, ok := cursor.Value.(string)
+		if !ok {
+			return nil, errors.New("invalid cursor value")
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, err
+		}
+		cursor.Value = t
+	}
+
+	return &cursor, nil
+}
+
+// columnReference finds the table and column a sort expression reads, such
+// as observations.total_score in COALESCE(observations.total_score, 0).
+var columnReference = regexp.MustCompile(`(\w+)\.(\w+)`)
+
+// fieldValue reads the value behind column from a loaded item. A column of a
+// joined table is read through the item's preloaded relation to that table.
+func fieldValue(ctx context.Context, result *gorm.DB, item any, column string) (any, error) {
+	itemSchema := result.Statement.Schema
+	itemValue := reflect.ValueOf(item).Elem()
+
+	if match := columnReference.FindStringSubmatch(column); match != nil {
+		table := match[1]
+		column = match[2]
+
+		if table != itemSchema.Table {
+			relation := relationTo(itemSchema, table)
+			if relation == nil {
+				return nil, fmt.Errorf("failed to build cursor: no relation to %s", table)
+			}
+
+			related, isZero := relation.Field.ValueOf(ctx, itemValue)
+			if isZero {
+				return nil, fmt.Errorf("failed to build cursor: %s is not loaded", relation.Name)
+			}
+
+			itemSchema = relation.FieldSchema
+			itemValue = reflect.Indirect(reflect.ValueOf(related))
+		}
+	}
+
+	field := itemSchema.LookUpField(column)
+	if field == nil {
+		return nil, fmt.Errorf("failed to build cursor: unknown column %s", column)
+	}
+
+	value, _ := field.ValueOf(ctx, itemValue)
+	if valuer, ok := value.(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return value, nil
+}
+
+// relationTo finds the single-record relation that loads rows of table.
+func relationTo(itemSchema *schema.Schema, table string) *schema.Relationship {
+	for _, relation := range itemSchema.Relationships.Relations {
+		if relation.FieldSchema.Table != table {
+			continue
+		}
+		if relation.Type == schema.BelongsTo || relation.Type == schema.HasOne {
+			return relation
+		}
+	}
+
+	return nil
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
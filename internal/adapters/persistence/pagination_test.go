@@ -0,0 +1,138 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func adminRows(count int, base time.Time) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "user_id", "admin_name", "created_at", "updated_at"})
+	for i := 1; i <= count; i++ {
+		created := base.Add(time.Duration(i) * time.Hour)
+		rows.AddRow(fmt.Sprintf("admin-%d", i), fmt.Sprintf("user-%d", i), fmt.Sprintf("Admin %d", i), created, created)
+	}
+	return rows
+}
+
+func userRows(count int) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "username", "email", "is_active"})
+	for i := 1; i <= count; i++ {
+		rows.AddRow(fmt.Sprintf("user-%d", i), fmt.Sprintf("admin%d", i), fmt.Sprintf("admin%d@example.com", i), true)
+	}
+	return rows
+}
+
+func TestAdminListPagesByEverySortKey(t *testing.T) {
+	sortKeys := make([]string, 0, len(adminListSpec.sortColumns))
+	for sortBy := range adminListSpec.sortColumns {
+		sortKeys = append(sortKeys, sortBy)
+	}
+	sort.Strings(sortKeys)
+
+	base := time.Date(2025, 9, 1, 8, 0, 0, 0, time.UTC)
+
+	for _, sortBy := range sortKeys {
+		t.Run(sortBy, func(t *testing.T) {
+			conn, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("sqlmock: %v", err)
+			}
+			repo := NewAdminRepository(openMockDB(t, conn))
+			query := entities.ListQuery{PageSize: 2, SortBy: sortBy}
+
+			mock.ExpectQuery("SELECT count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+			mock.ExpectQuery("FROM `admins`").WillReturnRows(adminRows(3, base))
+			mock.ExpectQuery("FROM `users`").WillReturnRows(userRows(3))
+
+			admins, pageInfo, err := repo.GetAll(context.Background(), query)
+			if err != nil {
+				t.Fatalf("first page: %v", err)
+			}
+			if len(admins) != 2 || !pageInfo.HasNext || pageInfo.NextCursor == "" {
+				t.Fatalf("first page = %d admins, HasNext %v, cursor %q", len(admins), pageInfo.HasNext, pageInfo.NextCursor)
+			}
+
+			cursor, err := decodeCursor(pageInfo.NextCursor)
+			if err != nil {
+				t.Fatalf("decode cursor: %v", err)
+			}
+			if cursor.Id != "admin-2" || cursor.SortBy != sortBy {
+				t.Fatalf("cursor = %+v, want admin-2 sorted by %s", cursor, sortBy)
+			}
+
+			column := regexp.QuoteMeta(adminListSpec.sortColumns[sortBy])
+			mock.ExpectQuery("SELECT count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+			mock.ExpectQuery(fmt.Sprintf(`\(%s < \? OR \(%s = \? AND admins\.id < \?\)\)`, column, column)).
+				WithArgs(true, cursor.Value, cursor.Value, "admin-2", 3).
+				WillReturnRows(adminRows(1, base))
+			mock.ExpectQuery("FROM `users`").WillReturnRows(userRows(1))
+
+			query.Cursor = pageInfo.NextCursor
+			if _, _, err := repo.GetAll(context.Background(), query); err != nil {
+				t.Fatalf("next page: %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestObservationCursorTreatsMissingScoreAsZero(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	repo := NewObservationRepository(openMockDB(t, conn))
+	query := entities.ListQuery{PageSize: 1, SortBy: "total_score"}
+
+	rows := sqlmock.NewRows([]string{"id", "child_id", "total_score", "status"}).
+		AddRow(7, "child-1", nil, "Pending").
+		AddRow(6, "child-2", nil, "Pending")
+
+	mock.ExpectQuery("SELECT count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta("ORDER BY COALESCE(observations.total_score, 0) desc")).WillReturnRows(rows)
+	mock.ExpectQuery("FROM `childrens`").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, pageInfo, err := repo.GetByPendingStatus(context.Background(), query)
+	if err != nil {
+		t.Fatalf("first page: %v", err)
+	}
+	if !pageInfo.HasNext {
+		t.Fatalf("first page has no next page: %+v", pageInfo)
+	}
+
+	cursor, err := decodeCursor(pageInfo.NextCursor)
+	if err != nil {
+		t.Fatalf("decode cursor: %v", err)
+	}
+	if cursor.Value != float64(0) {
+		t.Fatalf("cursor value = %#v, want 0 so the next page still matches unscored rows", cursor.Value)
+	}
+}
+
+func TestChildSearchMatchesVerifiedParentEmail(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	repo := NewChildRepository(openMockDB(t, conn))
+
+	mock.ExpectQuery(regexp.QuoteMeta("LEFT JOIN users ON users.id = parents.user_id") + ".*" + regexp.QuoteMeta("users.email LIKE ?")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery("FROM `childrens`").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	if _, _, err := repo.GetAll(context.Background(), entities.ListQuery{Search: "parent@example.com"}); err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expectations: %v", err)
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"backend-golang/internal/domain/entities"
 	"backend-golang/internal/domain/repositories"
 	"backend-golang/internal/infrastructure/database/models"
+	"errors"
 	"fmt"
+	"time"
 
 	"context"
 
@@ -28,9 +30,11 @@ func (r *parentDetailRepository) Create(ctx context.Context, tx *gorm.DB, parent
 		ParentType:            parentDetail.ParentType,
 		ParentName:            parentDetail.ParentName,
 		ParentPhone:           parentDetail.ParentPhone,
+		ParentPhoneIndex:      parentDetail.ParentPhoneIndex,
 		ParentBirthDate:       nil,
 		ParentOccupation:      nil,
 		RelationshipWithChild: nil,
+		IsPrimary:             parentDetail.IsPrimary,
 	}
 
 	if err := tx.WithContext(ctx).Create(&dbParentDetail).Error; err != nil {
@@ -39,3 +43,151 @@ func (r *parentDetailRepository) Create(ctx context.Context, tx *gorm.DB, parent
 
 	return nil
 }
+
+func (r *parentDetailRepository) GetById(ctx context.Context, parentDetailId string) (*entities.ParentDetail, error) {
+	if parentDetailId == "" {
+		return nil, errors.New("parent detail id cannot be empty")
+	}
+
+	var dbParentDetail models.ParentDetail
+	if err := r.db.WithContext(ctx).
+		Where("id = ?", parentDetailId).
+		First(&dbParentDetail).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("parent detail not found")
+		}
+		return nil, fmt.Errorf("failed to find parent detail: %w", err)
+	}
+
+	return r.modelToEntity(&dbParentDetail), nil
+}
+
+func (r *parentDetailRepository) GetByParentId(ctx context.Context, parentId string) ([]*entities.ParentDetail, error) {
+	if parentId == "" {
+		return nil, errors.New("parent id cannot be empty")
+	}
+
+	var dbParentDetails []*models.ParentDetail
+	if err := r.db.WithContext(ctx).
+		Where("parent_id = ?", parentId).
+		Order("is_primary desc, created_at asc").
+		Find(&dbParentDetails).Error; err != nil {
+		return nil, fmt.Errorf("failed to get parent details: %w", err)
+	}
+
+	parentDetails := make([]*entities.ParentDetail, 0, len(dbParentDetails))
+	for _, dbParentDetail := range dbParentDetails {
+		parentDetails = append(parentDetails, r.modelToEntity(dbParentDetail))
+	}
+
+	return parentDetails, nil
+}
+
+func (r *parentDetailRepository) Update(ctx context.Context, tx *gorm.DB, parentDetail *entities.ParentDetail) error {
+	if parentDetail == nil {
+		return errors.New("parent detail cannot be empty")
+	}
+
+	result := tx.WithContext(ctx).
+		Model(&models.ParentDetail{}).
+		Where("id = ?", parentDetail.Id).
+		Updates(map[string]interface{}{
+			"parent_type":        parentDetail.ParentType,
+			"parent_name":        parentDetail.ParentName,
+			"parent_phone":       parentDetail.ParentPhone,
+			"parent_phone_index": parentDetail.ParentPhoneIndex,
+			"updated_at":         time.Now(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update parent detail: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("parent detail not found")
+	}
+
+	return nil
+}
+
+func (r *parentDetailRepository) ClearPrimary(ctx context.Context, tx *gorm.DB, parentId string) error {
+	if parentId == "" {
+		return errors.New("parent id cannot be empty")
+	}
+
+	if err := tx.WithContext(ctx).
+		Model(&models.ParentDetail{}).
+		Where("parent_id = ? AND is_primary = ?", parentId, true).
+		Update("is_primary", false).Error; err != nil {
+		return fmt.Errorf("failed to clear primary contact: %w", err)
+	}
+
+	return nil
+}
+
+func (r *parentDetailRepository) SetPrimary(ctx context.Context, tx *gorm.DB, parentDetailId string) error {
+	if parentDetailId == "" {
+		return errors.New("parent detail id cannot be empty")
+	}
+
+	result := tx.WithContext(ctx).
+		Model(&models.ParentDetail{}).
+		Where("id = ?", parentDetailId).
+		Update("is_primary", true)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to set primary contact: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("parent detail not found")
+	}
+
+	return nil
+}
+
+func (r *parentDetailRepository) Delete(ctx context.Context, tx *gorm.DB, parentDetailId string) error {
+	if parentDetailId == "" {
+		return errors.New("parent detail id cannot be empty")
+	}
+
+	result := tx.WithContext(ctx).
+		Where("id = ?", parentDetailId).
+		Delete(&models.ParentDetail{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete parent detail: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("parent detail not found")
+	}
+
+	return nil
+}
+
+func (r *parentDetailRepository) ExistByParentType(ctx context.Context, parentId string, parentType string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&models.ParentDetail{}).
+		Where("parent_id = ? AND parent_type = ?", parentId, parentType).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check parent type existence: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *parentDetailRepository) modelToEntity(dbParentDetail *models.ParentDetail) *entities.ParentDetail {
+	return &entities.ParentDetail{
+		Id:               dbParentDetail.Id,
+		ParentId:         dbParentDetail.ParentId,
+		ParentType:       dbParentDetail.ParentType,
+		ParentName:       dbParentDetail.ParentName,
+		ParentPhone:      dbParentDetail.ParentPhone,
+		ParentPhoneIndex: dbParentDetail.ParentPhoneIndex,
+		IsPrimary:        dbParentDetail.IsPrimary,
+		CreatedAt:        dbParentDetail.CreatedAt,
+		UpdatedAt:        dbParentDetail.UpdatedAt,
+	}
+}
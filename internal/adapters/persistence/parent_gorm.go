@@ -70,6 +70,59 @@ func (r *parentRepository) GetByTempEmail(ctx context.Context, email string) (*e
 	return r.modelToParentDomain(&dbParent), nil
 }
 
+func (r *parentRepository) GetByUserId(ctx context.Context, userId string) (*entities.Parent, error) {
+	if userId == "" {
+		return nil, errors.New("user id cannot be empty")
+	}
+
+	var dbParent models.Parent
+	if err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("ParentDetail", func(db *gorm.DB) *gorm.DB {
+			return db.Order("is_primary desc, created_at asc")
+		}).
+		Where("user_id = ?", userId).
+		First(&dbParent).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("parent not found")
+		}
+		return nil, fmt.Errorf("failed to find parent by user id: %w", err)
+	}
+
+	parent := r.modelToParentDomain(&dbParent)
+
+	if dbParent.User != nil {
+		parent.User = &entities.User{
+			Id:        dbParent.User.Id,
+			Username:  dbParent.User.Username,
+			Email:     dbParent.User.Email,
+			Role:      dbParent.User.Role,
+			IsActive:  dbParent.User.IsActive,
+			CreatedAt: dbParent.User.CreatedAt,
+			UpdatedAt: dbParent.User.UpdatedAt,
+		}
+	}
+
+	if len(dbParent.ParentDetail) > 0 {
+		parentDetails := make([]entities.ParentDetail, 0, len(dbParent.ParentDetail))
+		for _, dbParentDetail := range dbParent.ParentDetail {
+			parentDetails = append(parentDetails, entities.ParentDetail{
+				Id:          dbParentDetail.Id,
+				ParentId:    dbParentDetail.ParentId,
+				ParentType:  dbParentDetail.ParentType,
+				ParentName:  dbParentDetail.ParentName,
+				ParentPhone: dbParentDetail.ParentPhone,
+				IsPrimary:   dbParentDetail.IsPrimary,
+				CreatedAt:   dbParentDetail.CreatedAt,
+				UpdatedAt:   dbParentDetail.UpdatedAt,
+			})
+		}
+		parent.ParentDetail = parentDetails
+	}
+
+	return parent, nil
+}
+
 func (r *parentRepository) UpdateUserId(ctx context.Context, tx *gorm.DB, tempEmail string, userId string) error {
 	if tempEmail == "" || userId == "" {
 		return errors.New("user_id or temp_email cannot be empty")
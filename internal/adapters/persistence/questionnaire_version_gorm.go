@@ -0,0 +1,164 @@
+package persistence
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type questionnaireVersionRepository struct {
+	db *gorm.DB
+}
+
+func NewQuestionnaireVersionRepository(db *gorm.DB) repositories.QuestionnaireVersionRepository {
+	return &questionnaireVersionRepository{
+		db: db,
+	}
+}
+
+func (r *questionnaireVersionRepository) Create(ctx context.Context, tx *gorm.DB, version *entities.QuestionnaireVersion) error {
+	if version == nil {
+		return errors.New("questionnaire version cannot be empty")
+	}
+
+	dbVersion := &models.QuestionnaireVersion{
+		Version:     version.Version,
+		Name:        version.Name,
+		Status:      version.Status,
+		PublishedAt: version.PublishedAt,
+		CreatedAt:   version.CreatedAt,
+		UpdatedAt:   version.UpdatedAt,
+	}
+
+	if err := tx.WithContext(ctx).Create(dbVersion).Error; err != nil {
+		return fmt.Errorf("failed to create questionnaire version: %w", err)
+	}
+
+	version.Id = dbVersion.Id
+	return nil
+}
+
+// Publish archives the version currently in effect and publishes versionId in
+// its place. Only drafts can be published.
+func (r *questionnaireVersionRepository) Publish(ctx context.Context, tx *gorm.DB, versionId int) error {
+	if err := tx.WithContext(ctx).
+		Model(&models.QuestionnaireVersion{}).
+		Where("status = ?", constants.QuestionnaireStatusPublished).
+		Update("status", constants.QuestionnaireStatusArchived).Error; err != nil {
+		return fmt.Errorf("failed to archive questionnaire version: %w", err)
+	}
+
+	result := tx.WithContext(ctx).
+		Model(&models.QuestionnaireVersion{}).
+		Where("id = ? AND status = ?", versionId, constants.QuestionnaireStatusDraft).
+		Updates(map[string]interface{}{
+			"status":       constants.QuestionnaireStatusPublished,
+			"published_at": time.Now(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to publish questionnaire version: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repositories.ErrStatusChanged
+	}
+
+	return nil
+}
+
+func (r *questionnaireVersionRepository) GetAll(ctx context.Context) ([]*entities.QuestionnaireVersion, error) {
+	var dbVersions []*models.QuestionnaireVersion
+
+	if err := r.db.WithContext(ctx).
+		Order("version desc").
+		Find(&dbVersions).Error; err != nil {
+		return nil, fmt.Errorf("failed to get questionnaire versions: %w", err)
+	}
+
+	versions := make([]*entities.QuestionnaireVersion, 0, len(dbVersions))
+	for _, dbVersion := range dbVersions {
+		versions = append(versions, r.modelToEntity(dbVersion))
+	}
+
+	return versions, nil
+}
+
+func (r *questionnaireVersionRepository) GetById(ctx context.Context, versionId int) (*entities.QuestionnaireVersion, error) {
+	var dbVersion models.QuestionnaireVersion
+
+	if err := r.db.WithContext(ctx).
+		First(&dbVersion, "id = ?", versionId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("questionnaire version with id %d not found", versionId)
+		}
+		return nil, fmt.Errorf("failed to get questionnaire version: %w", err)
+	}
+
+	return r.modelToEntity(&dbVersion), nil
+}
+
+func (r *questionnaireVersionRepository) GetPublished(ctx context.Context) (*entities.QuestionnaireVersion, error) {
+	var dbVersion models.QuestionnaireVersion
+
+	if err := r.db.WithContext(ctx).
+		Where("status = ?", constants.QuestionnaireStatusPublished).
+		First(&dbVersion).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("no published questionnaire version")
+		}
+		return nil, fmt.Errorf("failed to get published questionnaire version: %w", err)
+	}
+
+	return r.modelToEntity(&dbVersion), nil
+}
+
+// LockById holds the version row so question edits cannot race a publish.
+func (r *questionnaireVersionRepository) LockById(ctx context.Context, tx *gorm.DB, versionId int) (*entities.QuestionnaireVersion, error) {
+	var dbVersion models.QuestionnaireVersion
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&dbVersion, "id = ?", versionId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("questionnaire version with id %d not found", versionId)
+		}
+		return nil, fmt.Errorf("failed to lock questionnaire version: %w", err)
+	}
+
+	return r.modelToEntity(&dbVersion), nil
+}
+
+func (r *questionnaireVersionRepository) NextVersionNumber(ctx context.Context, tx *gorm.DB) (int, error) {
+	var latest int
+
+	if err := tx.WithContext(ctx).
+		Model(&models.QuestionnaireVersion{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&latest).Error; err != nil {
+		return 0, fmt.Errorf("failed to get latest questionnaire version: %w", err)
+	}
+
+	return latest + 1, nil
+}
+
+func (r *questionnaireVersionRepository) modelToEntity(dbVersion *models.QuestionnaireVersion) *entities.QuestionnaireVersion {
+	return &entities.QuestionnaireVersion{
+		Id:          dbVersion.Id,
+		Version:     dbVersion.Version,
+		Name:        dbVersion.Name,
+		Status:      dbVersion.Status,
+		PublishedAt: dbVersion.PublishedAt,
+		CreatedAt:   dbVersion.CreatedAt,
+		UpdatedAt:   dbVersion.UpdatedAt,
+	}
+}
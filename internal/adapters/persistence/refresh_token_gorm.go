@@ -8,8 +8,10 @@ import (
 
 	"errors"
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type refreshTokenRepository struct {
@@ -26,12 +28,17 @@ func (r *refreshTokenRepository) Create(ctx context.Context, token *entities.Ref
 	}
 
 	dbToken := &models.RefreshToken{
-		Id:        token.Id,
-		UserId:    token.UserId,
-		Token:     token.Token,
-		ExpiresAt: token.ExpiresAt,
-		Revoked:   token.Revoked,
-		CreatedAt: token.CreatedAt,
+		Id:         token.Id,
+		UserId:     token.UserId,
+		Token:      token.Token,
+		FamilyId:   token.FamilyId,
+		UserAgent:  token.UserAgent,
+		IpAddress:  token.IpAddress,
+		SignedInAt: token.SignedInAt,
+		LastUsedAt: token.LastUsedAt,
+		ExpiresAt:  token.ExpiresAt,
+		Revoked:    token.Revoked,
+		CreatedAt:  token.CreatedAt,
 	}
 
 	if err := r.db.WithContext(ctx).Create(&dbToken).Error; err != nil {
@@ -80,13 +87,137 @@ func (r *refreshTokenRepository) RevokeStatus(ctx context.Context, token string)
 	return nil
 }
 
+func (r *refreshTokenRepository) LockByToken(ctx context.Context, tx *gorm.DB, token string) (*entities.RefreshToken, error) {
+	if token == "" {
+		return nil, errors.New("refresh token cannot be empty")
+	}
+
+	var dbToken models.RefreshToken
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token = ?", token).
+		First(&dbToken).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("refresh token not found")
+		}
+		return nil, fmt.Errorf("failed to lock refresh token: %w", err)
+	}
+
+	return r.modelToRefreshTokenEntity(&dbToken), nil
+}
+
+func (r *refreshTokenRepository) Rotate(ctx context.Context, tx *gorm.DB, current *entities.RefreshToken, next *entities.RefreshToken) error {
+	if current == nil || next == nil {
+		return errors.New("refresh token cannot be nil")
+	}
+
+	dbToken := &models.RefreshToken{
+		UserId:     next.UserId,
+		Token:      next.Token,
+		FamilyId:   next.FamilyId,
+		UserAgent:  next.UserAgent,
+		IpAddress:  next.IpAddress,
+		SignedInAt: next.SignedInAt,
+		LastUsedAt: next.LastUsedAt,
+		ExpiresAt:  next.ExpiresAt,
+		CreatedAt:  next.CreatedAt,
+	}
+
+	if err := tx.WithContext(ctx).Create(dbToken).Error; err != nil {
+		return fmt.Errorf("failed to save refresh token: %w", err)
+	}
+
+	if err := tx.WithContext(ctx).
+		Model(&models.RefreshToken{}).
+		Where("id = ?", current.Id).
+		Updates(map[string]interface{}{
+			"revoked":        true,
+			"replaced_by_id": dbToken.Id,
+		}).Error; err != nil {
+		return fmt.Errorf("failed to revoke rotated refresh token: %w", err)
+	}
+
+	next.Id = dbToken.Id
+	current.Revoked = true
+	current.ReplacedById = &dbToken.Id
+	return nil
+}
+
+func (r *refreshTokenRepository) RevokeFamily(ctx context.Context, tx *gorm.DB, userId string, familyId string) error {
+	if err := tx.WithContext(ctx).
+		Model(&models.RefreshToken{}).
+		Where("user_id = ? AND family_id = ? AND revoked = ?", userId, familyId, false).
+		Update("revoked", true).Error; err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	return nil
+}
+
+func (r *refreshTokenRepository) GetActiveByUserId(ctx context.Context, userId string) ([]*entities.RefreshToken, error) {
+	var dbTokens []*models.RefreshToken
+
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked = ? AND expires_at > ?", userId, false, time.Now()).
+		Order("last_used_at desc").
+		Find(&dbTokens).Error; err != nil {
+		return nil, fmt.Errorf("failed to get active refresh tokens: %w", err)
+	}
+
+	tokens := make([]*entities.RefreshToken, 0, len(dbTokens))
+	for _, dbToken := range dbTokens {
+		tokens = append(tokens, r.modelToRefreshTokenEntity(dbToken))
+	}
+
+	return tokens, nil
+}
+
+func (r *refreshTokenRepository) RevokeSession(ctx context.Context, userId string, familyId string) error {
+	result := r.db.WithContext(ctx).Model(&models.RefreshToken{}).
+		Where("user_id = ? AND family_id = ? AND revoked = ?", userId, familyId, false).
+		Update("revoked", true)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke session: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("session not found")
+	}
+
+	return nil
+}
+
+func (r *refreshTokenRepository) RevokeAllByUser(ctx context.Context, userId string, exceptFamilyId string) (int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.RefreshToken{}).
+		Where("user_id = ? AND revoked = ?", userId, false)
+
+	if exceptFamilyId != "" {
+		query = query.Where("family_id <> ?", exceptFamilyId)
+	}
+
+	result := query.Update("revoked", true)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
 func (r *refreshTokenRepository) modelToRefreshTokenEntity(dbToken *models.RefreshToken) *entities.RefreshToken {
 	return &entities.RefreshToken{
-		Id:        dbToken.Id,
-		UserId:    dbToken.UserId,
-		Token:     dbToken.Token,
-		ExpiresAt: dbToken.ExpiresAt,
-		Revoked:   dbToken.Revoked,
-		CreatedAt: dbToken.CreatedAt,
+		Id:           dbToken.Id,
+		UserId:       dbToken.UserId,
+		Token:        dbToken.Token,
+		FamilyId:     dbToken.FamilyId,
+		ReplacedById: dbToken.ReplacedById,
+		UserAgent:    dbToken.UserAgent,
+		IpAddress:    dbToken.IpAddress,
+		SignedInAt:   dbToken.SignedInAt,
+		LastUsedAt:   dbToken.LastUsedAt,
+		ExpiresAt:    dbToken.ExpiresAt,
+		Revoked:      dbToken.Revoked,
+		CreatedAt:    dbToken.CreatedAt,
 	}
 }
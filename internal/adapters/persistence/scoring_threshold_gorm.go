@@ -0,0 +1,97 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type scoringThresholdRepository struct {
+	db *gorm.DB
+}
+
+func NewScoringThresholdRepository(db *gorm.DB) repositories.ScoringThresholdRepository {
+	return &scoringThresholdRepository{
+		db: db,
+	}
+}
+
+func (r *scoringThresholdRepository) GetAll(ctx context.Context) ([]*entities.ScoringThreshold, error) {
+	var dbThresholds []*models.ScoringThreshold
+
+	if err := r.db.WithContext(ctx).
+		Order("age_category asc, domain asc").
+		Find(&dbThresholds).Error; err != nil {
+		return nil, fmt.Errorf("failed to get scoring thresholds: %w", err)
+	}
+
+	return r.modelsToEntities(dbThresholds), nil
+}
+
+func (r *scoringThresholdRepository) GetByAgeCategory(ctx context.Context, ageCategory string) ([]*entities.ScoringThreshold, error) {
+	if ageCategory == "" {
+		return nil, errors.New("age category is required")
+	}
+
+	var dbThresholds []*models.ScoringThreshold
+
+	if err := r.db.WithContext(ctx).
+		Where("age_category = ?", ageCategory).
+		Order("domain asc").
+		Find(&dbThresholds).Error; err != nil {
+		return nil, fmt.Errorf("failed to get scoring thresholds: %w", err)
+	}
+
+	return r.modelsToEntities(dbThresholds), nil
+}
+
+func (r *scoringThresholdRepository) Upsert(ctx context.Context, tx *gorm.DB, thresholds []*entities.ScoringThreshold) error {
+	if len(thresholds) == 0 {
+		return nil
+	}
+
+	dbThresholds := make([]*models.ScoringThreshold, 0, len(thresholds))
+	for _, threshold := range thresholds {
+		dbThresholds = append(dbThresholds, &models.ScoringThreshold{
+			AgeCategory:   threshold.AgeCategory,
+			Domain:        threshold.Domain,
+			ModerateScore: threshold.ModerateScore,
+			HighScore:     threshold.HighScore,
+			CreatedAt:     threshold.CreatedAt,
+			UpdatedAt:     threshold.UpdatedAt,
+		})
+	}
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			DoUpdates: clause.AssignmentColumns([]string{"moderate_score", "high_score", "updated_at"}),
+		}).
+		Create(&dbThresholds).Error; err != nil {
+		return fmt.Errorf("failed to save scoring thresholds: %w", err)
+	}
+
+	return nil
+}
+
+func (r *scoringThresholdRepository) modelsToEntities(dbThresholds []*models.ScoringThreshold) []*entities.ScoringThreshold {
+	thresholds := make([]*entities.ScoringThreshold, 0, len(dbThresholds))
+	for _, dbThreshold := range dbThresholds {
+		thresholds = append(thresholds, &entities.ScoringThreshold{
+			Id:            dbThreshold.Id,
+			AgeCategory:   dbThreshold.AgeCategory,
+			Domain:        dbThreshold.Domain,
+			ModerateScore: dbThreshold.ModerateScore,
+			HighScore:     dbThreshold.HighScore,
+			CreatedAt:     dbThreshold.CreatedAt,
+			UpdatedAt:     dbThreshold.UpdatedAt,
+		})
+	}
+
+	return thresholds
+}
@@ -0,0 +1,254 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type searchRepository struct {
+	db            *gorm.DB
+	childRepo     *childRepository
+	therapistRepo *therapistRepository
+}
+
+func NewSearchRepository(db *gorm.DB) repositories.SearchRepository {
+	return &searchRepository{
+		db:            db,
+		childRepo:     &childRepository{db: db},
+		therapistRepo: &therapistRepository{db: db},
+	}
+}
+
+type scoredId struct {
+	Id    string
+	Score float64
+}
+
+func (r *searchRepository) SearchChildren(ctx context.Context, query entities.SearchQuery) ([]*entities.ChildSearchResult, error) {
+	terms := fullTextTerms(query.Text)
+	prefix := escapeLike(query.Text) + "%"
+
+	var hits []scoredId
+	if err := r.db.WithContext(ctx).
+		Table("childrens").
+		Select(`childrens.id AS id,
+			(CASE WHEN childrens.child_address_index = ? THEN 100 ELSE 0 END) +
+			(CASE WHEN childrens.child_name = ? THEN 20 WHEN childrens.child_name LIKE ? THEN 10 ELSE 0 END) +
+			(CASE WHEN childrens.child_school LIKE ? THEN 5 ELSE 0 END) +
+			MATCH(childrens.child_name, childrens.child_school) AGAINST (? IN BOOLEAN MODE) AS score`,
+			nullIfEmpty(query.AddressIndex), query.Text, prefix, prefix, terms).
+		Where(`childrens.child_address_index = ?
+			OR childrens.child_name LIKE ?
+			OR childrens.child_school LIKE ?
+			OR MATCH(childrens.child_name, childrens.child_school) AGAINST (? IN BOOLEAN MODE)`,
+			nullIfEmpty(query.AddressIndex), prefix, prefix, terms).
+		Order("score desc").
+		Limit(query.Limit).
+		Scan(&hits).Error; err != nil {
+		return nil, fmt.Errorf("failed to search children: %w", err)
+	}
+
+	if len(hits) == 0 {
+		return []*entities.ChildSearchResult{}, nil
+	}
+
+	var dbChildren []*models.Children
+	if err := r.db.WithContext(ctx).
+		Preload("Parent").
+		Preload("Parent.ParentDetail").
+		Where("id IN ?", hitIds(hits)).
+		Find(&dbChildren).Error; err != nil {
+		return nil, fmt.Errorf("failed to load children: %w", err)
+	}
+
+	scores := hitScores(hits)
+	results := make([]*entities.ChildSearchResult, 0, len(dbChildren))
+	for _, dbChild := range dbChildren {
+		results = append(results, &entities.ChildSearchResult{
+			Child: r.childRepo.modelToEntity(dbChild),
+			Score: scores[dbChild.Id],
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	return results, nil
+}
+
+func (r *searchRepository) SearchParents(ctx context.Context, query entities.SearchQuery) ([]*entities.ParentSearchResult, error) {
+	terms := fullTextTerms(query.Text)
+	prefix := escapeLike(query.Text) + "%"
+
+	var hits []scoredId
+	if err := r.db.WithContext(ctx).
+		Table("parents").
+		Joins("LEFT JOIN parent_details ON parent_details.parent_id = parents.id").
+		Joins("LEFT JOIN users ON users.id = parents.user_id").
+		Select(`parents.id AS id, MAX(
+			(CASE WHEN parent_details.parent_phone_index = ? THEN 100 ELSE 0 END) +
+			(CASE WHEN users.email = ? OR parents.temp_email = ? THEN 50
+				WHEN users.email LIKE ? OR parents.temp_email LIKE ? THEN 10 ELSE 0 END) +
+			(CASE WHEN parent_details.parent_name = ? THEN 20 WHEN parent_details.parent_name LIKE ? THEN 10 ELSE 0 END) +
+			MATCH(parent_details.parent_name) AGAINST (? IN BOOLEAN MODE)) AS score`,
+			nullIfEmpty(query.PhoneIndex), query.Text, query.Text, prefix, prefix, query.Text, prefix, terms).
+		Where(`parent_details.parent_phone_index = ?
+			OR users.email LIKE ?
+			OR parents.temp_email LIKE ?
+			OR parent_details.parent_name LIKE ?
+			OR MATCH(parent_details.parent_name) AGAINST (? IN BOOLEAN MODE)`,
+			nullIfEmpty(query.PhoneIndex), prefix, prefix, prefix, terms).
+		Group("parents.id").
+		Order("score desc").
+		Limit(query.Limit).
+		Scan(&hits).Error; err != nil {
+		return nil, fmt.Errorf("failed to search parents: %w", err)
+	}
+
+	if len(hits) == 0 {
+		return []*entities.ParentSearchResult{}, nil
+	}
+
+	var dbParents []*models.Parent
+	if err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("ParentDetail", func(db *gorm.DB) *gorm.DB {
+			return db.Order("is_primary desc, created_at asc")
+		}).
+		Preload("Children").
+		Where("id IN ?", hitIds(hits)).
+		Find(&dbParents).Error; err != nil {
+		return nil, fmt.Errorf("failed to load parents: %w", err)
+	}
+
+	scores := hitScores(hits)
+	results := make([]*entities.ParentSearchResult, 0, len(dbParents))
+	for _, dbParent := range dbParents {
+		parent := r.childRepo.modelToParentEntity(dbParent)
+
+		if dbParent.User != nil {
+			parent.User = &entities.User{
+				Id:       dbParent.User.Id,
+				Username: dbParent.User.Username,
+				Email:    dbParent.User.Email,
+				Role:     dbParent.User.Role,
+				IsActive: dbParent.User.IsActive,
+			}
+		}
+
+		for _, dbChild := range dbParent.Children {
+			parent.Children = append(parent.Children, *r.childRepo.modelToEntity(&dbChild))
+		}
+
+		results = append(results, &entities.ParentSearchResult{
+			Parent: parent,
+			Score:  scores[dbParent.Id],
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	return results, nil
+}
+
+func (r *searchRepository) SearchTherapists(ctx context.Context, query entities.SearchQuery) ([]*entities.TherapistSearchResult, error) {
+	terms := fullTextTerms(query.Text)
+	prefix := escapeLike(query.Text) + "%"
+
+	var hits []scoredId
+	if err := r.db.WithContext(ctx).
+		Table("therapists").
+		Joins("JOIN users ON users.id = therapists.user_id").
+		Select(`therapists.id AS id,
+			(CASE WHEN therapists.therapist_phone_index = ? THEN 100 ELSE 0 END) +
+			(CASE WHEN users.email = ? THEN 50 WHEN users.email LIKE ? THEN 10 ELSE 0 END) +
+			(CASE WHEN therapists.therapist_name = ? THEN 20 WHEN therapists.therapist_name LIKE ? THEN 10 ELSE 0 END) +
+			MATCH(therapists.therapist_name) AGAINST (? IN BOOLEAN MODE) AS score`,
+			nullIfEmpty(query.PhoneIndex), query.Text, prefix, query.Text, prefix, terms).
+		Where("users.is_active = ?", true).
+		Where(`therapists.therapist_phone_index = ?
+			OR users.email LIKE ?
+			OR therapists.therapist_name LIKE ?
+			OR MATCH(therapists.therapist_name) AGAINST (? IN BOOLEAN MODE)`,
+			nullIfEmpty(query.PhoneIndex), prefix, prefix, terms).
+		Order("score desc").
+		Limit(query.Limit).
+		Scan(&hits).Error; err != nil {
+		return nil, fmt.Errorf("failed to search therapists: %w", err)
+	}
+
+	if len(hits) == 0 {
+		return []*entities.TherapistSearchResult{}, nil
+	}
+
+	var dbTherapists []*models.Therapist
+	if err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("id IN ?", hitIds(hits)).
+		Find(&dbTherapists).Error; err != nil {
+		return nil, fmt.Errorf("failed to load therapists: %w", err)
+	}
+
+	scores := hitScores(hits)
+	results := make([]*entities.TherapistSearchResult, 0, len(dbTherapists))
+	for _, dbTherapist := range dbTherapists {
+		results = append(results, &entities.TherapistSearchResult{
+			Therapist: r.therapistRepo.modelToTherapistEntity(dbTherapist),
+			Score:     scores[dbTherapist.Id],
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	return results, nil
+}
+
+// fullTextTerms turns free text into a boolean-mode query where every word
+// is a prefix match, dropping characters that MySQL treats as operators.
+func fullTextTerms(text string) string {
+	replacer := strings.NewReplacer("+", " ", "-", " ", "<", " ", ">", " ", "(", " ", ")", " ", "~", " ", "*", " ", "\"", " ", "@", " ")
+
+	terms := strings.Fields(replacer.Replace(text))
+	for i, term := range terms {
+		terms[i] = term + "*"
+	}
+
+	return strings.Join(terms, " ")
+}
+
+// nullIfEmpty keeps an empty blind index from matching rows whose index is
+// an empty string.
+func nullIfEmpty(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func hitIds(hits []scoredId) []string {
+	ids := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.Id)
+	}
+	return ids
+}
+
+func hitScores(hits []scoredId) map[string]float64 {
+	scores := make(map[string]float64, len(hits))
+	for _, hit := range hits {
+		scores[hit.Id] = hit.Score
+	}
+	return scores
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type therapistRepository struct {
@@ -28,13 +29,14 @@ func (r *therapistRepository) Create(ctx context.Context, tx *gorm.DB, therapist
 	}
 
 	var dbTherapist = &models.Therapist{
-		Id:               therapist.Id,
-		UserId:           therapist.UserId,
-		TherapistName:    therapist.TherapistName,
-		TherapistSection: therapist.TherapistSection,
-		TherapistPhone:   therapist.TherapistPhone,
-		CreatedAt:        therapist.CreatedAt,
-		UpdatedAt:        therapist.UpdatedAt,
+		Id:                  therapist.Id,
+		UserId:              therapist.UserId,
+		TherapistName:       therapist.TherapistName,
+		TherapistSection:    therapist.TherapistSection,
+		TherapistPhone:      therapist.TherapistPhone,
+		TherapistPhoneIndex: therapist.TherapistPhoneIndex,
+		CreatedAt:           therapist.CreatedAt,
+		UpdatedAt:           therapist.UpdatedAt,
 	}
 
 	if err := tx.WithContext(ctx).Create(&dbTherapist).Error; err != nil {
@@ -44,13 +46,30 @@ func (r *therapistRepository) Create(ctx context.Context, tx *gorm.DB, therapist
 	return nil
 }
 
-func (r *therapistRepository) GetAll(ctx context.Context) ([]*entities.Therapist, error) {
-	var dbTherapists []*models.Therapist
+var therapistListSpec = listSpec{
+	sortColumns: map[string]string{
+		"therapist_name":    "therapists.therapist_name",
+		"therapist_section": "therapists.therapist_section",
+		"created_at":        "therapists.created_at",
+	},
+	defaultSort:   "created_at",
+	defaultOrder:  "desc",
+	searchColumns: []string{"therapists.therapist_name", "therapists.therapist_section", "users.username", "users.email"},
+	dateColumn:    "therapists.created_at",
+	idColumn:      "therapists.id",
+}
 
-	if err := r.db.WithContext(ctx).
-		Preload("User", "is_active = ?", true).
-		Find(&dbTherapists).Error; err != nil {
-		return nil, fmt.Errorf("failed to get therapists: %w", err)
+func (r *therapistRepository) GetAll(ctx context.Context, query entities.ListQuery) ([]*entities.Therapist, *entities.PageInfo, error) {
+	baseQuery := r.db.WithContext(ctx).
+		Model(&models.Therapist{}).
+		Joins("JOIN users ON users.id = therapists.user_id").
+		Where("users.is_active = ?", true)
+
+	dbTherapists, pageInfo, err := paginate[models.Therapist](baseQuery, query, therapistListSpec, func(db *gorm.DB) *gorm.DB {
+		return db.Preload("User")
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get therapists: %w", err)
 	}
 
 	therapists := make([]*entities.Therapist, 0, len(dbTherapists))
@@ -59,7 +78,7 @@ func (r *therapistRepository) GetAll(ctx context.Context) ([]*entities.Therapist
 		therapists = append(therapists, therapist)
 	}
 
-	return therapists, nil
+	return therapists, pageInfo, nil
 }
 
 func (r *therapistRepository) GetById(ctx context.Context, therapistId string) (*entities.Therapist, error) {
@@ -98,19 +117,60 @@ func (r *therapistRepository) GetByUserId(ctx context.Context, userId string) (*
 	return r.modelToTherapistEntity(&dbTherapist), nil
 }
 
+func (r *therapistRepository) GetActiveBySection(ctx context.Context, section string) ([]*entities.Therapist, error) {
+	query := r.db.WithContext(ctx).
+		Joins("JOIN users ON users.id = therapists.user_id").
+		Where("users.is_active = ?", true)
+
+	if section != "" {
+		query = query.Where("therapists.therapist_section = ?", section)
+	}
+
+	var dbTherapists []*models.Therapist
+	if err := query.Order("therapists.therapist_name asc").Find(&dbTherapists).Error; err != nil {
+		return nil, fmt.Errorf("failed to get therapists by section: %w", err)
+	}
+
+	therapists := make([]*entities.Therapist, 0, len(dbTherapists))
+	for _, dbTherapist := range dbTherapists {
+		therapists = append(therapists, r.modelToTherapistEntity(dbTherapist))
+	}
+
+	return therapists, nil
+}
+
+// LockById serialises bookings for one therapist until tx ends.
+func (r *therapistRepository) LockById(ctx context.Context, tx *gorm.DB, therapistId string) error {
+	var dbTherapist models.Therapist
+
+	if err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", therapistId).
+		First(&dbTherapist).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("therapist with id %s not found", therapistId)
+		}
+		return fmt.Errorf("failed to lock therapist %s: %w", therapistId, err)
+	}
+
+	return nil
+}
+
 func (r *therapistRepository) Update(ctx context.Context, tx *gorm.DB, therapist *entities.Therapist) error {
 	if therapist == nil {
 		return errors.New("therapist data cannot be empty")
 	}
 
 	dbTherapist := &models.Therapist{
-		Id:               therapist.Id,
-		UserId:           therapist.UserId,
-		TherapistName:    therapist.TherapistName,
-		TherapistSection: therapist.TherapistSection,
-		TherapistPhone:   therapist.TherapistPhone,
-		CreatedAt:        therapist.CreatedAt,
-		UpdatedAt:        therapist.UpdatedAt,
+		Id:                  therapist.Id,
+		UserId:              therapist.UserId,
+		TherapistName:       therapist.TherapistName,
+		TherapistSection:    therapist.TherapistSection,
+		TherapistPhone:      therapist.TherapistPhone,
+		TherapistPhoneIndex: therapist.TherapistPhoneIndex,
+		CreatedAt:           therapist.CreatedAt,
+		UpdatedAt:           therapist.UpdatedAt,
 	}
 
 	if err := tx.WithContext(ctx).Save(dbTherapist).Error; err != nil {
@@ -159,13 +219,14 @@ func (r *therapistRepository) modelToUserEntity(dbUser *models.User) *entities.U
 
 func (r *therapistRepository) modelToTherapistEntity(dbTherapist *models.Therapist) *entities.Therapist {
 	therapist := &entities.Therapist{
-		Id:               dbTherapist.Id,
-		UserId:           dbTherapist.UserId,
-		TherapistName:    dbTherapist.TherapistName,
-		TherapistSection: dbTherapist.TherapistSection,
-		TherapistPhone:   dbTherapist.TherapistPhone,
-		CreatedAt:        dbTherapist.CreatedAt,
-		UpdatedAt:        dbTherapist.UpdatedAt,
+		Id:                  dbTherapist.Id,
+		UserId:              dbTherapist.UserId,
+		TherapistName:       dbTherapist.TherapistName,
+		TherapistSection:    dbTherapist.TherapistSection,
+		TherapistPhone:      dbTherapist.TherapistPhone,
+		TherapistPhoneIndex: dbTherapist.TherapistPhoneIndex,
+		CreatedAt:           dbTherapist.CreatedAt,
+		UpdatedAt:           dbTherapist.UpdatedAt,
 	}
 
 	if dbTherapist.User != nil {
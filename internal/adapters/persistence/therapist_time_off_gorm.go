@@ -0,0 +1,129 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type therapistTimeOffRepository struct {
+	db *gorm.DB
+}
+
+func NewTherapistTimeOffRepository(db *gorm.DB) repositories.TherapistTimeOffRepository {
+	return &therapistTimeOffRepository{
+		db: db,
+	}
+}
+
+func (r *therapistTimeOffRepository) Create(ctx context.Context, tx *gorm.DB, timeOff *entities.TherapistTimeOff) error {
+	if timeOff == nil {
+		return errors.New("time off data cannot be empty")
+	}
+
+	dbTimeOff := &models.TherapistTimeOff{
+		Id:          timeOff.Id,
+		TherapistId: timeOff.TherapistId,
+		StartAt:     timeOff.StartAt,
+		EndAt:       timeOff.EndAt,
+		Reason:      timeOff.Reason,
+		CreatedAt:   timeOff.CreatedAt,
+		UpdatedAt:   timeOff.UpdatedAt,
+	}
+
+	if err := tx.WithContext(ctx).Create(dbTimeOff).Error; err != nil {
+		return fmt.Errorf("failed to create time off: %w", err)
+	}
+
+	return nil
+}
+
+func (r *therapistTimeOffRepository) GetById(ctx context.Context, timeOffId string) (*entities.TherapistTimeOff, error) {
+	if timeOffId == "" {
+		return nil, errors.New("timeOffId cannot be empty")
+	}
+
+	var dbTimeOff models.TherapistTimeOff
+
+	if err := r.db.WithContext(ctx).
+		Where("id = ?", timeOffId).
+		First(&dbTimeOff).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("time off with id %s not found", timeOffId)
+		}
+		return nil, fmt.Errorf("failed to get time off by id: %w", err)
+	}
+
+	return r.modelToEntity(&dbTimeOff), nil
+}
+
+func (r *therapistTimeOffRepository) GetByTherapistIds(ctx context.Context, therapistIds []string, from time.Time, to time.Time) ([]*entities.TherapistTimeOff, error) {
+	if len(therapistIds) == 0 {
+		return []*entities.TherapistTimeOff{}, nil
+	}
+
+	var dbTimeOffs []*models.TherapistTimeOff
+
+	if err := r.db.WithContext(ctx).
+		Where("therapist_id IN ?", therapistIds).
+		Where("start_at < ? AND end_at > ?", to, from).
+		Order("start_at asc").
+		Find(&dbTimeOffs).Error; err != nil {
+		return nil, fmt.Errorf("failed to get time offs: %w", err)
+	}
+
+	timeOffs := make([]*entities.TherapistTimeOff, 0, len(dbTimeOffs))
+	for _, dbTimeOff := range dbTimeOffs {
+		timeOffs = append(timeOffs, r.modelToEntity(dbTimeOff))
+	}
+
+	return timeOffs, nil
+}
+
+func (r *therapistTimeOffRepository) Delete(ctx context.Context, tx *gorm.DB, timeOffId string) error {
+	result := tx.WithContext(ctx).
+		Where("id = ?", timeOffId).
+		Delete(&models.TherapistTimeOff{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete time off: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("time off not found")
+	}
+
+	return nil
+}
+
+func (r *therapistTimeOffRepository) ExistOverlapping(ctx context.Context, tx *gorm.DB, therapistId string, period entities.TimeRange) (bool, error) {
+	var count int64
+
+	if err := tx.WithContext(ctx).
+		Model(&models.TherapistTimeOff{}).
+		Where("therapist_id = ?", therapistId).
+		Where("start_at < ? AND end_at > ?", period.End, period.Start).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check overlapping time off: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r *therapistTimeOffRepository) modelToEntity(dbTimeOff *models.TherapistTimeOff) *entities.TherapistTimeOff {
+	return &entities.TherapistTimeOff{
+		Id:          dbTimeOff.Id,
+		TherapistId: dbTimeOff.TherapistId,
+		StartAt:     dbTimeOff.StartAt,
+		EndAt:       dbTimeOff.EndAt,
+		Reason:      dbTimeOff.Reason,
+		CreatedAt:   dbTimeOff.CreatedAt,
+		UpdatedAt:   dbTimeOff.UpdatedAt,
+	}
+}
@@ -0,0 +1,112 @@
+package persistence
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/infrastructure/database/models"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type therapistWorkingHourRepository struct {
+	db *gorm.DB
+}
+
+func NewTherapistWorkingHourRepository(db *gorm.DB) repositories.TherapistWorkingHourRepository {
+	return &therapistWorkingHourRepository{
+		db: db,
+	}
+}
+
+func (r *therapistWorkingHourRepository) ReplaceByTherapistId(ctx context.Context, tx *gorm.DB, therapistId string, workingHours []*entities.TherapistWorkingHour) error {
+	if therapistId == "" {
+		return errors.New("therapistId cannot be empty")
+	}
+
+	if err := tx.WithContext(ctx).
+		Where("therapist_id = ?", therapistId).
+		Delete(&models.TherapistWorkingHour{}).Error; err != nil {
+		return fmt.Errorf("failed to clear working hours: %w", err)
+	}
+
+	if len(workingHours) == 0 {
+		return nil
+	}
+
+	dbWorkingHours := make([]*models.TherapistWorkingHour, 0, len(workingHours))
+	for _, workingHour := range workingHours {
+		dbWorkingHours = append(dbWorkingHours, &models.TherapistWorkingHour{
+			Id:          workingHour.Id,
+			TherapistId: therapistId,
+			DayOfWeek:   workingHour.DayOfWeek,
+			StartTime:   workingHour.StartTime,
+			EndTime:     workingHour.EndTime,
+			SlotMinutes: workingHour.SlotMinutes,
+			AgeCategory: workingHour.AgeCategory,
+			CreatedAt:   workingHour.CreatedAt,
+			UpdatedAt:   workingHour.UpdatedAt,
+		})
+	}
+
+	if err := tx.WithContext(ctx).Create(&dbWorkingHours).Error; err != nil {
+		return fmt.Errorf("failed to create working hours: %w", err)
+	}
+
+	return nil
+}
+
+func (r *therapistWorkingHourRepository) GetByTherapistId(ctx context.Context, therapistId string) ([]*entities.TherapistWorkingHour, error) {
+	if therapistId == "" {
+		return nil, errors.New("therapistId cannot be empty")
+	}
+
+	return r.GetByTherapistIds(ctx, []string{therapistId})
+}
+
+func (r *therapistWorkingHourRepository) GetByTherapistIds(ctx context.Context, therapistIds []string) ([]*entities.TherapistWorkingHour, error) {
+	if len(therapistIds) == 0 {
+		return []*entities.TherapistWorkingHour{}, nil
+	}
+
+	var dbWorkingHours []*models.TherapistWorkingHour
+
+	if err := r.db.WithContext(ctx).
+		Where("therapist_id IN ?", therapistIds).
+		Order("day_of_week asc, start_time asc").
+		Find(&dbWorkingHours).Error; err != nil {
+		return nil, fmt.Errorf("failed to get working hours: %w", err)
+	}
+
+	workingHours := make([]*entities.TherapistWorkingHour, 0, len(dbWorkingHours))
+	for _, dbWorkingHour := range dbWorkingHours {
+		workingHours = append(workingHours, r.modelToEntity(dbWorkingHour))
+	}
+
+	return workingHours, nil
+}
+
+func (r *therapistWorkingHourRepository) modelToEntity(dbWorkingHour *models.TherapistWorkingHour) *entities.TherapistWorkingHour {
+	return &entities.TherapistWorkingHour{
+		Id:          dbWorkingHour.Id,
+		TherapistId: dbWorkingHour.TherapistId,
+		DayOfWeek:   dbWorkingHour.DayOfWeek,
+		StartTime:   trimSeconds(dbWorkingHour.StartTime),
+		EndTime:     trimSeconds(dbWorkingHour.EndTime),
+		SlotMinutes: dbWorkingHour.SlotMinutes,
+		AgeCategory: dbWorkingHour.AgeCategory,
+		CreatedAt:   dbWorkingHour.CreatedAt,
+		UpdatedAt:   dbWorkingHour.UpdatedAt,
+	}
+}
+
+// trimSeconds turns MySQL TIME values ("09:00:00") into entities.ClockLayout.
+func trimSeconds(clock string) string {
+	if len(clock) > len(entities.ClockLayout) {
+		return clock[:len(entities.ClockLayout)]
+	}
+
+	return clock
+}
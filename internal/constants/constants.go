@@ -22,11 +22,113 @@ const (
 	RegistrationStatusPending  RegistrationStatus = "Pending"
 	RegistrationStatusComplete RegistrationStatus = "Complete"
 
-	ObservationStatusPending   ObservationStatus = "Pending"
-	ObservationStatusScheduled ObservationStatus = "Scheduled"
-	ObservationStatusCompleted ObservationStatus = "Complete"
+	ObservationStatusPending     ObservationStatus = "Pending"
+	ObservationStatusScheduled   ObservationStatus = "Scheduled"
+	ObservationStatusInProgress  ObservationStatus = "InProgress"
+	ObservationStatusCompleted   ObservationStatus = "Complete"
+	ObservationStatusCancelled   ObservationStatus = "Cancelled"
+	ObservationStatusNoShow      ObservationStatus = "NoShow"
+	ObservationStatusRescheduled ObservationStatus = "Rescheduled"
+	ObservationStatusRetracted   ObservationStatus = "Retracted"
 
 	VerificationCodeStatusPending VerificationCodeStatus = "Pending"
 	VerificationCodeStatusUsed    VerificationCodeStatus = "Used"
 	VerificationCodeStatusRevoked VerificationCodeStatus = "Revoked"
 )
+
+var (
+	// ObservationUnscheduledStatuses still need a slot from an admin.
+	ObservationUnscheduledStatuses = []ObservationStatus{ObservationStatusPending, ObservationStatusNoShow}
+	// ObservationBookedStatuses occupy the therapist's slot.
+	ObservationBookedStatuses = []ObservationStatus{ObservationStatusScheduled, ObservationStatusRescheduled, ObservationStatusInProgress}
+	// ObservationOpenStatuses have not reached an outcome yet; a child has at
+	// most one of these at a time.
+	ObservationOpenStatuses = []ObservationStatus{ObservationStatusPending, ObservationStatusScheduled, ObservationStatusRescheduled, ObservationStatusInProgress, ObservationStatusNoShow}
+)
+
+type AssessmentStatus string
+
+const (
+	AssessmentStatusScheduled  AssessmentStatus = "Scheduled"
+	AssessmentStatusInProgress AssessmentStatus = "InProgress"
+	AssessmentStatusCompleted  AssessmentStatus = "Completed"
+	AssessmentStatusCancelled  AssessmentStatus = "Cancelled"
+)
+
+// AssessmentOpenStatuses have not reached an outcome yet; an observation has
+// at most one of these per therapy section.
+var AssessmentOpenStatuses = []AssessmentStatus{AssessmentStatusScheduled, AssessmentStatusInProgress}
+
+type ConferenceStatus string
+type ConsentStatus string
+
+const (
+	ConferenceStatusScheduled ConferenceStatus = "Scheduled"
+	ConferenceStatusHeld      ConferenceStatus = "Held"
+	ConferenceStatusCancelled ConferenceStatus = "Cancelled"
+)
+
+const (
+	ConsentStatusPending  ConsentStatus = "Pending"
+	ConsentStatusAgreed   ConsentStatus = "Agreed"
+	ConsentStatusPartial  ConsentStatus = "Partial"
+	ConsentStatusDeclined ConsentStatus = "Declined"
+)
+
+type InterventionStatus string
+
+// InterventionStatusScheduled is never stored; it marks a planned session
+// that has not been recorded yet.
+const (
+	InterventionStatusScheduled InterventionStatus = "Scheduled"
+	InterventionStatusAttended  InterventionStatus = "Attended"
+	InterventionStatusCancelled InterventionStatus = "Cancelled"
+	InterventionStatusNoShow    InterventionStatus = "NoShow"
+)
+
+type GoalStatus string
+
+const (
+	GoalStatusActive       GoalStatus = "Active"
+	GoalStatusAchieved     GoalStatus = "Achieved"
+	GoalStatusDiscontinued GoalStatus = "Discontinued"
+)
+
+type ProgrammeStatus string
+type EvaluationDecision string
+
+const (
+	ProgrammeStatusActive     ProgrammeStatus = "Active"
+	ProgrammeStatusPaused     ProgrammeStatus = "Paused"
+	ProgrammeStatusGraduated  ProgrammeStatus = "Graduated"
+	ProgrammeStatusDischarged ProgrammeStatus = "Discharged"
+)
+
+const (
+	EvaluationDecisionContinue EvaluationDecision = "Continue"
+	EvaluationDecisionChange   EvaluationDecision = "Change"
+	EvaluationDecisionEnd      EvaluationDecision = "End"
+)
+
+// ProgrammeOngoingStatuses still expect a next evaluation.
+var ProgrammeOngoingStatuses = []ProgrammeStatus{ProgrammeStatusActive, ProgrammeStatusPaused}
+
+type ObservationDomain string
+type RiskLevel string
+type TherapySection string
+type QuestionnaireStatus string
+
+const (
+	RiskLevelLow      RiskLevel = "Rendah"
+	RiskLevelModerate RiskLevel = "Sedang"
+	RiskLevelHigh     RiskLevel = "Tinggi"
+
+	TherapySectionOkupasi  TherapySection = "Okupasi"
+	TherapySectionFisio    TherapySection = "Fisio"
+	TherapySectionWicara   TherapySection = "Wicara"
+	TherapySectionPaedagog TherapySection = "Paedagog"
+
+	QuestionnaireStatusDraft     QuestionnaireStatus = "Draft"
+	QuestionnaireStatusPublished QuestionnaireStatus = "Published"
+	QuestionnaireStatusArchived  QuestionnaireStatus = "Archived"
+)
@@ -0,0 +1,102 @@
+package entities
+
+import "sort"
+
+// AnswerSheetDomain is one domain of a completed observation with its answers
+// in question order.
+type AnswerSheetDomain struct {
+	Code      string
+	Name      string
+	Score     int
+	MaxScore  int
+	RiskLevel string
+	Answers   []AnswerSheetItem
+}
+
+type AnswerSheetItem struct {
+	QuestionId     int
+	QuestionNumber int
+	QuestionCode   string
+	QuestionText   string
+	Answer         bool
+	Score          int
+	ScoreEarned    int
+	Note           string
+}
+
+// BuildAnswerSheet groups answers by the domain of their question, in the
+// order of domains; domains that are no longer configured follow by code, and
+// domains without answers are left out.
+func BuildAnswerSheet(answers []*ObservationAnswer, questions []*ObservationQuestion, domains []*ObservationDomain, scores []ObservationDomainScore) []AnswerSheetDomain {
+	questionById := make(map[int]*ObservationQuestion, len(questions))
+	for _, question := range questions {
+		questionById[question.Id] = question
+	}
+
+	itemsByDomain := make(map[string][]AnswerSheetItem)
+	for _, answer := range answers {
+		question, ok := questionById[answer.QuestionId]
+		if !ok {
+			continue
+		}
+
+		var note string
+		if answer.Note != nil {
+			note = *answer.Note
+		}
+
+		itemsByDomain[question.Domain] = append(itemsByDomain[question.Domain], AnswerSheetItem{
+			QuestionId:     question.Id,
+			QuestionNumber: question.QuestionNumber,
+			QuestionCode:   question.QuestionCode,
+			QuestionText:   question.QuestionText,
+			Answer:         answer.Answer,
+			Score:          question.Score,
+			ScoreEarned:    answer.ScoreEarned,
+			Note:           note,
+		})
+	}
+
+	scoreByDomain := make(map[string]ObservationDomainScore, len(scores))
+	for _, score := range scores {
+		scoreByDomain[score.Domain] = score
+	}
+
+	sheet := make([]AnswerSheetDomain, 0, len(itemsByDomain))
+	add := func(code string, name string) {
+		items, ok := itemsByDomain[code]
+		if !ok {
+			return
+		}
+		delete(itemsByDomain, code)
+
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].QuestionNumber < items[j].QuestionNumber
+		})
+
+		score := scoreByDomain[code]
+		sheet = append(sheet, AnswerSheetDomain{
+			Code:      code,
+			Name:      name,
+			Score:     score.Score,
+			MaxScore:  score.MaxScore,
+			RiskLevel: score.RiskLevel,
+			Answers:   items,
+		})
+	}
+
+	for _, domain := range domains {
+		add(domain.Code, domain.Name)
+	}
+
+	remaining := make([]string, 0, len(itemsByDomain))
+	for code := range itemsByDomain {
+		remaining = append(remaining, code)
+	}
+	sort.Strings(remaining)
+	for _, code := range remaining {
+		add(code, code)
+	}
+
+	return sheet
+}
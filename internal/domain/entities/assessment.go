@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/helpers"
+	"time"
+)
+
+// AssessmentInstrument is a scored checklist a therapist of one therapy
+// section works through during an assessment.
+type AssessmentInstrument struct {
+	Id             int
+	Code           string
+	Name           string
+	TherapySection string
+	Description    string
+	SortOrder      int
+	IsActive       bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	Items []AssessmentInstrumentItem
+}
+
+type AssessmentInstrumentItem struct {
+	Id           int
+	InstrumentId int
+	ItemNumber   int
+	ItemText     string
+	MaxScore     int
+}
+
+// Assessment follows up a completed observation in one therapy section.
+type Assessment struct {
+	Id              int
+	ObservationId   int
+	ChildId         string
+	TherapySection  string
+	AssessorId      string
+	ScheduledDate   helpers.DateOnly
+	Status          string
+	Summary         string
+	Recommendation  string
+	SessionsPerWeek *int
+	CancelReason    string
+	CompletedAt     *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	Children *Children
+	Assessor *Therapist
+}
+
+type AssessmentScore struct {
+	Id           int
+	AssessmentId int
+	ItemId       int
+	Score        int
+	Note         *string
+	UpdatedAt    time.Time
+}
+
+func (a *Assessment) IsOpen() bool {
+	for _, status := range constants.AssessmentOpenStatuses {
+		if a.Status == string(status) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,116 @@
+package entities
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/helpers"
+	"math"
+	"time"
+)
+
+// CarePlan collects what the clinic has agreed to work on with a child. A
+// child has at most one. It also carries the programme status set by the
+// latest evaluation and when the next one is due.
+type CarePlan struct {
+	Id                 int
+	ChildId            string
+	ProgrammeStatus    string
+	NextEvaluationDate *helpers.DateOnly
+	LastEvaluatedOn    *helpers.DateOnly
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+
+	Children *Children
+	Entries  []CarePlanEntry
+}
+
+// CarePlanEntry is one therapy section's recommendation, taken from the
+// assessment that produced it.
+type CarePlanEntry struct {
+	Id              int
+	CarePlanId      int
+	TherapySection  string
+	AssessmentId    *int
+	Summary         string
+	Recommendation  string
+	SessionsPerWeek *int
+	CreatedAt       time.Time
+}
+
+// CarePlanGoal is a measurable goal in one therapy section. Progress is read
+// from the latest measurement and expressed as how far the child has moved
+// from Baseline towards Target, which may lie below the baseline.
+type CarePlanGoal struct {
+	Id                  int
+	CarePlanId          int
+	ChildId             string
+	TherapySection      string
+	Description         string
+	Baseline            float64
+	Target              float64
+	MeasurementCriteria string
+	ReviewDate          helpers.DateOnly
+	Status              string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+
+	Latest *CarePlanGoalMeasurement
+}
+
+// CarePlanGoalMeasurement is one reading of a goal, taken in an intervention
+// session or an evaluation.
+type CarePlanGoalMeasurement struct {
+	Id           int
+	GoalId       int
+	SessionId    *int
+	EvaluationId *int
+	TherapistId  *string
+	Value        float64
+	Note         string
+	MeasuredOn   helpers.DateOnly
+	CreatedAt    time.Time
+}
+
+func (g *CarePlanGoal) IsActive() bool {
+	return g.Status == string(constants.GoalStatusActive)
+}
+
+// Attainment converts a measured value into percent of the way from the
+// baseline to the target, clamped to 0-100.
+func (g *CarePlanGoal) Attainment(value float64) float64 {
+	if g.Target == g.Baseline {
+		return 0
+	}
+
+	percent := (value - g.Baseline) * 100 / (g.Target - g.Baseline)
+	return math.Max(0, math.Min(100, percent))
+}
+
+// CurrentAttainment is 100 for an achieved goal and otherwise follows the
+// latest measurement; a goal never measured has not moved.
+func (g *CarePlanGoal) CurrentAttainment() float64 {
+	if g.Status == string(constants.GoalStatusAchieved) {
+		return 100
+	}
+
+	if g.Latest == nil {
+		return 0
+	}
+
+	return g.Attainment(g.Latest.Value)
+}
+
+// IsReviewDue reports whether an active goal has reached its review date.
+func (g *CarePlanGoal) IsReviewDue(today time.Time) bool {
+	return g.IsActive() && g.ReviewDate.ToTime().Format(DateLayout) <= today.Format(DateLayout)
+}
+
+// IsOngoing reports whether the programme still expects evaluations.
+func (p *CarePlan) IsOngoing() bool {
+	for _, status := range constants.ProgrammeOngoingStatuses {
+		if p.ProgrammeStatus == string(status) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"backend-golang/internal/constants"
+	"time"
+)
+
+// CaseConference is the meeting where therapists and parents agree on a
+// child's plan, together with its minutes.
+type CaseConference struct {
+	Id                int
+	ChildId           string
+	ScheduledAt       time.Time
+	Location          string
+	Status            string
+	Agenda            string
+	Decisions         string
+	ParentConsent     string
+	ConsentNote       string
+	ConsentRecordedAt *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+
+	Children     *Children
+	Participants []CaseConferenceParticipant
+	Sections     []CaseConferenceSection
+}
+
+type CaseConferenceParticipant struct {
+	ConferenceId int
+	TherapistId  string
+
+	Therapist *Therapist
+}
+
+// CaseConferenceSection is a therapy section agreed at the conference and how
+// often the child attends it.
+type CaseConferenceSection struct {
+	ConferenceId    int
+	TherapySection  string
+	SessionsPerWeek int
+}
+
+func (c *CaseConference) HasParticipant(therapistId string) bool {
+	for _, participant := range c.Participants {
+		if participant.TherapistId == therapistId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *CaseConference) IsCancelled() bool {
+	return c.Status == string(constants.ConferenceStatusCancelled)
+}
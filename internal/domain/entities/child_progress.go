@@ -0,0 +1,173 @@
+package entities
+
+import (
+	"backend-golang/internal/helpers"
+	"sort"
+)
+
+// DomainTrend is one domain's score across a child's completed observations,
+// oldest first.
+type DomainTrend struct {
+	Code   string
+	Name   string
+	Points []DomainTrendPoint
+}
+
+type DomainTrendPoint struct {
+	ObservationId int
+	ScheduledDate helpers.DateOnly
+	AgeCategory   string
+	Score         int
+	MaxScore      int
+	RiskLevel     string
+}
+
+type AgeCategoryTransition struct {
+	From              string
+	To                string
+	FromObservationId int
+	ToObservationId   int
+	ObservedOn        helpers.DateOnly
+}
+
+// QuestionChange is a question answered differently in two sessions. A nil
+// answer means the question was not asked in that session.
+type QuestionChange struct {
+	QuestionCode        string
+	QuestionText        string
+	Domain              string
+	PreviousAnswer      *bool
+	CurrentAnswer       *bool
+	PreviousScoreEarned int
+	CurrentScoreEarned  int
+}
+
+// BuildDomainTrends reads the domain scores loaded on each observation, which
+// must be in session order. Domains follow their configured order; codes that
+// are no longer configured come after, by code.
+func BuildDomainTrends(observations []*Observation, domains []*ObservationDomain) []DomainTrend {
+	pointsByDomain := make(map[string][]DomainTrendPoint)
+	for _, observation := range observations {
+		for _, score := range observation.DomainScores {
+			pointsByDomain[score.Domain] = append(pointsByDomain[score.Domain], DomainTrendPoint{
+				ObservationId: observation.Id,
+				ScheduledDate: observation.ScheduledDate,
+				AgeCategory:   observation.AgeCategory,
+				Score:         score.Score,
+				MaxScore:      score.MaxScore,
+				RiskLevel:     score.RiskLevel,
+			})
+		}
+	}
+
+	trends := make([]DomainTrend, 0, len(pointsByDomain))
+	add := func(code string, name string) {
+		points, ok := pointsByDomain[code]
+		if !ok {
+			return
+		}
+		delete(pointsByDomain, code)
+
+		trends = append(trends, DomainTrend{Code: code, Name: name, Points: points})
+	}
+
+	for _, domain := range domains {
+		add(domain.Code, domain.Name)
+	}
+
+	remaining := make([]string, 0, len(pointsByDomain))
+	for code := range pointsByDomain {
+		remaining = append(remaining, code)
+	}
+	sort.Strings(remaining)
+	for _, code := range remaining {
+		add(code, code)
+	}
+
+	return trends
+}
+
+// AgeCategoryTransitions lists where consecutive observations, in session
+// order, were taken in different age categories.
+func AgeCategoryTransitions(observations []*Observation) []AgeCategoryTransition {
+	transitions := make([]AgeCategoryTransition, 0)
+	for i := 1; i < len(observations); i++ {
+		previous, current := observations[i-1], observations[i]
+		if previous.AgeCategory == current.AgeCategory {
+			continue
+		}
+
+		transitions = append(transitions, AgeCategoryTransition{
+			From:              previous.AgeCategory,
+			To:                current.AgeCategory,
+			FromObservationId: previous.Id,
+			ToObservationId:   current.Id,
+			ObservedOn:        current.ScheduledDate,
+		})
+	}
+
+	return transitions
+}
+
+// QuestionChanges compares two answer sheets by question code, since the
+// sessions may use different questionnaire versions or age categories.
+// Changes are listed in the current sheet's order, followed by questions only
+// the previous session asked.
+func QuestionChanges(previous []AnswerSheetDomain, current []AnswerSheetDomain) []QuestionChange {
+	type located struct {
+		item   AnswerSheetItem
+		domain string
+	}
+
+	previousByCode := make(map[string]located)
+	for _, domain := range previous {
+		for _, item := range domain.Answers {
+			previousByCode[item.QuestionCode] = located{item: item, domain: domain.Code}
+		}
+	}
+
+	changes := make([]QuestionChange, 0)
+	for _, domain := range current {
+		for _, item := range domain.Answers {
+			currentAnswer := item.Answer
+			change := QuestionChange{
+				QuestionCode:       item.QuestionCode,
+				QuestionText:       item.QuestionText,
+				Domain:             domain.Code,
+				CurrentAnswer:      &currentAnswer,
+				CurrentScoreEarned: item.ScoreEarned,
+			}
+
+			if before, ok := previousByCode[item.QuestionCode]; ok {
+				delete(previousByCode, item.QuestionCode)
+				if before.item.Answer == item.Answer {
+					continue
+				}
+				previousAnswer := before.item.Answer
+				change.PreviousAnswer = &previousAnswer
+				change.PreviousScoreEarned = before.item.ScoreEarned
+			}
+
+			changes = append(changes, change)
+		}
+	}
+
+	for _, domain := range previous {
+		for _, item := range domain.Answers {
+			if _, ok := previousByCode[item.QuestionCode]; !ok {
+				continue
+			}
+
+			previousAnswer := item.Answer
+			changes = append(changes, QuestionChange{
+				QuestionCode:        item.QuestionCode,
+				QuestionText:        item.QuestionText,
+				Domain:              domain.Code,
+				PreviousAnswer:      &previousAnswer,
+				PreviousScoreEarned: item.ScoreEarned,
+			})
+		}
+	}
+
+	return changes
+}
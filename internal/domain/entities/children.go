@@ -13,12 +13,27 @@ type Children struct {
 	ChildBirthPlace    string
 	ChildBirthDate     helpers.DateOnly
 	ChildAddress       []byte
+	ChildAddressIndex  string
 	ChildComplaint     string
 	ChildSchool        *string
 	ChildServiceChoice string
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 
-	Parent      *Parent
-	Observation *Observation
+	Parent       *Parent
+	Observations []*Observation
+}
+
+// LatestObservation is the most recently scheduled observation, which is the
+// one a parent is shown by default.
+func (c *Children) LatestObservation() *Observation {
+	var latest *Observation
+	for _, observation := range c.Observations {
+		if latest == nil || observation.ScheduledDate.ToTime().After(latest.ScheduledDate.ToTime()) ||
+			(observation.ScheduledDate.ToTime().Equal(latest.ScheduledDate.ToTime()) && observation.Id > latest.Id) {
+			latest = observation
+		}
+	}
+
+	return latest
 }
@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"backend-golang/internal/helpers"
+	"time"
+)
+
+// EvaluationIntervalMonths is how long a programme runs before its first, or
+// next, evaluation unless the team picks another date.
+const EvaluationIntervalMonths = 3
+
+// Evaluation closes a block of intervention: the team reviews goal progress
+// and the latest observation, decides how therapy goes on and sets the
+// programme status.
+type Evaluation struct {
+	Id                 int
+	ChildId            string
+	EvaluationDate     helpers.DateOnly
+	Summary            string
+	Decision           string
+	ProgrammeStatus    string
+	NextEvaluationDate *helpers.DateOnly
+	ObservationId      *int
+	RecordedBy         string
+	CreatedAt          time.Time
+
+	Children *Children
+	Goals    []EvaluationGoal
+}
+
+// EvaluationGoal is a care plan goal as it stood at the evaluation, kept as
+// written then since goals may be revised later.
+type EvaluationGoal struct {
+	EvaluationId   int
+	GoalId         int
+	TherapySection string
+	Description    string
+	Value          *float64
+	Attainment     float64
+	Status         string
+	Note           string
+}
+
+// DefaultEvaluationDate is the evaluation due one interval after the day.
+func DefaultEvaluationDate(from helpers.DateOnly) helpers.DateOnly {
+	return helpers.DateOnly(from.ToTime().AddDate(0, EvaluationIntervalMonths, 0))
+}
+
+// IsEvaluationOverdue reports whether an ongoing programme has passed its
+// evaluation date.
+func (p *CarePlan) IsEvaluationOverdue(today time.Time) bool {
+	return p.IsOngoing() && p.NextEvaluationDate != nil &&
+		p.NextEvaluationDate.ToTime().Format(DateLayout) < today.Format(DateLayout)
+}
@@ -0,0 +1,208 @@
+package entities
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/helpers"
+	"time"
+)
+
+const DateLayout = "2006-01-02"
+
+// InterventionPlan is a child's recurring weekly therapy in one section with
+// one therapist. Sessions are derived from its slots between StartDate and
+// EndDate; only the ones that happened (or did not) are stored.
+type InterventionPlan struct {
+	Id             int
+	ChildId        string
+	TherapistId    string
+	TherapySection string
+	StartDate      helpers.DateOnly
+	EndDate        *helpers.DateOnly
+	SessionMinutes int
+	IsActive       bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	Children  *Children
+	Therapist *Therapist
+	Slots     []InterventionPlanSlot
+}
+
+type InterventionPlanSlot struct {
+	Id        int
+	PlanId    int
+	DayOfWeek int
+	StartTime string
+}
+
+// SessionOccurrence is one planned session of a plan.
+type SessionOccurrence struct {
+	PlanId      int
+	SessionDate helpers.DateOnly
+	StartTime   string
+}
+
+func (o SessionOccurrence) Key() string {
+	return o.SessionDate.ToTime().Format(DateLayout) + " " + o.StartTime
+}
+
+type InterventionSession struct {
+	Id             int
+	PlanId         int
+	ChildId        string
+	TherapistId    string
+	TherapySection string
+	SessionDate    helpers.DateOnly
+	StartTime      string
+	Status         string
+	CancelReason   string
+	Subjective     string
+	Objective      string
+	Assessment     string
+	Plan           string
+	RecordedAt     time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	Children  *Children
+	Therapist *Therapist
+	Goals     []InterventionSessionGoal
+
+	// Measurements are the care plan goal readings taken in the session.
+	Measurements []CarePlanGoalMeasurement
+}
+
+// InterventionSessionGoal is a goal the therapist worked on in the session,
+// optionally one from the child's care plan.
+type InterventionSessionGoal struct {
+	Id             int
+	SessionId      int
+	CarePlanGoalId *int
+	Description    string
+	Note           string
+}
+
+func (s *InterventionSession) Occurrence() SessionOccurrence {
+	return SessionOccurrence{PlanId: s.PlanId, SessionDate: s.SessionDate, StartTime: s.StartTime}
+}
+
+// Covers reports whether the plan runs on the given day.
+func (p *InterventionPlan) Covers(day time.Time) bool {
+	if !p.IsActive {
+		return false
+	}
+
+	key := day.Format(DateLayout)
+	if key < p.StartDate.ToTime().Format(DateLayout) {
+		return false
+	}
+
+	return p.EndDate == nil || key <= p.EndDate.ToTime().Format(DateLayout)
+}
+
+// Occurrences lists the planned sessions from one day to another, both
+// inclusive, ordered by date and start time as the slots are.
+func (p *InterventionPlan) Occurrences(from time.Time, to time.Time) []SessionOccurrence {
+	occurrences := make([]SessionOccurrence, 0)
+
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	for ; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if !p.Covers(day) {
+			continue
+		}
+
+		for _, slot := range p.Slots {
+			if slot.DayOfWeek != int(day.Weekday()) {
+				continue
+			}
+
+			occurrences = append(occurrences, SessionOccurrence{
+				PlanId:      p.Id,
+				SessionDate: helpers.DateOnly(day),
+				StartTime:   slot.StartTime,
+			})
+		}
+	}
+
+	return occurrences
+}
+
+// HasOccurrence reports whether the plan has a session on the date at the
+// start time.
+func (p *InterventionPlan) HasOccurrence(date helpers.DateOnly, startTime string) bool {
+	for _, occurrence := range p.Occurrences(date.ToTime(), date.ToTime()) {
+		if occurrence.StartTime == startTime {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AttendanceCounts tallies a plan's sessions. Unrecorded counts planned
+// sessions up to today that nobody recorded.
+type AttendanceCounts struct {
+	Planned    int
+	Attended   int
+	Cancelled  int
+	NoShow     int
+	Unrecorded int
+}
+
+func (c *AttendanceCounts) Add(other AttendanceCounts) {
+	c.Planned += other.Planned
+	c.Attended += other.Attended
+	c.Cancelled += other.Cancelled
+	c.NoShow += other.NoShow
+	c.Unrecorded += other.Unrecorded
+}
+
+// Rate is the share of sessions that went ahead as planned, in percent, with
+// cancelled sessions left out.
+func (c AttendanceCounts) Rate() float64 {
+	expected := c.Planned - c.Cancelled
+	if expected <= 0 {
+		return 0
+	}
+
+	return float64(c.Attended) * 100 / float64(expected)
+}
+
+// PlanAttendance counts the plan's sessions between from and to. Sessions
+// after today are only counted once recorded, e.g. cancelled in advance.
+func PlanAttendance(plan *InterventionPlan, sessions []*InterventionSession, from time.Time, to time.Time, today time.Time) AttendanceCounts {
+	var counts AttendanceCounts
+
+	recorded := make(map[string]bool, len(sessions))
+	for _, session := range sessions {
+		if session.PlanId != plan.Id {
+			continue
+		}
+		recorded[session.Occurrence().Key()] = true
+
+		counts.Planned++
+		switch session.Status {
+		case string(constants.InterventionStatusAttended):
+			counts.Attended++
+		case string(constants.InterventionStatusCancelled):
+			counts.Cancelled++
+		case string(constants.InterventionStatusNoShow):
+			counts.NoShow++
+		}
+	}
+
+	if to.Format(DateLayout) > today.Format(DateLayout) {
+		to = today
+	}
+
+	for _, occurrence := range plan.Occurrences(from, to) {
+		if recorded[occurrence.Key()] {
+			continue
+		}
+		counts.Planned++
+		counts.Unrecorded++
+	}
+
+	return counts
+}
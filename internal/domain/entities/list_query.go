@@ -0,0 +1,44 @@
+package entities
+
+import "time"
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+type ListQuery struct {
+	Page      int
+	PageSize  int
+	Cursor    string
+	SortBy    string
+	SortOrder string
+	Search    string
+	DateFrom  *time.Time
+	DateTo    *time.Time
+}
+
+type PageInfo struct {
+	Page       int
+	PageSize   int
+	TotalItems int64
+	NextCursor string
+	HasNext    bool
+}
+
+func (q ListQuery) Limit() int {
+	if q.PageSize <= 0 {
+		return DefaultPageSize
+	}
+	if q.PageSize > MaxPageSize {
+		return MaxPageSize
+	}
+	return q.PageSize
+}
+
+func (q ListQuery) Offset() int {
+	if q.Page <= 1 {
+		return 0
+	}
+	return (q.Page - 1) * q.Limit()
+}
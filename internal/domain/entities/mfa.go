@@ -0,0 +1,29 @@
+package entities
+
+import "time"
+
+// MfaEnrollment holds a user's TOTP secret, encrypted. It is pending until
+// the user confirms a code from their authenticator app; only then is a
+// second step asked for at login.
+type MfaEnrollment struct {
+	UserId       string
+	Secret       []byte
+	EnabledAt    *time.Time
+	LastUsedStep int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (e *MfaEnrollment) IsEnabled() bool {
+	return e.EnabledAt != nil
+}
+
+// MfaRecoveryCode stands in for a TOTP code once, for a user who lost their
+// authenticator. Only a hash of the code is kept.
+type MfaRecoveryCode struct {
+	Id        int
+	UserId    string
+	CodeHash  string
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
@@ -1,23 +1,118 @@
 package entities
 
 import (
+	"backend-golang/internal/constants"
 	"backend-golang/internal/helpers"
 	"time"
 )
 
 type Observation struct {
-	Id             int
-	ChildId        string
-	TherapistId    string
-	AgeCategory    string
-	TotalScore     int
-	Conclusion     string
-	Recommendation string
-	ScheduledDate  helpers.DateOnly
-	Status         string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	Id                     int
+	ChildId                string
+	TherapistId            string
+	AgeCategory            string
+	QuestionnaireVersionId *int
+	TotalScore             int
+	Conclusion             string
+	Recommendation         string
+	SuggestedRiskLevel     string
+	SuggestedSection       string
+	RiskLevel              string
+	TherapySection         string
+	DraftSavedAt           *time.Time
+	ScheduledDate          helpers.DateOnly
+	ScheduledStart         *time.Time
+	ScheduledEnd           *time.Time
+	Status                 string
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
 
 	Children          *Children
+	Therapist         *Therapist
 	ObservationAnswer []ObservationAnswer
+	DomainScores      []ObservationDomainScore
+}
+
+var observationTransitions = map[constants.ObservationStatus][]constants.ObservationStatus{
+	constants.ObservationStatusPending: {
+		constants.ObservationStatusScheduled,
+		constants.ObservationStatusCancelled,
+	},
+	constants.ObservationStatusScheduled: {
+		constants.ObservationStatusInProgress,
+		constants.ObservationStatusRescheduled,
+		constants.ObservationStatusCancelled,
+		constants.ObservationStatusNoShow,
+	},
+	constants.ObservationStatusRescheduled: {
+		constants.ObservationStatusInProgress,
+		constants.ObservationStatusRescheduled,
+		constants.ObservationStatusCancelled,
+		constants.ObservationStatusNoShow,
+	},
+	constants.ObservationStatusNoShow: {
+		constants.ObservationStatusRescheduled,
+		constants.ObservationStatusCancelled,
+	},
+	constants.ObservationStatusInProgress: {
+		constants.ObservationStatusCompleted,
+	},
+	constants.ObservationStatusCompleted: {
+		constants.ObservationStatusRetracted,
+	},
+}
+
+func (o *Observation) CanTransitionTo(to constants.ObservationStatus) bool {
+	for _, allowed := range observationTransitions[constants.ObservationStatus(o.Status)] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ScheduleTarget is the status a new slot moves the observation into.
+func (o *Observation) ScheduleTarget() constants.ObservationStatus {
+	if o.Status == string(constants.ObservationStatusPending) {
+		return constants.ObservationStatusScheduled
+	}
+
+	return constants.ObservationStatusRescheduled
+}
+
+// DefaultAgeCategory catches ages no active category covers.
+const DefaultAgeCategory = "Lainnya"
+
+// NewPendingObservation opens an observation in the age category the child is
+// in today, for an admin to schedule.
+func NewPendingObservation(childId string, birthDate helpers.DateOnly, ageCategories []*AgeCategory, now time.Time) *Observation {
+	var childAge int
+	if !birthDate.ToTime().IsZero() {
+		childAge = helpers.CalculateAge(birthDate.ToTime())
+	}
+
+	ageCategory := DefaultAgeCategory
+	for _, category := range ageCategories {
+		if category.Contains(childAge) {
+			ageCategory = category.Name
+			break
+		}
+	}
+
+	return &Observation{
+		ChildId:       childId,
+		Status:        string(constants.ObservationStatusPending),
+		AgeCategory:   ageCategory,
+		ScheduledDate: helpers.DateOnly(now.Add(48 * time.Hour).Truncate(24 * time.Hour)),
+	}
+}
+
+func TransitionRequiresReason(to constants.ObservationStatus) bool {
+	switch to {
+	case constants.ObservationStatusCancelled, constants.ObservationStatusNoShow, constants.ObservationStatusRescheduled, constants.ObservationStatusRetracted:
+		return true
+	default:
+		return false
+	}
 }
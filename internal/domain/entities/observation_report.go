@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// ObservationReport is a rendered report kept until anything printed on it
+// changes.
+type ObservationReport struct {
+	Id              int
+	ObservationId   int
+	FileName        string
+	Content         []byte
+	Checksum        string
+	SourceUpdatedAt time.Time
+	SourceChecksum  string
+	GeneratedAt     time.Time
+}
+
+// IsCurrent tells whether the report was rendered from the same data. The
+// observation's own timestamp is not enough: the report also prints the
+// guardian, the child's address and the therapist, which change elsewhere.
+func (r *ObservationReport) IsCurrent(sourceChecksum string) bool {
+	return r != nil && len(r.Content) > 0 && r.SourceChecksum == sourceChecksum
+}
+
+// ObservationReportData is everything printed on a report, already decrypted.
+type ObservationReportData struct {
+	ObservationId   int
+	ChildName       string
+	ChildGender     string
+	ChildBirthPlace string
+	ChildBirthDate  time.Time
+	ChildAge        int
+	ChildSchool     string
+	ChildAddress    string
+	ParentName      string
+	ParentPhone     string
+	AgeCategory     string
+	ObservationDate time.Time
+	TherapistName   string
+	TotalScore      int
+	RiskLevel       string
+	TherapySection  string
+	Conclusion      string
+	Recommendation  string
+	Domains         []AnswerSheetDomain
+	GeneratedAt     time.Time
+}
+
+// Checksum fingerprints what the report prints, leaving out when it was
+// generated.
+func (d *ObservationReportData) Checksum() string {
+	source := *d
+	source.GeneratedAt = time.Time{}
+
+	encoded, _ := json.Marshal(source)
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,24 @@
+package entities
+
+import "time"
+
+// ObservationRevision is a completed observation as it was just before an
+// amendment replaced it.
+type ObservationRevision struct {
+	Id                 int
+	ObservationId      int
+	Revision           int
+	TotalScore         int
+	Conclusion         string
+	Recommendation     string
+	SuggestedRiskLevel string
+	SuggestedSection   string
+	RiskLevel          string
+	TherapySection     string
+	Answers            []ObservationAnswer
+	DomainScores       []ObservationDomainScore
+	Reason             string
+	ActorId            string
+	ActorRole          string
+	CreatedAt          time.Time
+}
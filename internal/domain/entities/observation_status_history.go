@@ -0,0 +1,14 @@
+package entities
+
+import "time"
+
+type ObservationStatusHistory struct {
+	Id            int
+	ObservationId int
+	FromStatus    string
+	ToStatus      string
+	Reason        string
+	ActorId       string
+	ActorRole     string
+	CreatedAt     time.Time
+}
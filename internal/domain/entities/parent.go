@@ -14,3 +14,17 @@ type Parent struct {
 	ParentDetail []ParentDetail
 	Children     []Children
 }
+
+func (p *Parent) PrimaryContact() *ParentDetail {
+	if len(p.ParentDetail) == 0 {
+		return nil
+	}
+
+	for i := range p.ParentDetail {
+		if p.ParentDetail[i].IsPrimary {
+			return &p.ParentDetail[i]
+		}
+	}
+
+	return &p.ParentDetail[0]
+}
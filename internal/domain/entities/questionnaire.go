@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"backend-golang/internal/constants"
+	"time"
+)
+
+type QuestionnaireVersion struct {
+	Id          int
+	Version     int
+	Name        string
+	Status      string
+	PublishedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	Questions []*ObservationQuestion
+}
+
+// IsEditable reports whether questions may still change. Published and
+// archived versions are frozen so the scores pinned to them stay reproducible.
+func (v *QuestionnaireVersion) IsEditable() bool {
+	return v.Status == string(constants.QuestionnaireStatusDraft)
+}
+
+type ObservationDomain struct {
+	Id             int
+	Code           string
+	Name           string
+	TherapySection string
+	SortOrder      int
+	IsActive       bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type AgeCategory struct {
+	Id        int
+	Name      string
+	MinAge    int
+	MaxAge    *int
+	SortOrder int
+	IsActive  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Contains reports whether age, in whole years, falls in the category. A nil
+// MaxAge leaves the range open-ended.
+func (c *AgeCategory) Contains(age int) bool {
+	if age < c.MinAge {
+		return false
+	}
+
+	return c.MaxAge == nil || age <= *c.MaxAge
+}
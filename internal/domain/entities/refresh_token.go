@@ -5,14 +5,23 @@ import (
 	"time"
 )
 
+// RefreshToken is single use: every refresh replaces it with a new token of
+// the same family. A family starts at login and ends when it is revoked; to
+// the user it is one session on one device.
 type RefreshToken struct {
-	Id        int
-	UserId    string
-	Token     string
-	ExpiresAt time.Time
-	Revoked   bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Id           int
+	UserId       string
+	Token        string
+	FamilyId     string
+	ReplacedById *int
+	UserAgent    string
+	IpAddress    string
+	SignedInAt   time.Time
+	LastUsedAt   time.Time
+	ExpiresAt    time.Time
+	Revoked      bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 
 	User *User
 }
@@ -21,6 +30,12 @@ func (rt *RefreshToken) IsExpired() bool {
 	return time.Now().After(rt.ExpiresAt)
 }
 
+// IsRotated reports whether the token has already been exchanged. Seeing it
+// again means someone else holds a copy.
+func (rt *RefreshToken) IsRotated() bool {
+	return rt.ReplacedById != nil
+}
+
 func (rt *RefreshToken) IsValid() error {
 	if rt.Revoked {
 		return errors.New("refresh token has been revoked")
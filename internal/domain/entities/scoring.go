@@ -0,0 +1,30 @@
+package entities
+
+import "time"
+
+type ScoringThreshold struct {
+	Id            int
+	AgeCategory   string
+	Domain        string
+	ModerateScore int
+	HighScore     int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type ObservationDomainScore struct {
+	Id            int
+	ObservationId int
+	Domain        string
+	Score         int
+	MaxScore      int
+	RiskLevel     string
+	CreatedAt     time.Time
+}
+
+type ScoringResult struct {
+	TotalScore       int
+	DomainScores     []ObservationDomainScore
+	RiskLevel        string
+	SuggestedSection string
+}
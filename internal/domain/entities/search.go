@@ -0,0 +1,23 @@
+package entities
+
+type SearchQuery struct {
+	Text         string
+	PhoneIndex   string
+	AddressIndex string
+	Limit        int
+}
+
+type ChildSearchResult struct {
+	Child *Children
+	Score float64
+}
+
+type ParentSearchResult struct {
+	Parent *Parent
+	Score  float64
+}
+
+type TherapistSearchResult struct {
+	Therapist *Therapist
+	Score     float64
+}
@@ -5,13 +5,14 @@ import (
 )
 
 type Therapist struct {
-	Id               string
-	UserId           string
-	TherapistName    string
-	TherapistSection string
-	TherapistPhone   []byte
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
+	Id                  string
+	UserId              string
+	TherapistName       string
+	TherapistSection    string
+	TherapistPhone      []byte
+	TherapistPhoneIndex string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
 
 	User *User
 }
@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+type TherapistTimeOff struct {
+	Id          string
+	TherapistId string
+	StartAt     time.Time
+	EndAt       time.Time
+	Reason      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (t *TherapistTimeOff) Overlaps(period TimeRange) bool {
+	return period.Overlaps(TimeRange{Start: t.StartAt, End: t.EndAt})
+}
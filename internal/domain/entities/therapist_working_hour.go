@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+const ClockLayout = "15:04"
+
+type TherapistWorkingHour struct {
+	Id          string
+	TherapistId string
+	DayOfWeek   int
+	StartTime   string
+	EndTime     string
+	SlotMinutes int
+	AgeCategory *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Window returns the working period on the given date, in the date's location.
+func (w *TherapistWorkingHour) Window(date time.Time) (time.Time, time.Time, error) {
+	start, err := time.Parse(ClockLayout, w.StartTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start time %q: %w", w.StartTime, err)
+	}
+
+	end, err := time.Parse(ClockLayout, w.EndTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end time %q: %w", w.EndTime, err)
+	}
+
+	year, month, day := date.Date()
+	return time.Date(year, month, day, start.Hour(), start.Minute(), 0, 0, date.Location()),
+		time.Date(year, month, day, end.Hour(), end.Minute(), 0, 0, date.Location()),
+		nil
+}
+
+// Slots splits the working period on the given date into consecutive slots.
+func (w *TherapistWorkingHour) Slots(date time.Time) []TimeRange {
+	if int(date.Weekday()) != w.DayOfWeek || w.SlotMinutes <= 0 {
+		return nil
+	}
+
+	windowStart, windowEnd, err := w.Window(date)
+	if err != nil {
+		return nil
+	}
+
+	duration := time.Duration(w.SlotMinutes) * time.Minute
+	var slots []TimeRange
+	for start := windowStart; !start.Add(duration).After(windowEnd); start = start.Add(duration) {
+		slots = append(slots, TimeRange{Start: start, End: start.Add(duration)})
+	}
+
+	return slots
+}
+
+// SlotAt returns the slot starting exactly at start, if there is one.
+func (w *TherapistWorkingHour) SlotAt(start time.Time) (TimeRange, bool) {
+	for _, slot := range w.Slots(start) {
+		if slot.Start.Equal(start) {
+			return slot, true
+		}
+	}
+
+	return TimeRange{}, false
+}
+
+func (w *TherapistWorkingHour) AcceptsAgeCategory(ageCategory string) bool {
+	return w.AgeCategory == nil || *w.AgeCategory == ageCategory
+}
@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Overlaps treats ranges as half-open, so back-to-back slots do not collide.
+func (r TimeRange) Overlaps(other TimeRange) bool {
+	return r.Start.Before(other.End) && other.Start.Before(r.End)
+}
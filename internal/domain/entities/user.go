@@ -18,3 +18,12 @@ type User struct {
 	Parent            []Parent
 	Admin             []Admin
 }
+
+// EndsSessions reports whether updating previous to u must sign the user out
+// everywhere: a new password, another role or deactivation all withdraw what
+// existing tokens were issued on.
+func (u *User) EndsSessions(previous *User) bool {
+	return u.Password != previous.Password ||
+		u.Role != previous.Role ||
+		u.IsActive != previous.IsActive
+}
@@ -10,7 +10,7 @@ import (
 type AdminRepository interface {
 	Create(ctx context.Context, tx *gorm.DB, therapist *entities.Admin) error
 
-	GetAll(ctx context.Context) ([]*entities.Admin, error)
+	GetAll(ctx context.Context, query entities.ListQuery) ([]*entities.Admin, *entities.PageInfo, error)
 	GetById(ctx context.Context, adminId string) (*entities.Admin, error)
 
 	Update(ctx context.Context, tx *gorm.DB, admin *entities.Admin) error
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type AgeCategoryRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, category *entities.AgeCategory) error
+	Update(ctx context.Context, tx *gorm.DB, category *entities.AgeCategory) error
+
+	GetAll(ctx context.Context) ([]*entities.AgeCategory, error)
+	GetActive(ctx context.Context) ([]*entities.AgeCategory, error)
+	GetById(ctx context.Context, categoryId int) (*entities.AgeCategory, error)
+	ExistByName(ctx context.Context, name string) (bool, error)
+}
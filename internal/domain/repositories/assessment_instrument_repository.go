@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type AssessmentInstrumentRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, instrument *entities.AssessmentInstrument) error
+	Update(ctx context.Context, tx *gorm.DB, instrument *entities.AssessmentInstrument) error
+
+	GetAll(ctx context.Context, therapySection string) ([]*entities.AssessmentInstrument, error)
+	GetById(ctx context.Context, instrumentId int) (*entities.AssessmentInstrument, error)
+	GetActiveBySection(ctx context.Context, therapySection string) ([]*entities.AssessmentInstrument, error)
+	ExistByCode(ctx context.Context, code string) (bool, error)
+}
@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/helpers"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type AssessmentRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, assessment *entities.Assessment) error
+
+	GetAll(ctx context.Context, query entities.ListQuery, status string, assessorId string) ([]*entities.Assessment, *entities.PageInfo, error)
+	GetById(ctx context.Context, assessmentId int) (*entities.Assessment, error)
+	LockById(ctx context.Context, tx *gorm.DB, assessmentId int) (*entities.Assessment, error)
+	ExistOpenByObservation(ctx context.Context, tx *gorm.DB, observationId int, therapySection string) (bool, error)
+
+	UpdateAssessor(ctx context.Context, tx *gorm.DB, assessmentId int, assessorId string, scheduledDate helpers.DateOnly) error
+	UpdateStatus(ctx context.Context, tx *gorm.DB, assessmentId int, from constants.AssessmentStatus, to constants.AssessmentStatus) error
+	Complete(ctx context.Context, tx *gorm.DB, assessment *entities.Assessment) error
+	Cancel(ctx context.Context, tx *gorm.DB, assessmentId int, reason string) error
+}
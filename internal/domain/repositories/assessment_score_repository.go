@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type AssessmentScoreRepository interface {
+	Upsert(ctx context.Context, tx *gorm.DB, scores []*entities.AssessmentScore) error
+	GetByAssessmentId(ctx context.Context, assessmentId int) ([]*entities.AssessmentScore, error)
+}
@@ -0,0 +1,37 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type CarePlanRepository interface {
+	// GetOrCreateByChildId returns the child's care plan, opening an empty
+	// one when the child has none yet.
+	GetOrCreateByChildId(ctx context.Context, tx *gorm.DB, childId string) (*entities.CarePlan, error)
+	AddEntry(ctx context.Context, tx *gorm.DB, entry *entities.CarePlanEntry) error
+
+	// GetByChildId returns nil without an error when the child has no care
+	// plan yet.
+	GetByChildId(ctx context.Context, childId string) (*entities.CarePlan, error)
+
+	// UpdateProgramme saves the programme status and evaluation dates.
+	UpdateProgramme(ctx context.Context, tx *gorm.DB, carePlan *entities.CarePlan) error
+	// GetOverdue lists ongoing programmes whose evaluation date is before
+	// today.
+	GetOverdue(ctx context.Context, query entities.ListQuery, today time.Time) ([]*entities.CarePlan, *entities.PageInfo, error)
+
+	AddGoal(ctx context.Context, tx *gorm.DB, goal *entities.CarePlanGoal) error
+	UpdateGoal(ctx context.Context, tx *gorm.DB, goal *entities.CarePlanGoal) error
+	// GetGoalById and GetGoalsByChildId fill each goal's latest measurement.
+	GetGoalById(ctx context.Context, goalId int) (*entities.CarePlanGoal, error)
+	GetGoalsByChildId(ctx context.Context, childId string) ([]*entities.CarePlanGoal, error)
+
+	// ReplaceSessionMeasurements swaps the readings taken in a session, so a
+	// corrected session record does not leave stale progress behind.
+	ReplaceSessionMeasurements(ctx context.Context, tx *gorm.DB, sessionId int, measurements []entities.CarePlanGoalMeasurement) error
+	AddMeasurements(ctx context.Context, tx *gorm.DB, measurements []entities.CarePlanGoalMeasurement) error
+}
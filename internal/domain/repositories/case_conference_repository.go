@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type CaseConferenceRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, conference *entities.CaseConference) error
+	Update(ctx context.Context, tx *gorm.DB, conference *entities.CaseConference) error
+	ReplaceParticipants(ctx context.Context, tx *gorm.DB, conferenceId int, therapistIds []string) error
+	ReplaceSections(ctx context.Context, tx *gorm.DB, conferenceId int, sections []entities.CaseConferenceSection) error
+
+	// GetAll narrows to conferences the therapist takes part in when
+	// therapistId is set.
+	GetAll(ctx context.Context, query entities.ListQuery, childId string, status string, therapistId string) ([]*entities.CaseConference, *entities.PageInfo, error)
+	GetById(ctx context.Context, conferenceId int) (*entities.CaseConference, error)
+	LockById(ctx context.Context, tx *gorm.DB, conferenceId int) (*entities.CaseConference, error)
+}
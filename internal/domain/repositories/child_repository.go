@@ -9,6 +9,9 @@ import (
 
 type ChildRepository interface {
 	Create(ctx context.Context, tx *gorm.DB, child *entities.Children) error
-	//GetById(ctx context.Context, childId string) (*entities.Children, error)
-	GetAll(ctx context.Context) ([]*entities.Children, error)
+
+	GetAll(ctx context.Context, query entities.ListQuery) ([]*entities.Children, *entities.PageInfo, error)
+	GetById(ctx context.Context, childId string) (*entities.Children, error)
+	GetByParentId(ctx context.Context, parentId string) ([]*entities.Children, error)
+	LockById(ctx context.Context, tx *gorm.DB, childId string) error
 }
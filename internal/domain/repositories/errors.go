@@ -0,0 +1,7 @@
+package repositories
+
+import "errors"
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+var ErrStatusChanged = errors.New("observation status changed concurrently")
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type EvaluationRepository interface {
+	// Create saves the evaluation with its goal snapshot.
+	Create(ctx context.Context, tx *gorm.DB, evaluation *entities.Evaluation) error
+
+	GetById(ctx context.Context, evaluationId int) (*entities.Evaluation, error)
+	// GetByChildId returns the child's evaluations, latest first.
+	GetByChildId(ctx context.Context, childId string) ([]*entities.Evaluation, error)
+}
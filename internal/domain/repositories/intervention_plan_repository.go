@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type InterventionPlanRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, plan *entities.InterventionPlan) error
+	// Update saves the plan and replaces its slots.
+	Update(ctx context.Context, tx *gorm.DB, plan *entities.InterventionPlan) error
+
+	GetAll(ctx context.Context, query entities.ListQuery, childId string, therapistId string) ([]*entities.InterventionPlan, *entities.PageInfo, error)
+	GetById(ctx context.Context, planId int) (*entities.InterventionPlan, error)
+	LockById(ctx context.Context, tx *gorm.DB, planId int) (*entities.InterventionPlan, error)
+	ExistActiveByChildSection(ctx context.Context, tx *gorm.DB, childId string, therapySection string, excludePlanId int) (bool, error)
+	// ExistByChildTherapist reports whether the therapist runs, or ran, a
+	// plan for the child.
+	ExistByChildTherapist(ctx context.Context, childId string, therapistId string) (bool, error)
+	// DeactivateByChild stops every active plan of the child.
+	DeactivateByChild(ctx context.Context, tx *gorm.DB, childId string) error
+
+	// GetInRange returns plans, active or not, whose period overlaps from to
+	// to; empty filters match every plan.
+	GetInRange(ctx context.Context, from time.Time, to time.Time, childId string, therapistId string) ([]*entities.InterventionPlan, error)
+}
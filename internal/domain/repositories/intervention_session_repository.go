@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/helpers"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type InterventionSessionRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, session *entities.InterventionSession) error
+	Update(ctx context.Context, tx *gorm.DB, session *entities.InterventionSession) error
+	ReplaceGoals(ctx context.Context, tx *gorm.DB, sessionId int, goals []entities.InterventionSessionGoal) error
+
+	GetById(ctx context.Context, sessionId int) (*entities.InterventionSession, error)
+	// GetByOccurrence returns nil without an error when the session has not
+	// been recorded.
+	GetByOccurrence(ctx context.Context, tx *gorm.DB, planId int, sessionDate helpers.DateOnly, startTime string) (*entities.InterventionSession, error)
+	GetByPlans(ctx context.Context, planIds []int, from time.Time, to time.Time) ([]*entities.InterventionSession, error)
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type MfaRepository interface {
+	GetByUserId(ctx context.Context, userId string) (*entities.MfaEnrollment, error)
+	LockByUserId(ctx context.Context, tx *gorm.DB, userId string) (*entities.MfaEnrollment, error)
+	Create(ctx context.Context, tx *gorm.DB, enrollment *entities.MfaEnrollment) error
+	Save(ctx context.Context, tx *gorm.DB, enrollment *entities.MfaEnrollment) error
+	Update(ctx context.Context, tx *gorm.DB, enrollment *entities.MfaEnrollment) error
+	Delete(ctx context.Context, tx *gorm.DB, userId string) error
+
+	ReplaceRecoveryCodes(ctx context.Context, tx *gorm.DB, userId string, codes []entities.MfaRecoveryCode) error
+	UseRecoveryCode(ctx context.Context, tx *gorm.DB, userId string, codeHash string) (bool, error)
+	CountRecoveryCodes(ctx context.Context, userId string) (int64, error)
+}
@@ -9,4 +9,6 @@ import (
 
 type ObservationAnswerRepository interface {
 	Create(ctx context.Context, tx *gorm.DB, answers []*entities.ObservationAnswer) error
+	Upsert(ctx context.Context, tx *gorm.DB, answers []*entities.ObservationAnswer) error
+	GetByObservationId(ctx context.Context, observationId int) ([]*entities.ObservationAnswer, error)
 }
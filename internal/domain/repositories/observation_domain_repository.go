@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type ObservationDomainRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, domain *entities.ObservationDomain) error
+	Update(ctx context.Context, tx *gorm.DB, domain *entities.ObservationDomain) error
+
+	GetAll(ctx context.Context) ([]*entities.ObservationDomain, error)
+	GetByCode(ctx context.Context, code string) (*entities.ObservationDomain, error)
+	ExistByCode(ctx context.Context, code string) (bool, error)
+}
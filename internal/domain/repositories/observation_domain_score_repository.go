@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type ObservationDomainScoreRepository interface {
+	ReplaceByObservationId(ctx context.Context, tx *gorm.DB, observationId int, scores []entities.ObservationDomainScore) error
+
+	GetByObservationId(ctx context.Context, observationId int) ([]entities.ObservationDomainScore, error)
+}
@@ -3,9 +3,18 @@ package repositories
 import (
 	"backend-golang/internal/domain/entities"
 	"context"
+
+	"gorm.io/gorm"
 )
 
 type ObservationQuestionRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, question *entities.ObservationQuestion) error
+	Update(ctx context.Context, tx *gorm.DB, question *entities.ObservationQuestion) error
+	Delete(ctx context.Context, tx *gorm.DB, questionId int) error
+	CopyToVersion(ctx context.Context, tx *gorm.DB, fromVersionId int, toVersionId int) error
+
 	GetById(ctx context.Context, questionId int) (*entities.ObservationQuestion, error)
-	GetByAgeCategory(ctx context.Context, ageCategory string) ([]*entities.ObservationQuestion, error)
+	GetByAgeCategory(ctx context.Context, versionId int, ageCategory string) ([]*entities.ObservationQuestion, error)
+	GetByVersionId(ctx context.Context, versionId int) ([]*entities.ObservationQuestion, error)
+	ExistConflicting(ctx context.Context, question *entities.ObservationQuestion) (bool, error)
 }
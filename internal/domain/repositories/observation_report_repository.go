@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type ObservationReportRepository interface {
+	Upsert(ctx context.Context, tx *gorm.DB, report *entities.ObservationReport) error
+	GetByObservationId(ctx context.Context, observationId int) (*entities.ObservationReport, error)
+}
@@ -1,9 +1,11 @@
 package repositories
 
 import (
+	"backend-golang/internal/constants"
 	"backend-golang/internal/domain/entities"
 	"backend-golang/internal/helpers"
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -11,11 +13,21 @@ import (
 type ObservationRepository interface {
 	Create(ctx context.Context, tx *gorm.DB, child *entities.Observation) error
 
-	GetByPendingStatus(ctx context.Context) ([]*entities.Observation, error)
-	GetByScheduledStatus(ctx context.Context) ([]*entities.Observation, error)
-	GetByCompletedStatus(ctx context.Context) ([]*entities.Observation, error)
+	GetByPendingStatus(ctx context.Context, query entities.ListQuery) ([]*entities.Observation, *entities.PageInfo, error)
+	GetByScheduledStatus(ctx context.Context, query entities.ListQuery) ([]*entities.Observation, *entities.PageInfo, error)
+	GetScheduledByTherapistId(ctx context.Context, therapistId string, query entities.ListQuery) ([]*entities.Observation, *entities.PageInfo, error)
+	GetByCompletedStatus(ctx context.Context, query entities.ListQuery) ([]*entities.Observation, *entities.PageInfo, error)
 	GetById(ctx context.Context, observationId int) (*entities.Observation, error)
+	GetByChildId(ctx context.Context, childId string) ([]*entities.Observation, error)
+	LockById(ctx context.Context, tx *gorm.DB, observationId int) (*entities.Observation, error)
+	GetBookedByTherapistIds(ctx context.Context, therapistIds []string, from time.Time, to time.Time) ([]*entities.Observation, error)
 
-	UpdateScheduledDate(ctx context.Context, observationId int, date helpers.DateOnly) error
-	UpdateAfterObservation(ctx context.Context, tx *gorm.DB, observationId int, therapistId string, totalScore int, conclusion string, recommendation string) error
+	UpdateScheduledDate(ctx context.Context, tx *gorm.DB, observationId int, date helpers.DateOnly, period entities.TimeRange, therapistId string) error
+	UpdateStatus(ctx context.Context, tx *gorm.DB, observationId int, from constants.ObservationStatus, to constants.ObservationStatus) error
+	PinQuestionnaireVersion(ctx context.Context, tx *gorm.DB, observationId int, versionId int) error
+	SaveDraft(ctx context.Context, tx *gorm.DB, observationId int, conclusion *string, recommendation *string) error
+	UpdateAfterObservation(ctx context.Context, tx *gorm.DB, observation *entities.Observation) error
+
+	ExistOpenByChildId(ctx context.Context, tx *gorm.DB, childId string) (bool, error)
+	ExistOverlapping(ctx context.Context, tx *gorm.DB, therapistId string, period entities.TimeRange, excludeObservationId int) (bool, error)
 }
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type ObservationRevisionRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, revision *entities.ObservationRevision) error
+	NextRevisionNumber(ctx context.Context, tx *gorm.DB, observationId int) (int, error)
+	GetByObservationId(ctx context.Context, observationId int) ([]*entities.ObservationRevision, error)
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type ObservationStatusHistoryRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, history *entities.ObservationStatusHistory) error
+
+	GetByObservationId(ctx context.Context, observationId int) ([]*entities.ObservationStatusHistory, error)
+}
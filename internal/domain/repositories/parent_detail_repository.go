@@ -9,4 +9,15 @@ import (
 
 type ParentDetailRepository interface {
 	Create(ctx context.Context, tx *gorm.DB, child *entities.ParentDetail) error
+
+	GetById(ctx context.Context, parentDetailId string) (*entities.ParentDetail, error)
+	GetByParentId(ctx context.Context, parentId string) ([]*entities.ParentDetail, error)
+
+	Update(ctx context.Context, tx *gorm.DB, parentDetail *entities.ParentDetail) error
+	ClearPrimary(ctx context.Context, tx *gorm.DB, parentId string) error
+	SetPrimary(ctx context.Context, tx *gorm.DB, parentDetailId string) error
+
+	Delete(ctx context.Context, tx *gorm.DB, parentDetailId string) error
+
+	ExistByParentType(ctx context.Context, parentId string, parentType string) (bool, error)
 }
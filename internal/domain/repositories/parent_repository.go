@@ -10,6 +10,7 @@ import (
 type ParentRepository interface {
 	Create(ctx context.Context, tx *gorm.DB, parent *entities.Parent) error
 	GetByTempEmail(ctx context.Context, email string) (*entities.Parent, error)
+	GetByUserId(ctx context.Context, userId string) (*entities.Parent, error)
 
 	UpdateRegistrationStatus(ctx context.Context, userId string) error
 	UpdateUserId(ctx context.Context, tx *gorm.DB, tempEmail string, userID string) error
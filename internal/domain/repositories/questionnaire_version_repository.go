@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type QuestionnaireVersionRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, version *entities.QuestionnaireVersion) error
+	Publish(ctx context.Context, tx *gorm.DB, versionId int) error
+
+	GetAll(ctx context.Context) ([]*entities.QuestionnaireVersion, error)
+	GetById(ctx context.Context, versionId int) (*entities.QuestionnaireVersion, error)
+	GetPublished(ctx context.Context) (*entities.QuestionnaireVersion, error)
+	LockById(ctx context.Context, tx *gorm.DB, versionId int) (*entities.QuestionnaireVersion, error)
+	NextVersionNumber(ctx context.Context, tx *gorm.DB) (int, error)
+}
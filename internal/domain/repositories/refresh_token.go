@@ -3,10 +3,29 @@ package repositories
 import (
 	"backend-golang/internal/domain/entities"
 	"context"
+
+	"gorm.io/gorm"
 )
 
 type RefreshTokenRepository interface {
 	Create(ctx context.Context, token *entities.RefreshToken) error
 	GetByToken(ctx context.Context, token string) (*entities.RefreshToken, error)
 	RevokeStatus(ctx context.Context, token string) error
+
+	// LockByToken returns the token even when revoked or rotated, so a reused
+	// token can be told apart from an unknown one.
+	LockByToken(ctx context.Context, tx *gorm.DB, token string) (*entities.RefreshToken, error)
+	// Rotate saves next and marks current as revoked and replaced by it.
+	Rotate(ctx context.Context, tx *gorm.DB, current *entities.RefreshToken, next *entities.RefreshToken) error
+	RevokeFamily(ctx context.Context, tx *gorm.DB, userId string, familyId string) error
+
+	// GetActiveByUserId returns the live token of each of the user's
+	// sessions, most recently used first.
+	GetActiveByUserId(ctx context.Context, userId string) ([]*entities.RefreshToken, error)
+	// RevokeSession ends one session and fails when the user has no such
+	// active session.
+	RevokeSession(ctx context.Context, userId string, familyId string) error
+	// RevokeAllByUser ends every session of the user except exceptFamilyId,
+	// which may be empty, and returns how many were ended.
+	RevokeAllByUser(ctx context.Context, userId string, exceptFamilyId string) (int64, error)
 }
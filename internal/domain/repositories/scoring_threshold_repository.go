@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type ScoringThresholdRepository interface {
+	GetAll(ctx context.Context) ([]*entities.ScoringThreshold, error)
+	GetByAgeCategory(ctx context.Context, ageCategory string) ([]*entities.ScoringThreshold, error)
+
+	Upsert(ctx context.Context, tx *gorm.DB, thresholds []*entities.ScoringThreshold) error
+}
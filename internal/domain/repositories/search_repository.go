@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+)
+
+type SearchRepository interface {
+	SearchChildren(ctx context.Context, query entities.SearchQuery) ([]*entities.ChildSearchResult, error)
+	SearchParents(ctx context.Context, query entities.SearchQuery) ([]*entities.ParentSearchResult, error)
+	SearchTherapists(ctx context.Context, query entities.SearchQuery) ([]*entities.TherapistSearchResult, error)
+}
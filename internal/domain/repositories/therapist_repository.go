@@ -10,9 +10,12 @@ import (
 type TherapistRepository interface {
 	Create(ctx context.Context, tx *gorm.DB, therapist *entities.Therapist) error
 
-	GetAll(ctx context.Context) ([]*entities.Therapist, error)
+	GetAll(ctx context.Context, query entities.ListQuery) ([]*entities.Therapist, *entities.PageInfo, error)
 	GetById(ctx context.Context, adminId string) (*entities.Therapist, error)
 	GetByUserId(ctx context.Context, userId string) (*entities.Therapist, error)
+	GetActiveBySection(ctx context.Context, section string) ([]*entities.Therapist, error)
+
+	LockById(ctx context.Context, tx *gorm.DB, therapistId string) error
 
 	Update(ctx context.Context, tx *gorm.DB, admin *entities.Therapist) error
 
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TherapistTimeOffRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, timeOff *entities.TherapistTimeOff) error
+
+	GetById(ctx context.Context, timeOffId string) (*entities.TherapistTimeOff, error)
+	GetByTherapistIds(ctx context.Context, therapistIds []string, from time.Time, to time.Time) ([]*entities.TherapistTimeOff, error)
+
+	Delete(ctx context.Context, tx *gorm.DB, timeOffId string) error
+
+	ExistOverlapping(ctx context.Context, tx *gorm.DB, therapistId string, period entities.TimeRange) (bool, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"backend-golang/internal/domain/entities"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type TherapistWorkingHourRepository interface {
+	ReplaceByTherapistId(ctx context.Context, tx *gorm.DB, therapistId string, workingHours []*entities.TherapistWorkingHour) error
+
+	GetByTherapistId(ctx context.Context, therapistId string) ([]*entities.TherapistWorkingHour, error)
+	GetByTherapistIds(ctx context.Context, therapistIds []string) ([]*entities.TherapistWorkingHour, error)
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	mfaChallengeTTL = 5 * time.Minute
+	// maxMfaChallengeAttempts is how many codes one challenge accepts before
+	// the user has to go through the password step again.
+	maxMfaChallengeAttempts = 5
+)
+
+// takeMfaChallengeScript reads and deletes a challenge in one step, so two
+// requests presenting the same challenge cannot both get hold of it.
+var takeMfaChallengeScript = redis.NewScript(`
+local challenge = redis.call('HMGET', KEYS[1], 'user_id', 'attempts')
+if not challenge[1] then
+	return false
+end
+local ttl = redis.call('PTTL', KEYS[1])
+redis.call('DEL', KEYS[1])
+return {challenge[1], challenge[2], ttl}
+`)
+
+// MfaChallenge is a challenge taken out of the store for one verification.
+type MfaChallenge struct {
+	Token     string
+	UserId    string
+	Attempts  int
+	ExpiresAt time.Time
+}
+
+// MfaChallengeService bridges the two steps of a login that needs a second
+// factor. The password step creates a challenge for the user; the code step
+// takes it, which removes it, and gives it back after a wrong code until the
+// attempts run out.
+type MfaChallengeService interface {
+	Create(ctx context.Context, userId string) (string, time.Time, error)
+	Take(ctx context.Context, token string) (*MfaChallenge, error)
+	Retry(ctx context.Context, challenge *MfaChallenge) error
+}
+
+type mfaChallengeService struct {
+	redisClient *redis.Client
+}
+
+func NewMfaChallengeService(redisClient *redis.Client) MfaChallengeService {
+	return &mfaChallengeService{redisClient: redisClient}
+}
+
+func (s *mfaChallengeService) Create(ctx context.Context, userId string) (string, time.Time, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", time.Time{}, err
+	}
+
+	token := hex.EncodeToString(bytes)
+	if err := s.store(ctx, &MfaChallenge{Token: token, UserId: userId}, mfaChallengeTTL); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token, time.Now().Add(mfaChallengeTTL), nil
+}
+
+// Take is nil when the challenge is unknown, expired or held by another
+// request.
+func (s *mfaChallengeService) Take(ctx context.Context, token string) (*MfaChallenge, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	result, err := takeMfaChallengeScript.Run(ctx, s.redisClient, []string{mfaChallengeKey(token)}).Slice()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(result) != 3 {
+		return nil, fmt.Errorf("unexpected mfa challenge reply: %v", result)
+	}
+
+	userId, _ := result[0].(string)
+	attemptsValue, _ := result[1].(string)
+	attempts, err := strconv.Atoi(attemptsValue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mfa challenge attempts: %w", err)
+	}
+	ttl, _ := result[2].(int64)
+	if userId == "" || ttl <= 0 {
+		return nil, nil
+	}
+
+	return &MfaChallenge{
+		Token:     token,
+		UserId:    userId,
+		Attempts:  attempts,
+		ExpiresAt: time.Now().Add(time.Duration(ttl) * time.Millisecond),
+	}, nil
+}
+
+// Retry gives a challenge back after a failed attempt, keeping its expiry.
+// A challenge whose attempts are used up, or that expired meanwhile, is
+// dropped.
+func (s *mfaChallengeService) Retry(ctx context.Context, challenge *MfaChallenge) error {
+	attempts := challenge.Attempts + 1
+	ttl := time.Until(challenge.ExpiresAt)
+	if attempts >= maxMfaChallengeAttempts || ttl <= 0 {
+		return nil
+	}
+
+	return s.store(ctx, &MfaChallenge{
+		Token:    challenge.Token,
+		UserId:   challenge.UserId,
+		Attempts: attempts,
+	}, ttl)
+}
+
+func (s *mfaChallengeService) store(ctx context.Context, challenge *MfaChallenge, ttl time.Duration) error {
+	redisKey := mfaChallengeKey(challenge.Token)
+
+	_, err := s.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, redisKey, "user_id", challenge.UserId, "attempts", challenge.Attempts)
+		pipe.PExpire(ctx, redisKey, ttl)
+		return nil
+	})
+	return err
+}
+
+func mfaChallengeKey(token string) string {
+	return fmt.Sprintf("mfa:challenge:%s", token)
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestMfaChallengeService(t *testing.T) (MfaChallengeService, *miniredis.Miniredis) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return NewMfaChallengeService(client), server
+}
+
+func TestMfaChallengeTakeIsSingleUse(t *testing.T) {
+	service, _ := newTestMfaChallengeService(t)
+	ctx := context.Background()
+
+	token, _, err := service.Create(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	challenge, err := service.Take(ctx, token)
+	if err != nil {
+		t.Fatalf("Take: %v", err)
+	}
+	if challenge == nil || challenge.UserId != "user-1" || challenge.Attempts != 0 {
+		t.Fatalf("Take = %+v, want a fresh challenge for user-1", challenge)
+	}
+
+	again, err := service.Take(ctx, token)
+	if err != nil {
+		t.Fatalf("second Take: %v", err)
+	}
+	if again != nil {
+		t.Fatalf("second Take = %+v, want nil while the first request holds it", again)
+	}
+}
+
+func TestMfaChallengeRetryStopsAtAttemptLimit(t *testing.T) {
+	service, _ := newTestMfaChallengeService(t)
+	ctx := context.Background()
+
+	token, _, err := service.Create(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	// Every wrong code but the last gives the challenge back.
+	for attempt := 0; attempt < maxMfaChallengeAttempts; attempt++ {
+		challenge, err := service.Take(ctx, token)
+		if err != nil {
+			t.Fatalf("Take after %d wrong codes: %v", attempt, err)
+		}
+		if challenge == nil {
+			t.Fatalf("challenge gone after %d wrong codes, want %d", attempt, maxMfaChallengeAttempts)
+		}
+		if challenge.Attempts != attempt {
+			t.Fatalf("Attempts = %d, want %d", challenge.Attempts, attempt)
+		}
+		if err := service.Retry(ctx, challenge); err != nil {
+			t.Fatalf("Retry: %v", err)
+		}
+	}
+
+	challenge, err := service.Take(ctx, token)
+	if err != nil {
+		t.Fatalf("Take: %v", err)
+	}
+	if challenge != nil {
+		t.Fatalf("Take = %+v after %d wrong codes, want nil", challenge, maxMfaChallengeAttempts)
+	}
+}
+
+func TestMfaChallengeRetryKeepsExpiry(t *testing.T) {
+	service, server := newTestMfaChallengeService(t)
+	ctx := context.Background()
+
+	token, _, err := service.Create(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	server.FastForward(mfaChallengeTTL - time.Minute)
+
+	challenge, err := service.Take(ctx, token)
+	if err != nil || challenge == nil {
+		t.Fatalf("Take = %+v, %v", challenge, err)
+	}
+	if err := service.Retry(ctx, challenge); err != nil {
+		t.Fatalf("Retry: %v", err)
+	}
+
+	// A wrong code must not buy the caller a fresh five minutes.
+	if ttl := server.TTL(mfaChallengeKey(token)); ttl <= 0 || ttl > time.Minute {
+		t.Fatalf("TTL after Retry = %v, want at most the minute that was left", ttl)
+	}
+
+	server.FastForward(time.Minute)
+	challenge, err = service.Take(ctx, token)
+	if err != nil {
+		t.Fatalf("Take: %v", err)
+	}
+	if challenge != nil {
+		t.Fatalf("Take = %+v after the original expiry, want nil", challenge)
+	}
+}
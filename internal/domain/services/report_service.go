@@ -0,0 +1,143 @@
+package services
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/pkg/pdf"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var reportAccent = pdf.Color{R: 0.13, G: 0.38, B: 0.55}
+
+type ReportService interface {
+	RenderObservationReport(data *entities.ObservationReportData) []byte
+}
+
+type reportService struct {
+	brandName string
+}
+
+func NewReportService(brandName string) ReportService {
+	return &reportService{brandName: brandName}
+}
+
+func (s *reportService) RenderObservationReport(data *entities.ObservationReportData) []byte {
+	doc := pdf.New(fmt.Sprintf("Laporan Observasi %s", data.ChildName))
+	doc.SetFooter(fmt.Sprintf("%s - Laporan Observasi #%d - dibuat %s", s.brandName, data.ObservationId, data.GeneratedAt.Format("02/01/2006 15:04")))
+
+	doc.Banner(s.brandName, 16, reportAccent, pdf.White)
+	doc.Space(10)
+
+	doc.SetFont(pdf.Bold, 14)
+	doc.Paragraph("Laporan Hasil Observasi")
+	doc.Space(6)
+
+	s.section(doc, "Identitas Anak")
+	s.fields(doc, [][2]string{
+		{"Nama", data.ChildName},
+		{"Jenis Kelamin", data.ChildGender},
+		{"Tempat, Tanggal Lahir", joinNonEmpty(", ", data.ChildBirthPlace, formatDate(data.ChildBirthDate))},
+		{"Usia", fmt.Sprintf("%d tahun (%s)", data.ChildAge, data.AgeCategory)},
+		{"Sekolah", orDash(data.ChildSchool)},
+		{"Alamat", orDash(data.ChildAddress)},
+		{"Orang Tua / Wali", orDash(data.ParentName)},
+		{"Telepon", orDash(data.ParentPhone)},
+	})
+
+	s.section(doc, "Observasi")
+	s.fields(doc, [][2]string{
+		{"Tanggal Observasi", orDash(formatDate(data.ObservationDate))},
+		{"Terapis", orDash(data.TherapistName)},
+		{"Total Skor", fmt.Sprintf("%d", data.TotalScore)},
+		{"Tingkat Risiko", orDash(data.RiskLevel)},
+		{"Layanan Terapi", orDash(data.TherapySection)},
+	})
+
+	widths := []float64{30, doc.ContentWidth() - 30 - 45 - 40, 45, 40}
+	for _, domain := range data.Domains {
+		s.section(doc, fmt.Sprintf("%s - %s (skor %d/%d, risiko %s)", domain.Code, domain.Name, domain.Score, domain.MaxScore, orDash(domain.RiskLevel)))
+
+		doc.SetFont(pdf.Bold, 9)
+		doc.Row(widths, []string{"No", "Pertanyaan", "Jawaban", "Skor"})
+		doc.SetFont(pdf.Regular, 9)
+
+		for _, answer := range domain.Answers {
+			question := answer.QuestionText
+			if answer.Note != "" {
+				question = fmt.Sprintf("%s\nCatatan: %s", question, answer.Note)
+			}
+			doc.Row(widths, []string{
+				fmt.Sprintf("%d", answer.QuestionNumber),
+				question,
+				yesNo(answer.Answer),
+				fmt.Sprintf("%d", answer.ScoreEarned),
+			})
+		}
+	}
+
+	s.section(doc, "Kesimpulan")
+	doc.SetFont(pdf.Regular, 10)
+	doc.Paragraph(orDash(data.Conclusion))
+
+	s.section(doc, "Rekomendasi")
+	doc.SetFont(pdf.Regular, 10)
+	doc.Paragraph(orDash(data.Recommendation))
+
+	doc.Space(30)
+	doc.SetFont(pdf.Regular, 10)
+	doc.Paragraph("Terapis,")
+	doc.Space(36)
+	doc.SetFont(pdf.Bold, 10)
+	doc.Paragraph(orDash(data.TherapistName))
+
+	return doc.Bytes()
+}
+
+func (s *reportService) section(doc *pdf.Document, title string) {
+	doc.Space(10)
+	doc.SetFont(pdf.Bold, 11)
+	doc.SetTextColor(reportAccent)
+	doc.Paragraph(title)
+	doc.SetTextColor(pdf.Black)
+	doc.Rule()
+}
+
+func (s *reportService) fields(doc *pdf.Document, rows [][2]string) {
+	widths := []float64{130, doc.ContentWidth() - 130}
+	doc.SetFont(pdf.Regular, 10)
+	for _, row := range rows {
+		doc.Row(widths, []string{row[0], ": " + row[1]})
+	}
+}
+
+func formatDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return date.Format("02-01-2006")
+}
+
+func yesNo(answer bool) string {
+	if answer {
+		return "Ya"
+	}
+	return "Tidak"
+}
+
+func orDash(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "-"
+	}
+	return value
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, sep)
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"math"
+	"sort"
+	"time"
+)
+
+// Used when no threshold row exists for an age category and domain.
+const (
+	defaultModerateRatio = 0.4
+	defaultHighRatio     = 0.7
+)
+
+var riskRank = map[constants.RiskLevel]int{
+	constants.RiskLevelLow:      0,
+	constants.RiskLevelModerate: 1,
+	constants.RiskLevelHigh:     2,
+}
+
+type ScoringService interface {
+	Score(questions []*entities.ObservationQuestion, answers map[int]bool, thresholds []*entities.ScoringThreshold, domains []*entities.ObservationDomain) *entities.ScoringResult
+}
+
+type scoringService struct{}
+
+func NewScoringService() ScoringService {
+	return &scoringService{}
+}
+
+// Score sums the "yes" answers per domain and rates each domain against its
+// threshold. The overall risk is the worst domain, and the suggested section
+// is the one treating that domain (ties go to the higher share of max score).
+// Domains are reported in the order given.
+func (s *scoringService) Score(questions []*entities.ObservationQuestion, answers map[int]bool, thresholds []*entities.ScoringThreshold, domains []*entities.ObservationDomain) *entities.ScoringResult {
+	scores := make(map[constants.ObservationDomain]int)
+	maxScores := make(map[constants.ObservationDomain]int)
+
+	result := &entities.ScoringResult{}
+	for _, question := range questions {
+		domain := constants.ObservationDomain(question.Domain)
+		maxScores[domain] += question.Score
+
+		if answers[question.Id] {
+			scores[domain] += question.Score
+			result.TotalScore += question.Score
+		}
+	}
+
+	thresholdByDomain := make(map[constants.ObservationDomain]*entities.ScoringThreshold)
+	for _, threshold := range thresholds {
+		thresholdByDomain[constants.ObservationDomain(threshold.Domain)] = threshold
+	}
+
+	overall := constants.RiskLevelLow
+	var worstDomain constants.ObservationDomain
+	var worstRatio float64
+
+	now := time.Now()
+	for _, domain := range orderedDomains(maxScores, domains) {
+		score, maxScore := scores[domain], maxScores[domain]
+		risk := domainRisk(score, maxScore, thresholdByDomain[domain])
+
+		result.DomainScores = append(result.DomainScores, entities.ObservationDomainScore{
+			Domain:    string(domain),
+			Score:     score,
+			MaxScore:  maxScore,
+			RiskLevel: string(risk),
+			CreatedAt: now,
+		})
+
+		ratio := 0.0
+		if maxScore > 0 {
+			ratio = float64(score) / float64(maxScore)
+		}
+
+		if riskRank[risk] > riskRank[overall] || (risk == overall && risk != constants.RiskLevelLow && ratio > worstRatio) {
+			overall, worstDomain, worstRatio = risk, domain, ratio
+		}
+	}
+
+	result.RiskLevel = string(overall)
+	if overall != constants.RiskLevelLow {
+		for _, domain := range domains {
+			if domain.Code == string(worstDomain) {
+				result.SuggestedSection = domain.TherapySection
+			}
+		}
+	}
+
+	return result
+}
+
+func domainRisk(score, maxScore int, threshold *entities.ScoringThreshold) constants.RiskLevel {
+	moderate := int(math.Ceil(float64(maxScore) * defaultModerateRatio))
+	high := int(math.Ceil(float64(maxScore) * defaultHighRatio))
+	if threshold != nil {
+		moderate, high = threshold.ModerateScore, threshold.HighScore
+	}
+
+	switch {
+	case score > 0 && score >= high:
+		return constants.RiskLevelHigh
+	case score > 0 && score >= moderate:
+		return constants.RiskLevelModerate
+	default:
+		return constants.RiskLevelLow
+	}
+}
+
+// orderedDomains keeps configured domains in their given order and appends
+// any other code so its score is still reported.
+func orderedDomains(present map[constants.ObservationDomain]int, configured []*entities.ObservationDomain) []constants.ObservationDomain {
+	known := make(map[constants.ObservationDomain]bool, len(configured))
+	ordered := make([]constants.ObservationDomain, 0, len(present))
+	for _, domain := range configured {
+		code := constants.ObservationDomain(domain.Code)
+		known[code] = true
+		if _, ok := present[code]; ok {
+			ordered = append(ordered, code)
+		}
+	}
+
+	var unknown []constants.ObservationDomain
+	for domain := range present {
+		if !known[domain] {
+			unknown = append(unknown, domain)
+		}
+	}
+	sort.Slice(unknown, func(i, j int) bool { return unknown[i] < unknown[j] })
+
+	return append(ordered, unknown...)
+}
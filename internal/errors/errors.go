@@ -15,6 +15,11 @@ var (
 	ErrNotFound        = NotFound("not_found", "not found")
 )
 
+var (
+	ErrInvalidListQuery = BadRequest("invalid_list_query", "Parameter halaman, urutan atau filter tidak valid")
+	ErrInvalidCursor    = BadRequest("invalid_cursor", "Cursor tidak valid atau sudah tidak berlaku")
+)
+
 var (
 	ErrPasswordTooShort = ValidationError("password_too_short", "Password minimal 8 karakter")
 	ErrPasswordNumber   = ValidationError("password_no_number", "Password harus mengandung minimal 1 angka")
@@ -45,3 +50,143 @@ var (
 	ErrSaveRefreshToken    = InternalServer("save_refresh_token_failed", "Gagal menyimpan refresh token")
 	ErrInvalidRefreshToken = Unauthorized("invalid_refresh_token", "Refresh token tidak valid atau sudah dicabut")
 )
+
+var (
+	ErrParentNotFound          = NotFound("parent_not_found", "Data orang tua tidak ditemukan")
+	ErrParentNotVerified       = Forbidden("parent_not_verified", "Akun orang tua belum terverifikasi")
+	ErrChildNotFound           = NotFound("child_not_found", "Data anak tidak ditemukan")
+	ErrChildHasOpenObservation = Conflict("child_has_open_observation", "Anak masih memiliki observasi yang belum selesai")
+	ErrParentDetailNotFound    = NotFound("parent_detail_not_found", "Data wali tidak ditemukan")
+	ErrParentTypeExists        = Conflict("parent_type_exists", "Data untuk tipe orang tua ini sudah ada")
+	ErrLastParentDetail        = BadRequest("last_parent_detail", "Minimal harus ada satu kontak orang tua")
+	ErrObservationNotFound     = NotFound("observation_not_found", "Data observasi tidak ditemukan")
+)
+
+var (
+	ErrTherapistNotFound      = NotFound("therapist_not_found", "Data terapis tidak ditemukan")
+	ErrTherapistRequired      = ValidationError("therapist_required", "Terapis wajib dipilih saat menjadwalkan observasi")
+	ErrObservationNotAssigned = Forbidden("observation_not_assigned", "Observasi ini tidak ditugaskan kepada Anda")
+)
+
+var (
+	ErrQuestionNotInObservation = ValidationError("question_not_in_observation", "Pertanyaan tidak termasuk dalam kuesioner observasi ini")
+	ErrInvalidScoringThreshold  = ValidationError("invalid_scoring_threshold", "Ambang skor tinggi harus lebih besar atau sama dengan ambang skor sedang")
+	ErrObservationNotInProgress = Conflict("observation_not_in_progress", "Draf hanya dapat disimpan saat observasi sedang berlangsung")
+	ErrObservationIncomplete    = ValidationError("observation_incomplete", "Masih ada pertanyaan yang belum dijawab")
+	ErrObservationNotCompleted  = Conflict("observation_not_completed", "Hanya observasi yang sudah selesai yang dapat diamandemen")
+	ErrAnswerSheetNotAvailable  = Conflict("answer_sheet_not_available", "Lembar jawaban hanya tersedia untuk observasi yang sudah selesai")
+	ErrInvalidComparison        = ValidationError("invalid_observation_comparison", "Observasi pembanding harus observasi selesai sebelumnya dari anak yang sama")
+	ErrReportNotAvailable       = Conflict("report_not_available", "Laporan hanya tersedia untuk observasi yang sudah selesai")
+	ErrObservationNotesRequired = ValidationError("observation_notes_required", "Kesimpulan dan rekomendasi wajib diisi sebelum observasi diselesaikan")
+)
+
+var (
+	ErrQuestionnaireNotPublished    = NotFound("questionnaire_not_published", "Belum ada versi kuesioner yang diterbitkan")
+	ErrQuestionnaireVersionNotFound = NotFound("questionnaire_version_not_found", "Versi kuesioner tidak ditemukan")
+	ErrQuestionnaireVersionLocked   = Conflict("questionnaire_version_locked", "Versi kuesioner yang sudah diterbitkan tidak dapat diubah")
+	ErrQuestionnaireVersionEmpty    = ValidationError("questionnaire_version_empty", "Versi kuesioner belum memiliki pertanyaan aktif")
+	ErrQuestionNotFound             = NotFound("question_not_found", "Pertanyaan tidak ditemukan")
+	ErrQuestionExists               = Conflict("question_exists", "Kode atau nomor pertanyaan sudah digunakan pada versi ini")
+	ErrObservationDomainNotFound    = NotFound("observation_domain_not_found", "Domain observasi tidak ditemukan")
+	ErrObservationDomainExists      = Conflict("observation_domain_exists", "Kode domain observasi sudah digunakan")
+	ErrAgeCategoryNotFound          = NotFound("age_category_not_found", "Kategori usia tidak ditemukan")
+	ErrAgeCategoryExists            = Conflict("age_category_exists", "Nama kategori usia sudah digunakan")
+	ErrInvalidAgeRange              = ValidationError("invalid_age_range", "Usia maksimal harus lebih besar atau sama dengan usia minimal")
+)
+
+var (
+	ErrInvalidStatusTransition  = Conflict("invalid_status_transition", "Perubahan status observasi tidak diizinkan")
+	ErrTransitionReasonRequired = ValidationError("transition_reason_required", "Alasan wajib diisi untuk perubahan status ini")
+	ErrObservationStatusChanged = Conflict("observation_status_changed", "Status observasi sudah berubah, silakan muat ulang data")
+)
+
+var (
+	ErrInvalidWorkingHours = ValidationError("invalid_working_hours", "Jam kerja tidak valid atau saling bertumpuk")
+	ErrInvalidTimeOff      = ValidationError("invalid_time_off", "Waktu selesai cuti harus setelah waktu mulai")
+	ErrTimeOffNotFound     = NotFound("time_off_not_found", "Data cuti terapis tidak ditemukan")
+	ErrScheduleInPast      = BadRequest("schedule_in_past", "Jadwal tidak boleh pada waktu yang sudah lewat")
+	ErrOutsideWorkingHours = BadRequest("outside_working_hours", "Waktu yang dipilih bukan slot jam kerja terapis")
+	ErrTherapistOnLeave    = Conflict("therapist_on_leave", "Terapis sedang cuti pada waktu tersebut")
+	ErrScheduleConflict    = Conflict("schedule_conflict", "Terapis sudah memiliki jadwal pada waktu tersebut")
+)
+
+var (
+	ErrAssessmentInstrumentNotFound = NotFound("assessment_instrument_not_found", "Instrumen asesmen tidak ditemukan")
+	ErrAssessmentInstrumentExists   = Conflict("assessment_instrument_exists", "Kode instrumen asesmen sudah digunakan")
+	ErrDuplicateInstrumentItem      = ValidationError("duplicate_instrument_item", "Nomor butir instrumen tidak boleh sama")
+	ErrAssessmentNotFound           = NotFound("assessment_not_found", "Data asesmen tidak ditemukan")
+	ErrAssessmentNotAssigned        = Forbidden("assessment_not_assigned", "Asesmen ini tidak ditugaskan kepada Anda")
+	ErrAssessmentAlreadyOpen        = Conflict("assessment_already_open", "Asesmen untuk bagian terapi ini sudah dibuka dari observasi tersebut")
+	ErrAssessmentNotOpen            = Conflict("assessment_not_open", "Asesmen sudah selesai atau dibatalkan")
+	ErrAssessmentRequiresCompleted  = Conflict("assessment_requires_completed_observation", "Asesmen hanya dapat dibuka dari observasi yang sudah selesai")
+	ErrTherapySectionRequired       = ValidationError("therapy_section_required", "Bagian terapi wajib dipilih karena observasi belum menentukannya")
+	ErrAssessorSectionMismatch      = ValidationError("assessor_section_mismatch", "Asesor harus terapis dari bagian terapi yang sama")
+	ErrAssessmentItemInvalid        = ValidationError("assessment_item_invalid", "Butir atau nilai asesmen tidak sesuai dengan instrumen")
+	ErrAssessmentIncomplete         = ValidationError("assessment_incomplete", "Semua butir instrumen asesmen wajib dinilai")
+	ErrAssessmentNoInstruments      = Conflict("assessment_no_instruments", "Belum ada instrumen asesmen aktif untuk bagian terapi ini")
+	ErrAssessmentStatusChanged      = Conflict("assessment_status_changed", "Status asesmen sudah berubah, silakan muat ulang data")
+)
+
+var (
+	ErrConferenceNotFound              = NotFound("case_conference_not_found", "Data konferensi kasus tidak ditemukan")
+	ErrConferenceCancelled             = Conflict("case_conference_cancelled", "Konferensi kasus yang dibatalkan tidak dapat diubah")
+	ErrDuplicateConferenceParticipant  = ValidationError("duplicate_conference_participant", "Terapis peserta konferensi tidak boleh sama")
+	ErrDuplicateConferenceSection      = ValidationError("duplicate_conference_section", "Bagian terapi yang disepakati tidak boleh sama")
+	ErrConferenceParticipantsAdminOnly = Forbidden("conference_participants_admin_only", "Hanya admin yang dapat mengubah peserta konferensi")
+	ErrConferenceDecisionsRequired     = ValidationError("conference_decisions_required", "Keputusan wajib diisi untuk konferensi yang sudah dilaksanakan")
+	ErrConsentRequiresHeld             = ValidationError("consent_requires_held_conference", "Persetujuan orang tua hanya dapat dicatat setelah konferensi dilaksanakan")
+)
+
+var (
+	ErrInterventionPlanNotFound    = NotFound("intervention_plan_not_found", "Rencana intervensi tidak ditemukan")
+	ErrInterventionPlanExists      = Conflict("intervention_plan_exists", "Anak sudah memiliki rencana intervensi aktif untuk bagian terapi ini")
+	ErrInterventionPlanNotAssigned = Forbidden("intervention_plan_not_assigned", "Rencana intervensi ini tidak ditugaskan kepada Anda")
+	ErrInvalidPlanPeriod           = ValidationError("invalid_plan_period", "Tanggal selesai rencana harus setelah tanggal mulai")
+	ErrDuplicatePlanSlot           = ValidationError("duplicate_plan_slot", "Jadwal sesi dalam rencana tidak boleh sama")
+	ErrTherapistSectionMismatch    = ValidationError("therapist_section_mismatch", "Terapis harus dari bagian terapi yang sama dengan rencana")
+	ErrSessionNotInPlan            = ValidationError("session_not_in_plan", "Tidak ada sesi terjadwal pada tanggal dan jam tersebut")
+	ErrSessionInFuture             = BadRequest("session_in_future", "Kehadiran hanya dapat dicatat untuk sesi yang sudah berlangsung")
+	ErrSoapNotesRequired           = ValidationError("soap_notes_required", "Catatan SOAP wajib diisi untuk sesi yang dihadiri")
+	ErrCancelReasonRequired        = ValidationError("cancel_reason_required", "Alasan pembatalan wajib diisi")
+	ErrSessionGoalsNotAllowed      = ValidationError("session_goals_not_allowed", "Tujuan sesi hanya dicatat untuk sesi yang dihadiri")
+	ErrInterventionSessionNotFound = NotFound("intervention_session_not_found", "Data sesi intervensi tidak ditemukan")
+	ErrInvalidReportRange          = BadRequest("invalid_report_range", "Rentang tanggal laporan tidak valid atau lebih dari 92 hari")
+)
+
+var (
+	ErrCarePlanGoalNotFound  = NotFound("care_plan_goal_not_found", "Tujuan rencana perawatan tidak ditemukan")
+	ErrInvalidGoalTarget     = ValidationError("invalid_goal_target", "Target tujuan harus berbeda dari baseline")
+	ErrReviewDateInPast      = BadRequest("review_date_in_past", "Tanggal tinjauan tidak boleh pada tanggal yang sudah lewat")
+	ErrGoalSectionNotAllowed = Forbidden("goal_section_not_allowed", "Anda hanya dapat mengelola tujuan pada bagian terapi Anda")
+	ErrGoalNotInPlan         = ValidationError("goal_not_in_plan", "Tujuan tidak termasuk rencana perawatan anak untuk bagian terapi ini")
+	ErrGoalNotActive         = Conflict("goal_not_active", "Tujuan sudah tercapai atau dihentikan")
+	ErrGoalValueWithoutGoal  = ValidationError("goal_value_without_goal", "Nilai capaian hanya dapat dicatat untuk tujuan dari rencana perawatan")
+	ErrDuplicateSessionGoal  = ValidationError("duplicate_session_goal", "Tujuan rencana perawatan tidak boleh dicatat lebih dari sekali")
+)
+
+var (
+	ErrEvaluationNotFound         = NotFound("evaluation_not_found", "Data evaluasi tidak ditemukan")
+	ErrEvaluationInFuture         = BadRequest("evaluation_in_future", "Tanggal evaluasi tidak boleh setelah hari ini")
+	ErrEvaluationDecisionMismatch = ValidationError("evaluation_decision_mismatch", "Keputusan mengakhiri terapi harus disertai status lulus atau keluar, dan sebaliknya")
+	ErrNextEvaluationRequired     = ValidationError("next_evaluation_required", "Tanggal evaluasi berikutnya wajib diisi dan harus setelah tanggal evaluasi")
+	ErrDuplicateEvaluationGoal    = ValidationError("duplicate_evaluation_goal", "Tujuan yang dievaluasi tidak boleh sama")
+)
+
+var (
+	ErrRefreshTokenReused = Unauthorized("refresh_token_reused", "Refresh token sudah pernah digunakan. Semua sesi terkait telah dicabut, silakan login kembali")
+)
+
+var (
+	ErrSessionNotFound = NotFound("session_not_found", "Sesi tidak ditemukan atau sudah berakhir")
+)
+
+var (
+	ErrMfaChallengeInvalid   = Unauthorized("mfa_challenge_invalid", "Sesi verifikasi dua langkah tidak valid atau sudah kedaluwarsa. Silakan login kembali")
+	ErrInvalidMfaCode        = Unauthorized("invalid_mfa_code", "Kode autentikasi tidak valid")
+	ErrTooManyMfaAttempts    = TooManyRequests("too_many_mfa_attempts", "Terlalu banyak percobaan kode autentikasi. Coba lagi dalam 5 menit")
+	ErrMfaCodeRequired       = ValidationError("mfa_code_required", "Kode autentikasi atau kode pemulihan wajib diisi")
+	ErrMfaAlreadyEnabled     = Conflict("mfa_already_enabled", "Autentikasi dua langkah sudah aktif")
+	ErrMfaNotEnabled         = BadRequest("mfa_not_enabled", "Autentikasi dua langkah belum aktif")
+	ErrMfaEnrollmentNotFound = BadRequest("mfa_enrollment_not_found", "Pendaftaran autentikasi dua langkah belum dimulai")
+	ErrMfaRequired           = Forbidden("mfa_required", "Autentikasi dua langkah wajib untuk akun ini dan tidak dapat dinonaktifkan")
+)
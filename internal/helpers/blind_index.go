@@ -0,0 +1,64 @@
+package helpers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+func BlindIndex(value string, key string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return "", fmt.Errorf("invalid hex key: %w", err)
+	}
+
+	if len(keyBytes) < 16 {
+		return "", errors.New("invalid key size: must be at least 16 bytes")
+	}
+
+	mac := hmac.New(sha256.New, keyBytes)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// NormalizePhone reduces a phone number to its digits so that "+62 812-3456"
+// and "08123456" produce the same blind index.
+func NormalizePhone(phone string) string {
+	var b strings.Builder
+	for _, r := range phone {
+		if unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	digits := b.String()
+	if strings.HasPrefix(digits, "62") {
+		digits = "0" + strings.TrimPrefix(digits, "62")
+	}
+
+	return digits
+}
+
+func NormalizeAddress(address string) string {
+	fields := strings.FieldsFunc(strings.ToLower(address), func(r rune) bool {
+		return unicode.IsSpace(r) || r == ',' || r == '.'
+	})
+
+	return strings.Join(fields, " ")
+}
+
+func PhoneBlindIndex(phone string, key string) (string, error) {
+	return BlindIndex(NormalizePhone(phone), key)
+}
+
+func AddressBlindIndex(address string, key string) (string, error) {
+	return BlindIndex(NormalizeAddress(address), key)
+}
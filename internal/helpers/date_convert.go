@@ -65,3 +65,14 @@ func (d *DateOnly) Scan(value interface{}) error {
 		return fmt.Errorf("cannot scan type %T into DateOnly", value)
 	}
 }
+
+// At combines the date with a "15:04" clock time in loc.
+func (d DateOnly) At(clock string, loc *time.Location) (time.Time, error) {
+	c, err := time.Parse("15:04", clock)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	year, month, day := d.ToTime().Date()
+	return time.Date(year, month, day, c.Hour(), c.Minute(), 0, 0, loc), nil
+}
@@ -0,0 +1,358 @@
+package helpers
+
+import (
+	"backend-golang/internal/infrastructure/config"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// SigningKey is one access-token key. A key signs from ActiveFrom until a
+// newer key becomes active, and is accepted for verification until RetireAt,
+// so tokens signed before a rotation stay valid until they expire.
+type SigningKey struct {
+	Kid        string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.PrivateKey
+	PublicKey  crypto.PublicKey
+	ActiveFrom time.Time
+	RetireAt   *time.Time
+}
+
+func (k *SigningKey) isRetired(now time.Time) bool {
+	return k.RetireAt != nil && !now.Before(*k.RetireAt)
+}
+
+func (k *SigningKey) canSign(now time.Time) bool {
+	return k.PrivateKey != nil && !k.ActiveFrom.After(now) && !k.isRetired(now)
+}
+
+// signingKeyConfig is one entry of the JWT_KEYS_FILE manifest. Keys are PEM
+// files; a key published only for verification, such as one still held by
+// another instance, lists just its public key.
+type signingKeyConfig struct {
+	Kid            string     `json:"kid"`
+	Algorithm      string     `json:"algorithm"`
+	PrivateKeyFile string     `json:"private_key_file"`
+	PublicKeyFile  string     `json:"public_key_file"`
+	ActiveFrom     time.Time  `json:"active_from"`
+	RetireAt       *time.Time `json:"retire_at"`
+}
+
+type keyRing struct {
+	mu   sync.RWMutex
+	keys []*SigningKey
+	// legacyUntil is how long access tokens signed with the shared secret
+	// are still accepted once a manifest is loaded. It is zero, refusing
+	// them, unless JWT_LEGACY_UNTIL is set.
+	legacyUntil time.Time
+}
+
+var signingKeys = &keyRing{}
+
+// LoadSigningKeys reads the manifest named by JWT_KEYS_FILE. Without one,
+// access tokens keep being signed with the shared HS256 secret. With one,
+// tokens signed with the secret are refused, or accepted until the
+// JWT_LEGACY_UNTIL timestamp while they run out after switching over.
+func LoadSigningKeys() error {
+	path := config.GetEnv("JWT_KEYS_FILE", "")
+	if path == "" {
+		log.Warn().Msg("JWT_KEYS_FILE is not set, access tokens are signed with the shared secret")
+		return nil
+	}
+
+	var legacyUntil time.Time
+	if value := config.GetEnv("JWT_LEGACY_UNTIL", ""); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("invalid JWT_LEGACY_UNTIL, use RFC 3339: %w", err)
+		}
+		legacyUntil = parsed
+	}
+
+	keys, err := readSigningKeys(path)
+	if err != nil {
+		return err
+	}
+
+	signingKeys.setLegacyUntil(legacyUntil)
+	signingKeys.replace(keys)
+	log.Info().Int("keys", len(keys)).Msg("JWT signing keys loaded")
+	return nil
+}
+
+// WatchSigningKeys reloads the manifest every interval so a scheduled key can
+// be added, and an old one retired, without a restart. A manifest that fails
+// to load leaves the current keys in place.
+func WatchSigningKeys(interval time.Duration) {
+	path := config.GetEnv("JWT_KEYS_FILE", "")
+	if path == "" || interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			keys, err := readSigningKeys(path)
+			if err != nil {
+				log.Error().Err(err).Str("path", path).Msg("Failed to reload JWT signing keys")
+				continue
+			}
+			signingKeys.replace(keys)
+		}
+	}()
+}
+
+func readSigningKeys(path string) ([]*SigningKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key manifest: %w", err)
+	}
+
+	var configs []signingKeyConfig
+	if err := json.Unmarshal(raw, &configs); err != nil {
+		return nil, fmt.Errorf("failed to parse signing key manifest: %w", err)
+	}
+
+	keys := make([]*SigningKey, 0, len(configs))
+	seen := make(map[string]bool, len(configs))
+	for _, cfg := range configs {
+		if cfg.Kid == "" {
+			return nil, errors.New("signing key without kid")
+		}
+		if seen[cfg.Kid] {
+			return nil, fmt.Errorf("duplicate signing key %q", cfg.Kid)
+		}
+		seen[cfg.Kid] = true
+
+		key, err := parseSigningKey(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("signing key %q: %w", cfg.Kid, err)
+		}
+		keys = append(keys, key)
+	}
+
+	now := time.Now()
+	for _, key := range keys {
+		if key.canSign(now) {
+			return keys, nil
+		}
+	}
+
+	return nil, errors.New("no signing key is active")
+}
+
+func parseSigningKey(cfg signingKeyConfig) (*SigningKey, error) {
+	key := &SigningKey{
+		Kid:        cfg.Kid,
+		ActiveFrom: cfg.ActiveFrom,
+		RetireAt:   cfg.RetireAt,
+	}
+
+	var privatePEM, publicPEM []byte
+	var err error
+	if cfg.PrivateKeyFile != "" {
+		if privatePEM, err = os.ReadFile(cfg.PrivateKeyFile); err != nil {
+			return nil, err
+		}
+	} else if cfg.PublicKeyFile != "" {
+		if publicPEM, err = os.ReadFile(cfg.PublicKeyFile); err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, errors.New("either private_key_file or public_key_file is required")
+	}
+
+	switch cfg.Algorithm {
+	case jwt.SigningMethodRS256.Alg():
+		key.Method = jwt.SigningMethodRS256
+		if privatePEM != nil {
+			privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privatePEM)
+			if err != nil {
+				return nil, err
+			}
+			key.PrivateKey, key.PublicKey = privateKey, &privateKey.PublicKey
+		} else {
+			if key.PublicKey, err = jwt.ParseRSAPublicKeyFromPEM(publicPEM); err != nil {
+				return nil, err
+			}
+		}
+
+	case jwt.SigningMethodEdDSA.Alg():
+		key.Method = jwt.SigningMethodEdDSA
+		if privatePEM != nil {
+			privateKey, err := jwt.ParseEdPrivateKeyFromPEM(privatePEM)
+			if err != nil {
+				return nil, err
+			}
+			key.PrivateKey, key.PublicKey = privateKey, privateKey.(ed25519.PrivateKey).Public()
+		} else {
+			if key.PublicKey, err = jwt.ParseEdPublicKeyFromPEM(publicPEM); err != nil {
+				return nil, err
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q, use RS256 or EdDSA", cfg.Algorithm)
+	}
+
+	return key, nil
+}
+
+func (r *keyRing) replace(keys []*SigningKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ActiveFrom.After(keys[j].ActiveFrom)
+	})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.keys = keys
+}
+
+func (r *keyRing) setLegacyUntil(legacyUntil time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.legacyUntil = legacyUntil
+}
+
+// signingKey is the most recently activated key that can sign now, or nil
+// when access tokens are still signed with the shared secret.
+func (r *keyRing) signingKey(now time.Time) *SigningKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, key := range r.keys {
+		if key.canSign(now) {
+			return key
+		}
+	}
+
+	return nil
+}
+
+func (r *keyRing) verificationKey(kid string, now time.Time) (*SigningKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, key := range r.keys {
+		if key.Kid == kid {
+			if key.isRetired(now) {
+				return nil, fmt.Errorf("signing key %q is retired", kid)
+			}
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func (r *keyRing) acceptsLegacy(now time.Time) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.keys) == 0 || now.Before(r.legacyUntil)
+}
+
+func (r *keyRing) published(now time.Time) []*SigningKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]*SigningKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		if !key.isRetired(now) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// accessTokenKey picks the verification key named by the token's kid and
+// refuses a token whose algorithm does not match that key.
+func accessTokenKey(token *jwt.Token) (interface{}, error) {
+	now := time.Now()
+
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if !signingKeys.acceptsLegacy(now) {
+			return nil, errors.New("token has no key id")
+		}
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return config.JWTKey, nil
+	}
+
+	key, err := signingKeys.verificationKey(kid, now)
+	if err != nil {
+		return nil, err
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, errors.New("unexpected signing method")
+	}
+
+	return key.PublicKey, nil
+}
+
+// JSONWebKey is the public half of a signing key as published in the JWKS.
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS lists every key that is not retired, including keys scheduled to
+// become active, so verifiers can fetch them before the first token arrives.
+func JWKS() JSONWebKeySet {
+	set := JSONWebKeySet{Keys: make([]JSONWebKey, 0)}
+
+	for _, key := range signingKeys.published(time.Now()) {
+		jwk := JSONWebKey{
+			Kid: key.Kid,
+			Use: "sig",
+			Alg: key.Method.Alg(),
+		}
+
+		switch publicKey := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		default:
+			continue
+		}
+
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	return set
+}
@@ -0,0 +1,194 @@
+package helpers
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/infrastructure/config"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// useKeyRing swaps the package key ring for the duration of a test.
+func useKeyRing(t *testing.T, keys []*SigningKey, legacyUntil time.Time) {
+	t.Helper()
+
+	previous := signingKeys
+	signingKeys = &keyRing{}
+	signingKeys.replace(keys)
+	signingKeys.setLegacyUntil(legacyUntil)
+	t.Cleanup(func() { signingKeys = previous })
+}
+
+func testSigningKeys(t *testing.T) (active *SigningKey, retired *SigningKey) {
+	t.Helper()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("ed25519: %v", err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa: %v", err)
+	}
+
+	now := time.Now()
+	retireAt := now.Add(-time.Minute)
+
+	active = &SigningKey{
+		Kid:        "active",
+		Method:     jwt.SigningMethodEdDSA,
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+		ActiveFrom: now.Add(-time.Hour),
+	}
+	retired = &SigningKey{
+		Kid:        "retired",
+		Method:     jwt.SigningMethodRS256,
+		PrivateKey: rsaKey,
+		PublicKey:  &rsaKey.PublicKey,
+		ActiveFrom: now.Add(-48 * time.Hour),
+		RetireAt:   &retireAt,
+	}
+
+	return active, retired
+}
+
+func tokenWith(method jwt.SigningMethod, kid string) *jwt.Token {
+	token := jwt.New(method)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	return token
+}
+
+func TestAccessTokenKey(t *testing.T) {
+	config.JWTKey = []byte("test-secret")
+	active, retired := testSigningKeys(t)
+	manifest := []*SigningKey{active, retired}
+
+	tests := []struct {
+		name        string
+		keys        []*SigningKey
+		legacyUntil time.Time
+		token       *jwt.Token
+		wantKey     interface{}
+	}{
+		{
+			name:    "active key",
+			keys:    manifest,
+			token:   tokenWith(jwt.SigningMethodEdDSA, "active"),
+			wantKey: active.PublicKey,
+		},
+		{
+			name:  "algorithm does not match the key",
+			keys:  manifest,
+			token: tokenWith(jwt.SigningMethodHS256, "active"),
+		},
+		{
+			name:  "unknown kid",
+			keys:  manifest,
+			token: tokenWith(jwt.SigningMethodEdDSA, "missing"),
+		},
+		{
+			name:  "retired kid",
+			keys:  manifest,
+			token: tokenWith(jwt.SigningMethodRS256, "retired"),
+		},
+		{
+			name:    "shared secret without a manifest",
+			token:   tokenWith(jwt.SigningMethodHS256, ""),
+			wantKey: config.JWTKey,
+		},
+		{
+			name:        "shared secret before JWT_LEGACY_UNTIL",
+			keys:        manifest,
+			legacyUntil: time.Now().Add(time.Hour),
+			token:       tokenWith(jwt.SigningMethodHS256, ""),
+			wantKey:     config.JWTKey,
+		},
+		{
+			name:        "shared secret after JWT_LEGACY_UNTIL",
+			keys:        manifest,
+			legacyUntil: time.Now().Add(-time.Second),
+			token:       tokenWith(jwt.SigningMethodHS256, ""),
+		},
+		{
+			name:  "shared secret once a manifest is loaded",
+			keys:  manifest,
+			token: tokenWith(jwt.SigningMethodHS256, ""),
+		},
+		{
+			name:        "kid-less token with an asymmetric algorithm",
+			keys:        manifest,
+			legacyUntil: time.Now().Add(time.Hour),
+			token:       tokenWith(jwt.SigningMethodRS256, ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useKeyRing(t, tt.keys, tt.legacyUntil)
+
+			key, err := accessTokenKey(tt.token)
+			if tt.wantKey == nil {
+				if err == nil {
+					t.Fatalf("accessTokenKey accepted the token with key %T", key)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("accessTokenKey: %v", err)
+			}
+			switch want := tt.wantKey.(type) {
+			case []byte:
+				if got, ok := key.([]byte); !ok || string(got) != string(want) {
+					t.Fatalf("key = %v, want the shared secret", key)
+				}
+			case ed25519.PublicKey:
+				if got, ok := key.(ed25519.PublicKey); !ok || !got.Equal(want) {
+					t.Fatalf("key = %v, want the active public key", key)
+				}
+			}
+		})
+	}
+}
+
+func TestVerificationTokenIsNotAnAccessToken(t *testing.T) {
+	config.JWTKey = []byte("test-secret")
+	useKeyRing(t, nil, time.Time{})
+
+	verificationToken, _, err := GenerateVerificationToken("user-1")
+	if err != nil {
+		t.Fatalf("GenerateVerificationToken: %v", err)
+	}
+	if _, err := ParseAccessToken(verificationToken); err == nil {
+		t.Fatal("ParseAccessToken accepted a verification token")
+	}
+	if _, err := VerifyVerificationToken(verificationToken); err != nil {
+		t.Fatalf("VerifyVerificationToken: %v", err)
+	}
+
+	accessToken, err := GenerateToken("user-1", constants.RoleAdmin)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	if _, err := VerifyVerificationToken(accessToken); err == nil {
+		t.Fatal("VerifyVerificationToken accepted an access token")
+	}
+}
+
+func TestReloadKeepsLegacyCutoff(t *testing.T) {
+	active, _ := testSigningKeys(t)
+	useKeyRing(t, nil, time.Time{})
+
+	// A first manifest after a restart must not reopen the legacy window.
+	signingKeys.replace([]*SigningKey{active})
+	if signingKeys.acceptsLegacy(time.Now()) {
+		t.Fatal("loading a manifest opened a legacy window without JWT_LEGACY_UNTIL")
+	}
+}
@@ -11,33 +11,84 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// AccessTokenTTL is how long an access token stays valid, and so how long a
+// revocation has to be remembered.
+const AccessTokenTTL = 60 * time.Minute
+
+// verificationAudience marks email verification and password reset tokens.
+// They are signed with the shared secret like legacy access tokens, so the
+// audience is what keeps one from being used as the other.
+const verificationAudience = "verification"
+
 type AppClaims struct {
 	Role constants.Role `json:"role"`
+	// IssuedAtMs repeats the issue time in milliseconds, so a login right
+	// after "sign out everywhere" is told apart from the tokens it revoked.
+	// iat itself stays in whole seconds as other JWT consumers expect.
+	IssuedAtMs int64 `json:"iat_ms,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// IssuedAtTime is the issue time at the best precision the token carries.
+func (c *AppClaims) IssuedAtTime() time.Time {
+	if c.IssuedAtMs > 0 {
+		return time.UnixMilli(c.IssuedAtMs)
+	}
+	if c.IssuedAt == nil {
+		return time.Time{}
+	}
+	return c.IssuedAt.Time
+}
+
 func GenerateToken(userId string, role constants.Role) (string, error) {
-	expirationTime := time.Now().Add(60 * time.Minute)
+	issuedAt := time.Now()
+	expirationTime := issuedAt.Add(AccessTokenTTL)
 
 	claims := &AppClaims{
-		Role: role,
+		Role:       role,
+		IssuedAtMs: issuedAt.UnixMilli(),
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        GenerateULID(),
 			Subject:   userId,
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
 			Issuer:    "backend_golang",
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString(config.JWTKey)
-	return tokenString, err
+	key := signingKeys.signingKey(time.Now())
+	if key == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString(config.JWTKey)
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.Kid
+	return token.SignedString(key.PrivateKey)
+}
+
+// ParseAccessToken checks the signature and expiry of an access token,
+// verifying it with the key named by its kid header.
+func ParseAccessToken(tokenString string) (*AppClaims, error) {
+	claims := &AppClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, accessTokenKey)
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+	if len(claims.Audience) > 0 {
+		return nil, errors.New("not an access token")
+	}
+	return claims, nil
 }
 
 func GenerateVerificationToken(userId string) (string, time.Time, error) {
 	expirationTime := time.Now().Add(15 * time.Minute)
 	claims := &jwt.RegisteredClaims{
 		Subject:   userId,
+		Audience:  jwt.ClaimStrings{verificationAudience},
 		ExpiresAt: jwt.NewNumericDate(expirationTime),
 		IssuedAt:  jwt.NewNumericDate(time.Now()),
 		Issuer:    "backend_golang",
@@ -51,7 +102,7 @@ func VerifyVerificationToken(tokenString string) (*jwt.RegisteredClaims, error)
 	claims := &jwt.RegisteredClaims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
 		return config.JWTKey, nil
-	})
+	}, jwt.WithAudience(verificationAudience), jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,58 @@
+package helpers
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/infrastructure/config"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestAccessTokenKeepsWholeSecondIatAndMillisecondIssueTime(t *testing.T) {
+	config.JWTKey = []byte("test-secret")
+
+	before := time.Now().Truncate(time.Millisecond)
+	tokenString, err := GenerateToken("user-1", constants.RoleAdmin)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.Split(tokenString, ".")[1])
+	if err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	for _, claim := range []string{"iat", "exp"} {
+		if strings.ContainsAny(string(raw[claim]), ".eE") {
+			t.Errorf("%s = %s, want whole seconds", claim, raw[claim])
+		}
+	}
+
+	claims, err := ParseAccessToken(tokenString)
+	if err != nil {
+		t.Fatalf("ParseAccessToken: %v", err)
+	}
+	issuedAt := claims.IssuedAtTime()
+	if issuedAt.Before(before) || issuedAt.After(time.Now()) {
+		t.Errorf("IssuedAtTime = %v, want between %v and now", issuedAt, before)
+	}
+	if claims.IssuedAt.Unix() != issuedAt.Unix() {
+		t.Errorf("iat %v and iat_ms %v disagree", claims.IssuedAt.Time, issuedAt)
+	}
+}
+
+func TestIssuedAtTimeFallsBackToIat(t *testing.T) {
+	issuedAt := time.Unix(1758600000, 0)
+	claims := &AppClaims{RegisteredClaims: jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(issuedAt)}}
+
+	if got := claims.IssuedAtTime(); !got.Equal(issuedAt) {
+		t.Errorf("IssuedAtTime = %v, want iat %v for a token without iat_ms", got, issuedAt)
+	}
+}
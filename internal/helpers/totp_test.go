@@ -0,0 +1,106 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA-1 seed of RFC 6238 appendix B, "12345678901234567890",
+// in base32.
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestValidateTOTPAcceptsRFC6238Vectors(t *testing.T) {
+	// The RFC lists eight digit codes; six digit codes are their last six.
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+		{unix: 20000000000, code: "353130"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			step, ok := ValidateTOTP(rfc6238Secret, tt.code, time.Unix(tt.unix, 0))
+			if !ok {
+				t.Fatalf("code %s rejected at %d", tt.code, tt.unix)
+			}
+			if want := tt.unix / totpPeriod; step != want {
+				t.Errorf("step = %d, want %d", step, want)
+			}
+		})
+	}
+}
+
+func TestValidateTOTPSkew(t *testing.T) {
+	// 050471 belongs to the step starting at 1111111110.
+	issued := time.Unix(1111111110, 0)
+	const code = "050471"
+	const step = 1111111110 / totpPeriod
+
+	tests := []struct {
+		name   string
+		now    time.Time
+		wantOk bool
+	}{
+		{name: "same period", now: issued.Add(29 * time.Second), wantOk: true},
+		{name: "one period late", now: issued.Add(totpPeriod * time.Second), wantOk: true},
+		{name: "end of the late period", now: issued.Add((2*totpPeriod - 1) * time.Second), wantOk: true},
+		{name: "two periods late", now: issued.Add(2 * totpPeriod * time.Second)},
+		{name: "one period early", now: issued.Add(-time.Second), wantOk: true},
+		{name: "start of the early period", now: issued.Add(-totpPeriod * time.Second), wantOk: true},
+		{name: "two periods early", now: issued.Add((-totpPeriod - 1) * time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ValidateTOTP(rfc6238Secret, code, tt.now)
+			if ok != tt.wantOk {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOk)
+			}
+			// A code accepted late or early still reports its own step, which
+			// is what the replay check compares.
+			if ok && got != step {
+				t.Errorf("step = %d, want %d", got, step)
+			}
+		})
+	}
+}
+
+func TestValidateTOTPRejectsMalformedInput(t *testing.T) {
+	now := time.Unix(59, 0)
+
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+		wantOk bool
+	}{
+		{name: "lower case secret", secret: "gezdgnbvgy3tqojqgezdgnbvgy3tqojq", code: "287082", wantOk: true},
+		{name: "wrong code", secret: rfc6238Secret, code: "287083"},
+		{name: "eight digits", secret: rfc6238Secret, code: "94287082"},
+		{name: "empty code", secret: rfc6238Secret},
+		{name: "secret not base32", secret: "not-base32!", code: "287082"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := ValidateTOTP(tt.secret, tt.code, now); ok != tt.wantOk {
+				t.Errorf("ok = %v, want %v", ok, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestHashRecoveryCodeIgnoresFormatting(t *testing.T) {
+	want := HashRecoveryCode("abcde-fghij")
+	for _, typed := range []string{"ABCDE-FGHIJ", "abcdefghij", "abcde fghij"} {
+		if got := HashRecoveryCode(typed); got != want {
+			t.Errorf("HashRecoveryCode(%q) differs from the issued code", typed)
+		}
+	}
+}
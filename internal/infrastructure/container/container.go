@@ -6,12 +6,24 @@ import (
 	"backend-golang/internal/domain/repositories"
 	"backend-golang/internal/domain/services"
 	"backend-golang/internal/helpers"
+	"backend-golang/internal/infrastructure/config"
 	"backend-golang/internal/infrastructure/database"
 	"backend-golang/internal/usecases/admin"
+	"backend-golang/internal/usecases/assessment"
 	"backend-golang/internal/usecases/auth"
+	"backend-golang/internal/usecases/careplan"
 	"backend-golang/internal/usecases/child"
+	"backend-golang/internal/usecases/conference"
+	"backend-golang/internal/usecases/evaluation"
+	"backend-golang/internal/usecases/intervention"
 	"backend-golang/internal/usecases/observation"
+	"backend-golang/internal/usecases/parent"
+	"backend-golang/internal/usecases/questionnaire"
 	"backend-golang/internal/usecases/registration"
+	"backend-golang/internal/usecases/report"
+	"backend-golang/internal/usecases/schedule"
+	"backend-golang/internal/usecases/scoring"
+	"backend-golang/internal/usecases/search"
 	"backend-golang/internal/usecases/therapist"
 	pkgredis "backend-golang/pkg/redis"
 
@@ -23,23 +35,47 @@ type Container struct {
 	RedisClient *goredis.Client
 
 	// Repositories
-	AdminRepo               repositories.AdminRepository
-	ChildRepo               repositories.ChildRepository
-	ObservationRepo         repositories.ObservationRepository
-	ObservationQuestionRepo repositories.ObservationQuestionRepository
-	ObservationAnswerRepo   repositories.ObservationAnswerRepository
-	ParentDetailRepo        repositories.ParentDetailRepository
-	ParentRepo              repositories.ParentRepository
-	RefreshTokenRepo        repositories.RefreshTokenRepository
-	TherapistRepo           repositories.TherapistRepository
-	TxRepo                  repositories.TransactionRepository
-	UserRepo                repositories.UserRepository
-	VerifyTokenRepo         repositories.VerificationTokenRepository
+	AdminRepo                repositories.AdminRepository
+	AgeCategoryRepo          repositories.AgeCategoryRepository
+	AssessmentInstrumentRepo repositories.AssessmentInstrumentRepository
+	AssessmentRepo           repositories.AssessmentRepository
+	AssessmentScoreRepo      repositories.AssessmentScoreRepository
+	CarePlanRepo             repositories.CarePlanRepository
+	CaseConferenceRepo       repositories.CaseConferenceRepository
+	ChildRepo                repositories.ChildRepository
+	EvaluationRepo           repositories.EvaluationRepository
+	InterventionPlanRepo     repositories.InterventionPlanRepository
+	InterventionSessionRepo  repositories.InterventionSessionRepository
+	MfaRepo                  repositories.MfaRepository
+	ObservationRepo          repositories.ObservationRepository
+	ObservationDomainRepo    repositories.ObservationDomainRepository
+	ObservationReportRepo    repositories.ObservationReportRepository
+	ObservationRevisionRepo  repositories.ObservationRevisionRepository
+	ObservationQuestionRepo  repositories.ObservationQuestionRepository
+	ObservationAnswerRepo    repositories.ObservationAnswerRepository
+	ObservationStatusRepo    repositories.ObservationStatusHistoryRepository
+	ObservationScoreRepo     repositories.ObservationDomainScoreRepository
+	ParentDetailRepo         repositories.ParentDetailRepository
+	ParentRepo               repositories.ParentRepository
+	QuestionnaireVersionRepo repositories.QuestionnaireVersionRepository
+	RefreshTokenRepo         repositories.RefreshTokenRepository
+	ScoringThresholdRepo     repositories.ScoringThresholdRepository
+	SearchRepo               repositories.SearchRepository
+	TherapistRepo            repositories.TherapistRepository
+	TherapistTimeOffRepo     repositories.TherapistTimeOffRepository
+	TherapistWorkingHourRepo repositories.TherapistWorkingHourRepository
+	TxRepo                   repositories.TransactionRepository
+	UserRepo                 repositories.UserRepository
+	VerifyTokenRepo          repositories.VerificationTokenRepository
 
 	// Services
-	emailService services.EmailService
-	rateLimiter  services.RateLimiterService
-	tokenService services.TokenService
+	emailService   services.EmailService
+	rateLimiter    services.RateLimiterService
+	tokenService   services.TokenService
+	tokenDenylist  services.TokenDenylistService
+	mfaChallenge   services.MfaChallengeService
+	scoringService services.ScoringService
+	reportService  services.ReportService
 
 	// Use Case Auth
 	RegisterUC                  auth.RegisterUseCase
@@ -51,6 +87,16 @@ type Container struct {
 	VerificationAccountUC       auth.VerificationAccountUseCase
 	ForgetPasswordUC            auth.ForgetPasswordUseCase
 	ResendForgetPasswordUC      auth.ResendForgetPasswordUseCase
+	FindSessionsUC              auth.FindSessionsUseCase
+	RevokeSessionUC             auth.RevokeSessionUseCase
+	RevokeOtherSessionsUC       auth.RevokeOtherSessionsUseCase
+	RevokeUserSessionsUC        auth.RevokeUserSessionsUseCase
+	VerifyMfaLoginUC            auth.VerifyMfaLoginUseCase
+	FindMfaStatusUC             auth.FindMfaStatusUseCase
+	StartMfaEnrollmentUC        auth.StartMfaEnrollmentUseCase
+	ConfirmMfaEnrollmentUC      auth.ConfirmMfaEnrollmentUseCase
+	RegenerateRecoveryCodesUC   auth.RegenerateRecoveryCodesUseCase
+	DisableMfaUC                auth.DisableMfaUseCase
 
 	// Use Cases Admin
 	CreateAdminUC     admin.CreateAdminUseCase
@@ -66,11 +112,61 @@ type Container struct {
 	UpdateTherapistUC     therapist.UpdateTherapistUseCase
 	DeleteTherapistUC     therapist.DeleteTherapistUseCase
 
+	// Use Case Schedule
+	FindWorkingHoursUC   schedule.FindWorkingHoursUseCase
+	UpdateWorkingHoursUC schedule.UpdateWorkingHoursUseCase
+	FindTimeOffsUC       schedule.FindTimeOffsUseCase
+	CreateTimeOffUC      schedule.CreateTimeOffUseCase
+	DeleteTimeOffUC      schedule.DeleteTimeOffUseCase
+	FindAvailableSlotsUC schedule.FindAvailableSlotsUseCase
+
+	// Use Case Scoring
+	FindScoringThresholdsUC   scoring.FindThresholdsUseCase
+	UpdateScoringThresholdsUC scoring.UpdateThresholdsUseCase
+
+	// Use Case Questionnaire
+	FindQuestionnaireVersionsUC      questionnaire.FindVersionsUseCase
+	FindQuestionnaireVersionDetailUC questionnaire.FindVersionDetailUseCase
+	CreateQuestionnaireVersionUC     questionnaire.CreateVersionUseCase
+	PublishQuestionnaireVersionUC    questionnaire.PublishVersionUseCase
+	CreateQuestionUC                 questionnaire.CreateQuestionUseCase
+	UpdateQuestionUC                 questionnaire.UpdateQuestionUseCase
+	DeleteQuestionUC                 questionnaire.DeleteQuestionUseCase
+	FindObservationDomainsUC         questionnaire.FindDomainsUseCase
+	CreateObservationDomainUC        questionnaire.CreateDomainUseCase
+	UpdateObservationDomainUC        questionnaire.UpdateDomainUseCase
+	DeleteObservationDomainUC        questionnaire.DeleteDomainUseCase
+	FindAgeCategoriesUC              questionnaire.FindAgeCategoriesUseCase
+	CreateAgeCategoryUC              questionnaire.CreateAgeCategoryUseCase
+	UpdateAgeCategoryUC              questionnaire.UpdateAgeCategoryUseCase
+	DeleteAgeCategoryUC              questionnaire.DeleteAgeCategoryUseCase
+
+	// Use Case Report
+	DownloadObservationReportUC report.DownloadObservationReportUseCase
+
 	// Use Case Registration
 	RegistrationUC registration.RegistrationUseCase
+	AddChildUC     registration.AddChildUseCase
 
 	// Use Case Child
-	FindChildsUC child.FindChildUseCase
+	FindChildsUC             child.FindChildUseCase
+	CreateChildObservationUC child.CreateChildObservationUseCase
+	FindChildProgressUC      child.FindChildProgressUseCase
+	FindChildCarePlanUC      child.FindChildCarePlanUseCase
+
+	// Use Case Search
+	GlobalSearchUC search.GlobalSearchUseCase
+
+	// Use Case Parent
+	FindParentProfileUC     parent.FindParentProfileUseCase
+	FindParentChildrenUC    parent.FindParentChildrenUseCase
+	FindParentChildDetailUC parent.FindParentChildDetailUseCase
+	FindChildObservationUC  parent.FindChildObservationUseCase
+	CreateParentDetailUC    parent.CreateParentDetailUseCase
+	FindParentDetailsUC     parent.FindParentDetailsUseCase
+	UpdateParentDetailUC    parent.UpdateParentDetailUseCase
+	DeleteParentDetailUC    parent.DeleteParentDetailUseCase
+	SetPrimaryContactUC     parent.SetPrimaryContactUseCase
 
 	//Use Case Observation
 	FindPendingObservationsUC   observation.FindPendingObservationsUseCase
@@ -78,16 +174,75 @@ type Container struct {
 	FindCompletedObservationsUC observation.FindCompletedObservationsUseCase
 	FindObservationDetailUC     observation.FindObservationDetailUseCase
 	UpdateObservationDateUC     observation.UpdateObservationDateUseCase
+	UpdateObservationStatusUC   observation.UpdateObservationStatusUseCase
 	ObservationQuestionsUC      observation.QuestionsUseCase
+	PreviewObservationScoreUC   observation.PreviewObservationScoreUseCase
 	SubmitObservationUC         observation.SubmitObservationUseCase
+	FindObservationDraftUC      observation.FindObservationDraftUseCase
+	SaveObservationDraftUC      observation.SaveObservationDraftUseCase
+	FinaliseObservationUC       observation.FinaliseObservationUseCase
+	AmendObservationUC          observation.AmendObservationUseCase
+	FindObservationAnswersUC    observation.FindObservationAnswersUseCase
+
+	// Use Case Assessment
+	CreateAssessmentInstrumentUC assessment.CreateInstrumentUseCase
+	FindAssessmentInstrumentsUC  assessment.FindInstrumentsUseCase
+	UpdateAssessmentInstrumentUC assessment.UpdateInstrumentUseCase
+	OpenAssessmentUC             assessment.OpenAssessmentUseCase
+	FindAssessmentsUC            assessment.FindAssessmentsUseCase
+	FindAssessmentDetailUC       assessment.FindAssessmentDetailUseCase
+	ReassignAssessmentUC         assessment.ReassignAssessmentUseCase
+	CancelAssessmentUC           assessment.CancelAssessmentUseCase
+	SaveAssessmentScoresUC       assessment.SaveAssessmentScoresUseCase
+	CompleteAssessmentUC         assessment.CompleteAssessmentUseCase
+
+	// Use Case Case Conference
+	CreateConferenceUC     conference.CreateConferenceUseCase
+	FindConferencesUC      conference.FindConferencesUseCase
+	FindConferenceDetailUC conference.FindConferenceDetailUseCase
+	UpdateConferenceUC     conference.UpdateConferenceUseCase
+
+	// Use Case Intervention
+	CreateInterventionPlanUC intervention.CreatePlanUseCase
+	FindInterventionPlansUC  intervention.FindPlansUseCase
+	UpdateInterventionPlanUC intervention.UpdatePlanUseCase
+	FindTodaySessionsUC      intervention.FindTodaySessionsUseCase
+	RecordSessionUC          intervention.RecordSessionUseCase
+	FindSessionDetailUC      intervention.FindSessionDetailUseCase
+	FindAttendanceReportUC   intervention.FindAttendanceReportUseCase
+
+	// Use Case Care Plan
+	CreateCarePlanGoalUC careplan.CreateGoalUseCase
+	UpdateCarePlanGoalUC careplan.UpdateGoalUseCase
+	FindGoalAttainmentUC careplan.FindGoalAttainmentUseCase
+
+	// Use Case Evaluation
+	PrepareEvaluationUC      evaluation.PrepareEvaluationUseCase
+	CreateEvaluationUC       evaluation.CreateEvaluationUseCase
+	FindChildEvaluationsUC   evaluation.FindChildEvaluationsUseCase
+	FindEvaluationDetailUC   evaluation.FindEvaluationDetailUseCase
+	FindOverdueEvaluationsUC evaluation.FindOverdueEvaluationsUseCase
 
 	// Handlers
-	AdminHandler        *handlers.AdminHandler
-	AuthHandler         *handlers.AuthHandler
-	ObservationHandler  *handlers.ObservationHandler
-	RegistrationHandler *handlers.RegistrationHandler
-	TherapistHandler    *handlers.TherapistHandler
-	ChildHandler        *handlers.ChildHandler
+	AdminHandler          *handlers.AdminHandler
+	AuthHandler           *handlers.AuthHandler
+	ObservationHandler    *handlers.ObservationHandler
+	RegistrationHandler   *handlers.RegistrationHandler
+	TherapistHandler      *handlers.TherapistHandler
+	ChildHandler          *handlers.ChildHandler
+	ParentHandler         *handlers.ParentHandler
+	SearchHandler         *handlers.SearchHandler
+	ScheduleHandler       *handlers.ScheduleHandler
+	ScoringHandler        *handlers.ScoringHandler
+	QuestionnaireHandler  *handlers.QuestionnaireHandler
+	ReportHandler         *handlers.ReportHandler
+	AssessmentHandler     *handlers.AssessmentHandler
+	CaseConferenceHandler *handlers.CaseConferenceHandler
+	InterventionHandler   *handlers.InterventionHandler
+	CarePlanHandler       *handlers.CarePlanHandler
+	EvaluationHandler     *handlers.EvaluationHandler
+	SessionHandler        *handlers.SessionHandler
+	MfaHandler            *handlers.MfaHandler
 }
 
 func NewContainer() (*Container, error) {
@@ -141,14 +296,34 @@ func (c *Container) initRepositories() error {
 	db := c.DB.GetDB()
 
 	c.AdminRepo = gorm.NewAdminRepository(db)
+	c.AgeCategoryRepo = gorm.NewAgeCategoryRepository(db)
+	c.AssessmentInstrumentRepo = gorm.NewAssessmentInstrumentRepository(db)
+	c.AssessmentRepo = gorm.NewAssessmentRepository(db)
+	c.AssessmentScoreRepo = gorm.NewAssessmentScoreRepository(db)
+	c.CarePlanRepo = gorm.NewCarePlanRepository(db)
+	c.CaseConferenceRepo = gorm.NewCaseConferenceRepository(db)
 	c.ChildRepo = gorm.NewChildRepository(db)
+	c.EvaluationRepo = gorm.NewEvaluationRepository(db)
+	c.InterventionPlanRepo = gorm.NewInterventionPlanRepository(db)
+	c.InterventionSessionRepo = gorm.NewInterventionSessionRepository(db)
+	c.MfaRepo = gorm.NewMfaRepository(db)
 	c.ObservationRepo = gorm.NewObservationRepository(db)
+	c.ObservationDomainRepo = gorm.NewObservationDomainRepository(db)
+	c.ObservationReportRepo = gorm.NewObservationReportRepository(db)
+	c.ObservationRevisionRepo = gorm.NewObservationRevisionRepository(db)
 	c.ObservationQuestionRepo = gorm.NewObservationQuestionRepository(db)
 	c.ObservationAnswerRepo = gorm.NewObservationAnswerRepository(db)
+	c.ObservationStatusRepo = gorm.NewObservationStatusHistoryRepository(db)
+	c.ObservationScoreRepo = gorm.NewObservationDomainScoreRepository(db)
 	c.ParentDetailRepo = gorm.NewParentDetailRepository(db)
 	c.ParentRepo = gorm.NewParentRepository(db)
+	c.QuestionnaireVersionRepo = gorm.NewQuestionnaireVersionRepository(db)
 	c.RefreshTokenRepo = gorm.NewRefreshTokenRepository(db)
+	c.ScoringThresholdRepo = gorm.NewScoringThresholdRepository(db)
+	c.SearchRepo = gorm.NewSearchRepository(db)
 	c.TherapistRepo = gorm.NewTherapistRepository(db)
+	c.TherapistTimeOffRepo = gorm.NewTherapistTimeOffRepository(db)
+	c.TherapistWorkingHourRepo = gorm.NewTherapistWorkingHourRepository(db)
 	c.TxRepo = gorm.NewTransactionRepository(db)
 	c.UserRepo = gorm.NewUserRepository(db)
 	c.VerifyTokenRepo = gorm.NewVerificationTokenRepository(db)
@@ -160,6 +335,10 @@ func (c *Container) initServices() error {
 	c.emailService = services.NewEmailService()
 	c.rateLimiter = services.NewRateLimiterService(c.RedisClient)
 	c.tokenService = services.NewTokenService()
+	c.tokenDenylist = services.NewTokenDenylistService(c.RedisClient)
+	c.mfaChallenge = services.NewMfaChallengeService(c.RedisClient)
+	c.scoringService = services.NewScoringService()
+	c.reportService = services.NewReportService(config.GetEnv("REPORT_BRAND_NAME", "Klinik Puspa"))
 
 	return nil
 }
@@ -172,9 +351,13 @@ func (c *Container) initUseCases() error {
 		c.ParentRepo,
 		c.VerifyTokenRepo,
 		c.RefreshTokenRepo,
+		c.MfaRepo,
 		c.emailService,
 		c.rateLimiter,
 		c.tokenService,
+		c.tokenDenylist,
+		c.mfaChallenge,
+		config.GetEnv("MFA_REQUIRED_FOR_ADMIN", "false") == "true",
 	)
 
 	c.RegisterUC = auth.NewRegisterUseCase(authDeps)
@@ -186,12 +369,24 @@ func (c *Container) initUseCases() error {
 	c.VerificationAccountUC = auth.NewVerificationAccountUseCase(authDeps)
 	c.ForgetPasswordUC = auth.NewForgetPasswordUseCase(authDeps)
 	c.ResendForgetPasswordUC = auth.NewResendForgetPasswordUseCase(authDeps)
+	c.FindSessionsUC = auth.NewFindSessionsUseCase(authDeps)
+	c.RevokeSessionUC = auth.NewRevokeSessionUseCase(authDeps)
+	c.RevokeOtherSessionsUC = auth.NewRevokeOtherSessionsUseCase(authDeps)
+	c.RevokeUserSessionsUC = auth.NewRevokeUserSessionsUseCase(authDeps)
+	c.VerifyMfaLoginUC = auth.NewVerifyMfaLoginUseCase(authDeps)
+	c.FindMfaStatusUC = auth.NewFindMfaStatusUseCase(authDeps)
+	c.StartMfaEnrollmentUC = auth.NewStartMfaEnrollmentUseCase(authDeps)
+	c.ConfirmMfaEnrollmentUC = auth.NewConfirmMfaEnrollmentUseCase(authDeps)
+	c.RegenerateRecoveryCodesUC = auth.NewRegenerateRecoveryCodesUseCase(authDeps)
+	c.DisableMfaUC = auth.NewDisableMfaUseCase(authDeps)
 
 	// Admin Use Case
 	adminDeps := admin.NewDependencies(
 		c.TxRepo,
 		c.UserRepo,
 		c.AdminRepo,
+		c.RefreshTokenRepo,
+		c.tokenDenylist,
 	)
 
 	c.CreateAdminUC = admin.NewCreateAdminUseCase(adminDeps)
@@ -201,7 +396,7 @@ func (c *Container) initUseCases() error {
 	c.DeleteAdminUC = admin.NewDeleteAdminUseCase(adminDeps)
 
 	// Therapist Use Case
-	therapistDeps := therapist.NewDependencies(c.TxRepo, c.UserRepo, c.TherapistRepo)
+	therapistDeps := therapist.NewDependencies(c.TxRepo, c.UserRepo, c.TherapistRepo, c.RefreshTokenRepo, c.tokenDenylist)
 
 	c.CreateTherapistUC = therapist.NewCreateTherapistUseCase(therapistDeps)
 	c.FindTherapistsUC = therapist.NewFindTherapistsUseCase(therapistDeps)
@@ -209,6 +404,73 @@ func (c *Container) initUseCases() error {
 	c.UpdateTherapistUC = therapist.NewUpdateTherapistUseCase(therapistDeps)
 	c.DeleteTherapistUC = therapist.NewDeleteTherapistUseCase(therapistDeps)
 
+	// Schedule Use Case
+	scheduleDeps := schedule.NewDependencies(
+		c.TxRepo,
+		c.TherapistRepo,
+		c.TherapistWorkingHourRepo,
+		c.TherapistTimeOffRepo,
+		c.ObservationRepo,
+	)
+
+	c.FindWorkingHoursUC = schedule.NewFindWorkingHoursUseCase(scheduleDeps)
+	c.UpdateWorkingHoursUC = schedule.NewUpdateWorkingHoursUseCase(scheduleDeps)
+	c.FindTimeOffsUC = schedule.NewFindTimeOffsUseCase(scheduleDeps)
+	c.CreateTimeOffUC = schedule.NewCreateTimeOffUseCase(scheduleDeps)
+	c.DeleteTimeOffUC = schedule.NewDeleteTimeOffUseCase(scheduleDeps)
+	c.FindAvailableSlotsUC = schedule.NewFindAvailableSlotsUseCase(scheduleDeps)
+
+	// Scoring Use Case
+	scoringDeps := scoring.NewDependencies(
+		c.TxRepo,
+		c.ScoringThresholdRepo,
+		c.ObservationDomainRepo,
+		c.AgeCategoryRepo,
+	)
+
+	c.FindScoringThresholdsUC = scoring.NewFindThresholdsUseCase(scoringDeps)
+	c.UpdateScoringThresholdsUC = scoring.NewUpdateThresholdsUseCase(scoringDeps)
+
+	// Questionnaire Use Case
+	questionnaireDeps := questionnaire.NewDependencies(
+		c.TxRepo,
+		c.QuestionnaireVersionRepo,
+		c.ObservationQuestionRepo,
+		c.ObservationDomainRepo,
+		c.AgeCategoryRepo,
+	)
+
+	c.FindQuestionnaireVersionsUC = questionnaire.NewFindVersionsUseCase(questionnaireDeps)
+	c.FindQuestionnaireVersionDetailUC = questionnaire.NewFindVersionDetailUseCase(questionnaireDeps)
+	c.CreateQuestionnaireVersionUC = questionnaire.NewCreateVersionUseCase(questionnaireDeps)
+	c.PublishQuestionnaireVersionUC = questionnaire.NewPublishVersionUseCase(questionnaireDeps)
+	c.CreateQuestionUC = questionnaire.NewCreateQuestionUseCase(questionnaireDeps)
+	c.UpdateQuestionUC = questionnaire.NewUpdateQuestionUseCase(questionnaireDeps)
+	c.DeleteQuestionUC = questionnaire.NewDeleteQuestionUseCase(questionnaireDeps)
+	c.FindObservationDomainsUC = questionnaire.NewFindDomainsUseCase(questionnaireDeps)
+	c.CreateObservationDomainUC = questionnaire.NewCreateDomainUseCase(questionnaireDeps)
+	c.UpdateObservationDomainUC = questionnaire.NewUpdateDomainUseCase(questionnaireDeps)
+	c.DeleteObservationDomainUC = questionnaire.NewDeleteDomainUseCase(questionnaireDeps)
+	c.FindAgeCategoriesUC = questionnaire.NewFindAgeCategoriesUseCase(questionnaireDeps)
+	c.CreateAgeCategoryUC = questionnaire.NewCreateAgeCategoryUseCase(questionnaireDeps)
+	c.UpdateAgeCategoryUC = questionnaire.NewUpdateAgeCategoryUseCase(questionnaireDeps)
+	c.DeleteAgeCategoryUC = questionnaire.NewDeleteAgeCategoryUseCase(questionnaireDeps)
+
+	// Report Use Case
+	reportDeps := report.NewDependencies(
+		c.TxRepo,
+		c.ObservationRepo,
+		c.ParentRepo,
+		c.ObservationAnswerRepo,
+		c.ObservationQuestionRepo,
+		c.ObservationDomainRepo,
+		c.ObservationScoreRepo,
+		c.ObservationReportRepo,
+		c.reportService,
+	)
+
+	c.DownloadObservationReportUC = report.NewDownloadObservationReportUseCase(reportDeps)
+
 	// Registration Use Case
 	registrationDeps := registration.NewDependencies(
 		c.TxRepo,
@@ -216,14 +478,55 @@ func (c *Container) initUseCases() error {
 		c.ParentDetailRepo,
 		c.ChildRepo,
 		c.ObservationRepo,
+		c.AgeCategoryRepo,
 	)
 
 	c.RegistrationUC = registration.NewRegistrationUseCase(registrationDeps)
+	c.AddChildUC = registration.NewAddChildUseCase(registrationDeps)
 
 	// Child Use Case
-	childDeps := child.NewDependencies(c.ChildRepo)
+	childDeps := child.NewDependencies(
+		c.TxRepo,
+		c.ChildRepo,
+		c.ObservationRepo,
+		c.ObservationAnswerRepo,
+		c.ObservationQuestionRepo,
+		c.ObservationDomainRepo,
+		c.ObservationScoreRepo,
+		c.AgeCategoryRepo,
+		c.TherapistRepo,
+		c.ParentRepo,
+		c.CarePlanRepo,
+		c.InterventionPlanRepo,
+	)
 
 	c.FindChildsUC = child.NewFindChildUseCase(childDeps)
+	c.CreateChildObservationUC = child.NewCreateChildObservationUseCase(childDeps)
+	c.FindChildProgressUC = child.NewFindChildProgressUseCase(childDeps)
+	c.FindChildCarePlanUC = child.NewFindChildCarePlanUseCase(childDeps)
+
+	// Search Use Case
+	searchDeps := search.NewDependencies(c.SearchRepo)
+
+	c.GlobalSearchUC = search.NewGlobalSearchUseCase(searchDeps)
+
+	// Parent Use Case
+	parentDeps := parent.NewDependencies(
+		c.TxRepo,
+		c.ParentRepo,
+		c.ParentDetailRepo,
+		c.ChildRepo,
+	)
+
+	c.FindParentProfileUC = parent.NewFindParentProfileUseCase(parentDeps)
+	c.FindParentChildrenUC = parent.NewFindParentChildrenUseCase(parentDeps)
+	c.FindParentChildDetailUC = parent.NewFindParentChildDetailUseCase(parentDeps)
+	c.FindChildObservationUC = parent.NewFindChildObservationUseCase(parentDeps)
+	c.CreateParentDetailUC = parent.NewCreateParentDetailUseCase(parentDeps)
+	c.FindParentDetailsUC = parent.NewFindParentDetailsUseCase(parentDeps)
+	c.UpdateParentDetailUC = parent.NewUpdateParentDetailUseCase(parentDeps)
+	c.DeleteParentDetailUC = parent.NewDeleteParentDetailUseCase(parentDeps)
+	c.SetPrimaryContactUC = parent.NewSetPrimaryContactUseCase(parentDeps)
 
 	// Observation Use Case
 	observationDeps := observation.NewDependencies(
@@ -232,6 +535,15 @@ func (c *Container) initUseCases() error {
 		c.ObservationQuestionRepo,
 		c.ObservationAnswerRepo,
 		c.TherapistRepo,
+		c.TherapistWorkingHourRepo,
+		c.TherapistTimeOffRepo,
+		c.ObservationStatusRepo,
+		c.ScoringThresholdRepo,
+		c.ObservationScoreRepo,
+		c.QuestionnaireVersionRepo,
+		c.ObservationDomainRepo,
+		c.ObservationRevisionRepo,
+		c.scoringService,
 	)
 
 	c.FindPendingObservationsUC = observation.NewFindPendingObservationsUseCase(observationDeps)
@@ -239,8 +551,101 @@ func (c *Container) initUseCases() error {
 	c.FindCompletedObservationsUC = observation.NewFindCompletedObservationsUseCase(observationDeps)
 	c.FindObservationDetailUC = observation.NewFindObservationDetailUseCase(observationDeps)
 	c.UpdateObservationDateUC = observation.NewUpdateObservationDateUseCase(observationDeps)
+	c.UpdateObservationStatusUC = observation.NewUpdateObservationStatusUseCase(observationDeps)
 	c.ObservationQuestionsUC = observation.NewObservationQuestionsUseCase(observationDeps)
+	c.PreviewObservationScoreUC = observation.NewPreviewObservationScoreUseCase(observationDeps)
 	c.SubmitObservationUC = observation.NewSubmitObservationUseCase(observationDeps)
+	c.FindObservationDraftUC = observation.NewFindObservationDraftUseCase(observationDeps)
+	c.SaveObservationDraftUC = observation.NewSaveObservationDraftUseCase(observationDeps)
+	c.FinaliseObservationUC = observation.NewFinaliseObservationUseCase(observationDeps)
+	c.AmendObservationUC = observation.NewAmendObservationUseCase(observationDeps)
+	c.FindObservationAnswersUC = observation.NewFindObservationAnswersUseCase(observationDeps)
+
+	// Assessment Use Case
+	assessmentDeps := assessment.NewDependencies(
+		c.TxRepo,
+		c.ObservationRepo,
+		c.TherapistRepo,
+		c.AssessmentInstrumentRepo,
+		c.AssessmentRepo,
+		c.AssessmentScoreRepo,
+		c.CarePlanRepo,
+	)
+
+	c.CreateAssessmentInstrumentUC = assessment.NewCreateInstrumentUseCase(assessmentDeps)
+	c.FindAssessmentInstrumentsUC = assessment.NewFindInstrumentsUseCase(assessmentDeps)
+	c.UpdateAssessmentInstrumentUC = assessment.NewUpdateInstrumentUseCase(assessmentDeps)
+	c.OpenAssessmentUC = assessment.NewOpenAssessmentUseCase(assessmentDeps)
+	c.FindAssessmentsUC = assessment.NewFindAssessmentsUseCase(assessmentDeps)
+	c.FindAssessmentDetailUC = assessment.NewFindAssessmentDetailUseCase(assessmentDeps)
+	c.ReassignAssessmentUC = assessment.NewReassignAssessmentUseCase(assessmentDeps)
+	c.CancelAssessmentUC = assessment.NewCancelAssessmentUseCase(assessmentDeps)
+	c.SaveAssessmentScoresUC = assessment.NewSaveAssessmentScoresUseCase(assessmentDeps)
+	c.CompleteAssessmentUC = assessment.NewCompleteAssessmentUseCase(assessmentDeps)
+
+	// Case Conference Use Case
+	conferenceDeps := conference.NewDependencies(
+		c.TxRepo,
+		c.ChildRepo,
+		c.TherapistRepo,
+		c.CaseConferenceRepo,
+	)
+
+	c.CreateConferenceUC = conference.NewCreateConferenceUseCase(conferenceDeps)
+	c.FindConferencesUC = conference.NewFindConferencesUseCase(conferenceDeps)
+	c.FindConferenceDetailUC = conference.NewFindConferenceDetailUseCase(conferenceDeps)
+	c.UpdateConferenceUC = conference.NewUpdateConferenceUseCase(conferenceDeps)
+
+	// Intervention Use Case
+	interventionDeps := intervention.NewDependencies(
+		c.TxRepo,
+		c.ChildRepo,
+		c.TherapistRepo,
+		c.InterventionPlanRepo,
+		c.InterventionSessionRepo,
+		c.CarePlanRepo,
+	)
+
+	c.CreateInterventionPlanUC = intervention.NewCreatePlanUseCase(interventionDeps)
+	c.FindInterventionPlansUC = intervention.NewFindPlansUseCase(interventionDeps)
+	c.UpdateInterventionPlanUC = intervention.NewUpdatePlanUseCase(interventionDeps)
+	c.FindTodaySessionsUC = intervention.NewFindTodaySessionsUseCase(interventionDeps)
+	c.RecordSessionUC = intervention.NewRecordSessionUseCase(interventionDeps)
+	c.FindSessionDetailUC = intervention.NewFindSessionDetailUseCase(interventionDeps)
+	c.FindAttendanceReportUC = intervention.NewFindAttendanceReportUseCase(interventionDeps)
+
+	// Care Plan Use Case
+	carePlanDeps := careplan.NewDependencies(
+		c.TxRepo,
+		c.ChildRepo,
+		c.TherapistRepo,
+		c.ParentRepo,
+		c.CarePlanRepo,
+		c.InterventionPlanRepo,
+	)
+
+	c.CreateCarePlanGoalUC = careplan.NewCreateGoalUseCase(carePlanDeps)
+	c.UpdateCarePlanGoalUC = careplan.NewUpdateGoalUseCase(carePlanDeps)
+	c.FindGoalAttainmentUC = careplan.NewFindGoalAttainmentUseCase(carePlanDeps)
+
+	// Evaluation Use Case
+	evaluationDeps := evaluation.NewDependencies(
+		c.TxRepo,
+		c.ChildRepo,
+		c.TherapistRepo,
+		c.ObservationRepo,
+		c.ObservationDomainRepo,
+		c.ObservationScoreRepo,
+		c.CarePlanRepo,
+		c.InterventionPlanRepo,
+		c.EvaluationRepo,
+	)
+
+	c.PrepareEvaluationUC = evaluation.NewPrepareEvaluationUseCase(evaluationDeps)
+	c.CreateEvaluationUC = evaluation.NewCreateEvaluationUseCase(evaluationDeps)
+	c.FindChildEvaluationsUC = evaluation.NewFindChildEvaluationsUseCase(evaluationDeps)
+	c.FindEvaluationDetailUC = evaluation.NewFindEvaluationDetailUseCase(evaluationDeps)
+	c.FindOverdueEvaluationsUC = evaluation.NewFindOverdueEvaluationsUseCase(evaluationDeps)
 
 	return nil
 }
@@ -266,6 +671,22 @@ func (c *Container) initHandlers() error {
 		c.ResendForgetPasswordUC,
 	)
 
+	c.SessionHandler = handlers.NewSessionHandler(
+		c.FindSessionsUC,
+		c.RevokeSessionUC,
+		c.RevokeOtherSessionsUC,
+		c.RevokeUserSessionsUC,
+	)
+
+	c.MfaHandler = handlers.NewMfaHandler(
+		c.VerifyMfaLoginUC,
+		c.FindMfaStatusUC,
+		c.StartMfaEnrollmentUC,
+		c.ConfirmMfaEnrollmentUC,
+		c.RegenerateRecoveryCodesUC,
+		c.DisableMfaUC,
+	)
+
 	c.TherapistHandler = handlers.NewTherapistHandler(
 		c.CreateTherapistUC,
 		c.FindTherapistsUC,
@@ -280,16 +701,123 @@ func (c *Container) initHandlers() error {
 		c.FindCompletedObservationsUC,
 		c.FindObservationDetailUC,
 		c.UpdateObservationDateUC,
+		c.UpdateObservationStatusUC,
 		c.ObservationQuestionsUC,
+		c.PreviewObservationScoreUC,
 		c.SubmitObservationUC,
+		c.FindObservationDraftUC,
+		c.SaveObservationDraftUC,
+		c.FinaliseObservationUC,
+		c.AmendObservationUC,
+		c.FindObservationAnswersUC,
 	)
 
 	c.RegistrationHandler = handlers.NewRegistrationHandler(
 		c.RegistrationUC,
+		c.AddChildUC,
 	)
 
 	c.ChildHandler = handlers.NewChildHandler(
 		c.FindChildsUC,
+		c.CreateChildObservationUC,
+		c.FindChildProgressUC,
+		c.FindChildCarePlanUC,
+	)
+
+	c.SearchHandler = handlers.NewSearchHandler(
+		c.GlobalSearchUC,
+	)
+
+	c.ScheduleHandler = handlers.NewScheduleHandler(
+		c.FindWorkingHoursUC,
+		c.UpdateWorkingHoursUC,
+		c.FindTimeOffsUC,
+		c.CreateTimeOffUC,
+		c.DeleteTimeOffUC,
+		c.FindAvailableSlotsUC,
+	)
+
+	c.ScoringHandler = handlers.NewScoringHandler(
+		c.FindScoringThresholdsUC,
+		c.UpdateScoringThresholdsUC,
+	)
+
+	c.QuestionnaireHandler = handlers.NewQuestionnaireHandler(
+		c.FindQuestionnaireVersionsUC,
+		c.FindQuestionnaireVersionDetailUC,
+		c.CreateQuestionnaireVersionUC,
+		c.PublishQuestionnaireVersionUC,
+		c.CreateQuestionUC,
+		c.UpdateQuestionUC,
+		c.DeleteQuestionUC,
+		c.FindObservationDomainsUC,
+		c.CreateObservationDomainUC,
+		c.UpdateObservationDomainUC,
+		c.DeleteObservationDomainUC,
+		c.FindAgeCategoriesUC,
+		c.CreateAgeCategoryUC,
+		c.UpdateAgeCategoryUC,
+		c.DeleteAgeCategoryUC,
+	)
+
+	c.ReportHandler = handlers.NewReportHandler(
+		c.DownloadObservationReportUC,
+	)
+
+	c.ParentHandler = handlers.NewParentHandler(
+		c.FindParentProfileUC,
+		c.FindParentChildrenUC,
+		c.FindParentChildDetailUC,
+		c.FindChildObservationUC,
+		c.CreateParentDetailUC,
+		c.FindParentDetailsUC,
+		c.UpdateParentDetailUC,
+		c.DeleteParentDetailUC,
+		c.SetPrimaryContactUC,
+	)
+
+	c.AssessmentHandler = handlers.NewAssessmentHandler(
+		c.CreateAssessmentInstrumentUC,
+		c.FindAssessmentInstrumentsUC,
+		c.UpdateAssessmentInstrumentUC,
+		c.OpenAssessmentUC,
+		c.FindAssessmentsUC,
+		c.FindAssessmentDetailUC,
+		c.ReassignAssessmentUC,
+		c.CancelAssessmentUC,
+		c.SaveAssessmentScoresUC,
+		c.CompleteAssessmentUC,
+	)
+
+	c.CaseConferenceHandler = handlers.NewCaseConferenceHandler(
+		c.CreateConferenceUC,
+		c.FindConferencesUC,
+		c.FindConferenceDetailUC,
+		c.UpdateConferenceUC,
+	)
+
+	c.InterventionHandler = handlers.NewInterventionHandler(
+		c.CreateInterventionPlanUC,
+		c.FindInterventionPlansUC,
+		c.UpdateInterventionPlanUC,
+		c.FindTodaySessionsUC,
+		c.RecordSessionUC,
+		c.FindSessionDetailUC,
+		c.FindAttendanceReportUC,
+	)
+
+	c.CarePlanHandler = handlers.NewCarePlanHandler(
+		c.CreateCarePlanGoalUC,
+		c.UpdateCarePlanGoalUC,
+		c.FindGoalAttainmentUC,
+	)
+
+	c.EvaluationHandler = handlers.NewEvaluationHandler(
+		c.PrepareEvaluationUC,
+		c.CreateEvaluationUC,
+		c.FindChildEvaluationsUC,
+		c.FindEvaluationDetailUC,
+		c.FindOverdueEvaluationsUC,
 	)
 
 	return nil
@@ -89,6 +89,106 @@ func (m *Migrator) RunMigrations() error {
 			Migrate:  migrations.MigrateCreateObservationAnswersTable,
 			Rollback: migrations.RollbackCreateObservationAnswersTable,
 		},
+		{
+			ID:       "202509221000_add_is_primary_to_parent_details",
+			Migrate:  migrations.MigrateAddIsPrimaryToParentDetails,
+			Rollback: migrations.RollbackAddIsPrimaryToParentDetails,
+		},
+		{
+			ID:       "202509221100_add_search_indexes",
+			Migrate:  migrations.MigrateAddSearchIndexes,
+			Rollback: migrations.RollbackAddSearchIndexes,
+		},
+		{
+			ID:       "202509221200_add_therapist_assignment_index",
+			Migrate:  migrations.MigrateAddTherapistAssignmentIndex,
+			Rollback: migrations.RollbackAddTherapistAssignmentIndex,
+		},
+		{
+			ID:       "202509221300_create_therapist_schedule_tables",
+			Migrate:  migrations.MigrateCreateTherapistScheduleTables,
+			Rollback: migrations.RollbackCreateTherapistScheduleTables,
+		},
+		{
+			ID:       "202509221400_add_observation_status_history",
+			Migrate:  migrations.MigrateAddObservationStatusHistory,
+			Rollback: migrations.RollbackAddObservationStatusHistory,
+		},
+		{
+			ID:       "202509221500_add_observation_scoring",
+			Migrate:  migrations.MigrateAddObservationScoring,
+			Rollback: migrations.RollbackAddObservationScoring,
+		},
+		{
+			ID:       "202509221600_add_versioned_questionnaires",
+			Migrate:  migrations.MigrateAddVersionedQuestionnaires,
+			Rollback: migrations.RollbackAddVersionedQuestionnaires,
+		},
+		{
+			ID:       "202509221700_add_observation_drafts",
+			Migrate:  migrations.MigrateAddObservationDrafts,
+			Rollback: migrations.RollbackAddObservationDrafts,
+		},
+		{
+			ID:       "202509221800_add_observation_revisions",
+			Migrate:  migrations.MigrateAddObservationRevisions,
+			Rollback: migrations.RollbackAddObservationRevisions,
+		},
+		{
+			ID:       "202509221900_create_observation_reports",
+			Migrate:  migrations.MigrateCreateObservationReports,
+			Rollback: migrations.RollbackCreateObservationReports,
+		},
+		{
+			ID:       "202509222000_create_assessments",
+			Migrate:  migrations.MigrateCreateAssessments,
+			Rollback: migrations.RollbackCreateAssessments,
+		},
+		{
+			ID:       "202509222100_create_case_conferences",
+			Migrate:  migrations.MigrateCreateCaseConferences,
+			Rollback: migrations.RollbackCreateCaseConferences,
+		},
+		{
+			ID:       "202509222200_create_interventions",
+			Migrate:  migrations.MigrateCreateInterventions,
+			Rollback: migrations.RollbackCreateInterventions,
+		},
+		{
+			ID:       "202509222300_create_care_plan_goals",
+			Migrate:  migrations.MigrateCreateCarePlanGoals,
+			Rollback: migrations.RollbackCreateCarePlanGoals,
+		},
+		{
+			ID:       "202509230000_create_evaluations",
+			Migrate:  migrations.MigrateCreateEvaluations,
+			Rollback: migrations.RollbackCreateEvaluations,
+		},
+		{
+			ID:       "202509230100_add_refresh_token_rotation",
+			Migrate:  migrations.MigrateAddRefreshTokenRotation,
+			Rollback: migrations.RollbackAddRefreshTokenRotation,
+		},
+		{
+			ID:       "202509230200_add_refresh_token_sessions",
+			Migrate:  migrations.MigrateAddRefreshTokenSessions,
+			Rollback: migrations.RollbackAddRefreshTokenSessions,
+		},
+		{
+			ID:       "202509230300_create_mfa_tables",
+			Migrate:  migrations.MigrateCreateMfaTables,
+			Rollback: migrations.RollbackCreateMfaTables,
+		},
+		{
+			ID:       "202509230400_add_observation_report_source_checksum",
+			Migrate:  migrations.MigrateAddObservationReportSourceChecksum,
+			Rollback: migrations.RollbackAddObservationReportSourceChecksum,
+		},
+		{
+			ID:       "202509230500_purge_plaintext_observation_reports",
+			Migrate:  migrations.MigratePurgePlaintextObservationReports,
+			Rollback: migrations.RollbackPurgePlaintextObservationReports,
+		},
 	})
 
 	if err := migrator.Migrate(); err != nil {
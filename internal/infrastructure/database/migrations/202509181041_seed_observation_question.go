@@ -109,8 +109,9 @@ func SeedObservationQuestionsTableUp(tx *gorm.DB) error {
 			return err
 		}
 		if count == 0 {
-
-			if err := tx.Create(&question).Error; err != nil {
+			// Versioning and domain columns are added and backfilled by a
+			// later migration.
+			if err := tx.Omit("QuestionnaireVersionId", "Domain").Create(&question).Error; err != nil {
 				return err
 			}
 		}
@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateAddIsPrimaryToParentDetails(tx *gorm.DB) error {
+	if err := tx.Exec(`
+        ALTER TABLE parent_details
+			ADD COLUMN is_primary BOOLEAN NOT NULL DEFAULT FALSE AFTER relationship_with_child,
+			ADD UNIQUE KEY unique_parent_details_parent_type (parent_id, parent_type);
+    `).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`
+        UPDATE parent_details pd
+		JOIN (
+			SELECT parent_id, MIN(created_at) AS first_created_at
+			FROM parent_details
+			GROUP BY parent_id
+		) first_detail ON first_detail.parent_id = pd.parent_id AND first_detail.first_created_at = pd.created_at
+		SET pd.is_primary = TRUE;
+    `).Error
+}
+
+func RollbackAddIsPrimaryToParentDetails(tx *gorm.DB) error {
+	return tx.Exec(`
+        ALTER TABLE parent_details
+			DROP INDEX unique_parent_details_parent_type,
+			DROP COLUMN is_primary;
+    `).Error
+}
@@ -0,0 +1,121 @@
+package migrations
+
+import (
+	"backend-golang/internal/helpers"
+	"backend-golang/internal/infrastructure/config"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+func MigrateAddSearchIndexes(tx *gorm.DB) error {
+	if err := tx.Exec(`
+        ALTER TABLE parent_details
+			ADD COLUMN parent_phone_index CHAR(64) NULL AFTER parent_phone,
+			ADD INDEX idx_parent_details_parent_phone_index (parent_phone_index),
+			ADD FULLTEXT INDEX ft_parent_details_parent_name (parent_name);
+    `).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Exec(`
+        ALTER TABLE childrens
+			ADD COLUMN child_address_index CHAR(64) NULL AFTER child_address,
+			ADD INDEX idx_childrens_child_address_index (child_address_index),
+			ADD FULLTEXT INDEX ft_childrens_child_name_school (child_name, child_school);
+    `).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Exec(`
+        ALTER TABLE therapists
+			ADD COLUMN therapist_phone_index CHAR(64) NULL AFTER therapist_phone,
+			ADD INDEX idx_therapists_therapist_phone_index (therapist_phone_index),
+			ADD FULLTEXT INDEX ft_therapists_therapist_name (therapist_name);
+    `).Error; err != nil {
+		return err
+	}
+
+	return backfillBlindIndexes(tx)
+}
+
+func RollbackAddSearchIndexes(tx *gorm.DB) error {
+	if err := tx.Exec(`
+        ALTER TABLE therapists
+			DROP INDEX ft_therapists_therapist_name,
+			DROP INDEX idx_therapists_therapist_phone_index,
+			DROP COLUMN therapist_phone_index;
+    `).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Exec(`
+        ALTER TABLE childrens
+			DROP INDEX ft_childrens_child_name_school,
+			DROP INDEX idx_childrens_child_address_index,
+			DROP COLUMN child_address_index;
+    `).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`
+        ALTER TABLE parent_details
+			DROP INDEX ft_parent_details_parent_name,
+			DROP INDEX idx_parent_details_parent_phone_index,
+			DROP COLUMN parent_phone_index;
+    `).Error
+}
+
+// backfillBlindIndexes has to decrypt every existing value, since the keyed
+// hash cannot be derived from the ciphertext in SQL.
+func backfillBlindIndexes(tx *gorm.DB) error {
+	encryptionKey := config.GetEnv("ENCRYPTION_KEY", "")
+	blindIndexKey := config.GetEnv("BLIND_INDEX_KEY", "")
+	if encryptionKey == "" || blindIndexKey == "" {
+		return fmt.Errorf("ENCRYPTION_KEY and BLIND_INDEX_KEY are required to backfill blind indexes")
+	}
+
+	targets := []struct {
+		table       string
+		valueColumn string
+		indexColumn string
+		index       func(value string, key string) (string, error)
+	}{
+		{"parent_details", "parent_phone", "parent_phone_index", helpers.PhoneBlindIndex},
+		{"childrens", "child_address", "child_address_index", helpers.AddressBlindIndex},
+		{"therapists", "therapist_phone", "therapist_phone_index", helpers.PhoneBlindIndex},
+	}
+
+	for _, target := range targets {
+		var rows []struct {
+			Id    string
+			Value []byte
+		}
+
+		if err := tx.Table(target.table).
+			Select(fmt.Sprintf("id, %s AS value", target.valueColumn)).
+			Scan(&rows).Error; err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			plaintext, err := helpers.DecryptData(row.Value, encryptionKey)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt %s.%s for %s: %w", target.table, target.valueColumn, row.Id, err)
+			}
+
+			index, err := target.index(string(plaintext), blindIndexKey)
+			if err != nil {
+				return err
+			}
+
+			if err := tx.Table(target.table).
+				Where("id = ?", row.Id).
+				Update(target.indexColumn, index).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateAddTherapistAssignmentIndex(tx *gorm.DB) error {
+	// Unassigned observations used to be stored with an empty therapist_id,
+	// which the foreign key would reject.
+	if err := tx.Exec(`
+        UPDATE observations SET therapist_id = NULL WHERE therapist_id = '';
+    `).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`
+        ALTER TABLE observations
+			ADD INDEX therapist_id_status_idx (therapist_id, status),
+			ADD CONSTRAINT fk_observations_therapist FOREIGN KEY (therapist_id) REFERENCES therapists(id);
+    `).Error
+}
+
+func RollbackAddTherapistAssignmentIndex(tx *gorm.DB) error {
+	return tx.Exec(`
+        ALTER TABLE observations
+			DROP FOREIGN KEY fk_observations_therapist,
+			DROP INDEX therapist_id_status_idx;
+    `).Error
+}
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateCreateTherapistScheduleTables(tx *gorm.DB) error {
+	if err := tx.Exec(`
+        CREATE TABLE therapist_working_hours (
+			id CHAR(26) PRIMARY KEY,
+			therapist_id CHAR(26) NOT NULL,
+			day_of_week TINYINT NOT NULL,
+			start_time TIME NOT NULL,
+			end_time TIME NOT NULL,
+			slot_minutes SMALLINT NOT NULL,
+			age_category ENUM('Balita', 'Anak-anak', 'Remaja', 'Lainnya') NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			INDEX therapist_id_day_idx (therapist_id, day_of_week),
+			FOREIGN KEY (therapist_id) REFERENCES therapists(id) ON DELETE CASCADE
+		);
+    `).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Exec(`
+        CREATE TABLE therapist_time_offs (
+			id CHAR(26) PRIMARY KEY,
+			therapist_id CHAR(26) NOT NULL,
+			start_at DATETIME NOT NULL,
+			end_at DATETIME NOT NULL,
+			reason VARCHAR(255) NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			INDEX therapist_id_range_idx (therapist_id, start_at, end_at),
+			FOREIGN KEY (therapist_id) REFERENCES therapists(id) ON DELETE CASCADE
+		);
+    `).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`
+        ALTER TABLE observations
+			ADD COLUMN scheduled_start DATETIME NULL AFTER scheduled_date,
+			ADD COLUMN scheduled_end DATETIME NULL AFTER scheduled_start,
+			ADD INDEX therapist_id_scheduled_start_idx (therapist_id, scheduled_start);
+    `).Error
+}
+
+func RollbackCreateTherapistScheduleTables(tx *gorm.DB) error {
+	if err := tx.Exec(`
+        ALTER TABLE observations
+			DROP INDEX therapist_id_scheduled_start_idx,
+			DROP COLUMN scheduled_end,
+			DROP COLUMN scheduled_start;
+    `).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Exec("DROP TABLE therapist_time_offs;").Error; err != nil {
+		return err
+	}
+
+	return tx.Exec("DROP TABLE therapist_working_hours;").Error
+}
@@ -0,0 +1,52 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateAddObservationStatusHistory(tx *gorm.DB) error {
+	if err := tx.Exec(`
+        ALTER TABLE observations
+			MODIFY COLUMN status ENUM('Pending', 'Scheduled', 'InProgress', 'Complete', 'Cancelled', 'NoShow', 'Rescheduled') NOT NULL DEFAULT 'Pending';
+    `).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`
+        CREATE TABLE observation_status_histories (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			observation_id INTEGER NOT NULL,
+			from_status VARCHAR(20) NOT NULL,
+			to_status VARCHAR(20) NOT NULL,
+			reason TEXT NULL,
+			actor_id CHAR(26) NULL,
+			actor_role VARCHAR(20) NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+			INDEX observation_id_created_at_idx (observation_id, created_at),
+			FOREIGN KEY (observation_id) REFERENCES observations(id) ON DELETE CASCADE
+		);
+    `).Error
+}
+
+func RollbackAddObservationStatusHistory(tx *gorm.DB) error {
+	if err := tx.Exec("DROP TABLE observation_status_histories;").Error; err != nil {
+		return err
+	}
+
+	if err := tx.Exec(`
+        UPDATE observations
+		SET status = CASE
+			WHEN status IN ('InProgress', 'Rescheduled') THEN 'Scheduled'
+			WHEN status IN ('Cancelled', 'NoShow') THEN 'Pending'
+			ELSE status
+		END;
+    `).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`
+        ALTER TABLE observations
+			MODIFY COLUMN status ENUM('Pending', 'Scheduled', 'Complete') NOT NULL DEFAULT 'Pending';
+    `).Error
+}
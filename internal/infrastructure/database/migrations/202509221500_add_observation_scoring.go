@@ -0,0 +1,81 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateAddObservationScoring(tx *gorm.DB) error {
+	if err := tx.Exec(`
+        ALTER TABLE observations
+			ADD COLUMN suggested_risk_level ENUM('Rendah', 'Sedang', 'Tinggi') NULL AFTER recommendation,
+			ADD COLUMN suggested_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NULL AFTER suggested_risk_level,
+			ADD COLUMN risk_level ENUM('Rendah', 'Sedang', 'Tinggi') NULL AFTER suggested_section,
+			ADD COLUMN therapy_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NULL AFTER risk_level;
+    `).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Exec(`
+        CREATE TABLE scoring_thresholds (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			age_category ENUM('Balita', 'Anak-anak', 'Remaja', 'Lainnya') NOT NULL,
+			domain VARCHAR(5) NOT NULL,
+			moderate_score INTEGER NOT NULL,
+			high_score INTEGER NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			UNIQUE KEY unique_scoring_thresholds_category_domain (age_category, domain)
+		);
+    `).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Exec(`
+        CREATE TABLE observation_domain_scores (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			observation_id INTEGER NOT NULL,
+			domain VARCHAR(5) NOT NULL,
+			score INTEGER NOT NULL,
+			max_score INTEGER NOT NULL,
+			risk_level ENUM('Rendah', 'Sedang', 'Tinggi') NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+			UNIQUE KEY unique_observation_domain_scores (observation_id, domain),
+			FOREIGN KEY (observation_id) REFERENCES observations(id) ON DELETE CASCADE
+		);
+    `).Error; err != nil {
+		return err
+	}
+
+	// Start every domain at 40% / 70% of its attainable score; admins tune
+	// these afterwards.
+	return tx.Exec(`
+        INSERT INTO scoring_thresholds (age_category, domain, moderate_score, high_score)
+		SELECT age_category,
+			SUBSTRING(SUBSTRING_INDEX(question_code, '-', 1), 2) AS domain,
+			CEIL(SUM(score) * 0.4),
+			CEIL(SUM(score) * 0.7)
+		FROM observation_questions
+		WHERE is_active = TRUE
+		GROUP BY age_category, domain;
+    `).Error
+}
+
+func RollbackAddObservationScoring(tx *gorm.DB) error {
+	if err := tx.Exec("DROP TABLE observation_domain_scores;").Error; err != nil {
+		return err
+	}
+
+	if err := tx.Exec("DROP TABLE scoring_thresholds;").Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`
+        ALTER TABLE observations
+			DROP COLUMN therapy_section,
+			DROP COLUMN risk_level,
+			DROP COLUMN suggested_section,
+			DROP COLUMN suggested_risk_level;
+    `).Error
+}
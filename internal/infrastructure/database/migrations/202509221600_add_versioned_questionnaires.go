@@ -0,0 +1,150 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateAddVersionedQuestionnaires(tx *gorm.DB) error {
+	statements := []string{
+		`CREATE TABLE age_categories (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			name VARCHAR(20) NOT NULL,
+			min_age INTEGER NOT NULL,
+			max_age INTEGER NULL,
+			sort_order INTEGER NOT NULL DEFAULT 0,
+			is_active BOOLEAN DEFAULT TRUE,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			UNIQUE KEY unique_age_categories_name (name)
+		);`,
+		`INSERT INTO age_categories (name, min_age, max_age, sort_order) VALUES
+			('Balita', 0, 5, 1),
+			('Anak-anak', 6, 12, 2),
+			('Remaja', 13, 17, 3),
+			('Lainnya', 18, NULL, 4);`,
+
+		`CREATE TABLE observation_domains (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			code VARCHAR(5) NOT NULL,
+			name VARCHAR(100) NOT NULL,
+			therapy_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NOT NULL,
+			sort_order INTEGER NOT NULL DEFAULT 0,
+			is_active BOOLEAN DEFAULT TRUE,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			UNIQUE KEY unique_observation_domains_code (code)
+		);`,
+		`INSERT INTO observation_domains (code, name, therapy_section, sort_order) VALUES
+			('PE', 'Perilaku dan Emosi', 'Okupasi', 1),
+			('FM', 'Fisik dan Motorik', 'Fisio', 2),
+			('BB', 'Bahasa dan Bicara', 'Wicara', 3),
+			('KA', 'Kognitif dan Atensi', 'Paedagog', 4),
+			('S', 'Sosialisasi', 'Okupasi', 5),
+			('K', 'Kemandirian', 'Okupasi', 6);`,
+
+		`CREATE TABLE questionnaire_versions (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			version INTEGER NOT NULL,
+			name VARCHAR(100) NOT NULL,
+			status ENUM('Draft', 'Published', 'Archived') NOT NULL DEFAULT 'Draft',
+			published_at DATETIME NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			UNIQUE KEY unique_questionnaire_versions_version (version),
+			INDEX questionnaire_versions_status_idx (status)
+		);`,
+		`INSERT INTO questionnaire_versions (id, version, name, status, published_at)
+			VALUES (1, 1, 'Kuesioner Awal', 'Published', NOW());`,
+
+		// The seeded questions become version 1; their domain was only encoded
+		// in the code prefix after the age letter (e.g. "BKA-03" -> "KA").
+		`ALTER TABLE observation_questions
+			MODIFY COLUMN question_code VARCHAR(10) NOT NULL,
+			MODIFY COLUMN age_category VARCHAR(20) NOT NULL,
+			ADD COLUMN questionnaire_version_id INTEGER NULL AFTER id,
+			ADD COLUMN domain VARCHAR(5) NULL AFTER age_category,
+			DROP INDEX question_code,
+			DROP INDEX unique_question_code,
+			DROP INDEX unique_age_question_number;`,
+		`UPDATE observation_questions
+			SET questionnaire_version_id = 1,
+				domain = SUBSTRING(SUBSTRING_INDEX(question_code, '-', 1), 2);`,
+		`ALTER TABLE observation_questions
+			MODIFY COLUMN questionnaire_version_id INTEGER NOT NULL,
+			MODIFY COLUMN domain VARCHAR(5) NOT NULL,
+			ADD UNIQUE KEY unique_version_question_code (questionnaire_version_id, question_code),
+			ADD UNIQUE KEY unique_version_age_question_number (questionnaire_version_id, age_category, question_number),
+			ADD CONSTRAINT fk_questions_version FOREIGN KEY (questionnaire_version_id) REFERENCES questionnaire_versions(id) ON DELETE CASCADE,
+			ADD CONSTRAINT fk_questions_domain FOREIGN KEY (domain) REFERENCES observation_domains(code),
+			ADD CONSTRAINT fk_questions_age_category FOREIGN KEY (age_category) REFERENCES age_categories(name);`,
+
+		// Observations already started were answered against version 1; the
+		// rest are pinned when they move to InProgress.
+		`ALTER TABLE observations
+			MODIFY COLUMN age_category VARCHAR(20) NOT NULL,
+			ADD COLUMN questionnaire_version_id INTEGER NULL AFTER age_category,
+			ADD CONSTRAINT fk_observations_questionnaire_version FOREIGN KEY (questionnaire_version_id) REFERENCES questionnaire_versions(id),
+			ADD CONSTRAINT fk_observations_age_category FOREIGN KEY (age_category) REFERENCES age_categories(name);`,
+		`UPDATE observations SET questionnaire_version_id = 1 WHERE status IN ('InProgress', 'Complete');`,
+
+		`ALTER TABLE scoring_thresholds
+			MODIFY COLUMN age_category VARCHAR(20) NOT NULL,
+			ADD CONSTRAINT fk_scoring_thresholds_age_category FOREIGN KEY (age_category) REFERENCES age_categories(name),
+			ADD CONSTRAINT fk_scoring_thresholds_domain FOREIGN KEY (domain) REFERENCES observation_domains(code);`,
+		`ALTER TABLE therapist_working_hours
+			MODIFY COLUMN age_category VARCHAR(20) NULL,
+			ADD CONSTRAINT fk_working_hours_age_category FOREIGN KEY (age_category) REFERENCES age_categories(name);`,
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func RollbackAddVersionedQuestionnaires(tx *gorm.DB) error {
+	statements := []string{
+		`ALTER TABLE therapist_working_hours
+			DROP FOREIGN KEY fk_working_hours_age_category,
+			MODIFY COLUMN age_category ENUM('Balita', 'Anak-anak', 'Remaja', 'Lainnya') NULL;`,
+		`ALTER TABLE scoring_thresholds
+			DROP FOREIGN KEY fk_scoring_thresholds_domain,
+			DROP FOREIGN KEY fk_scoring_thresholds_age_category,
+			MODIFY COLUMN age_category ENUM('Balita', 'Anak-anak', 'Remaja', 'Lainnya') NOT NULL;`,
+		`ALTER TABLE observations
+			DROP FOREIGN KEY fk_observations_age_category,
+			DROP FOREIGN KEY fk_observations_questionnaire_version,
+			DROP COLUMN questionnaire_version_id,
+			MODIFY COLUMN age_category ENUM('Balita', 'Anak-anak', 'Remaja', 'Lainnya') NOT NULL;`,
+		`DELETE FROM observation_questions WHERE questionnaire_version_id <> 1;`,
+		`ALTER TABLE observation_questions
+			DROP FOREIGN KEY fk_questions_age_category,
+			DROP FOREIGN KEY fk_questions_domain,
+			DROP FOREIGN KEY fk_questions_version,
+			DROP INDEX unique_version_age_question_number,
+			DROP INDEX unique_version_question_code,
+			DROP COLUMN domain,
+			DROP COLUMN questionnaire_version_id,
+			MODIFY COLUMN question_code VARCHAR(6) NOT NULL,
+			MODIFY COLUMN age_category ENUM('Balita', 'Anak-anak', 'Remaja', 'Lainya') NOT NULL,
+			ADD UNIQUE KEY unique_age_question_number (age_category, question_number),
+			ADD UNIQUE KEY unique_question_code (question_code);`,
+		"DROP TABLE questionnaire_versions;",
+		"DROP TABLE observation_domains;",
+		"DROP TABLE age_categories;",
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
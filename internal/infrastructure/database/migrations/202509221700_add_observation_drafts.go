@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateAddObservationDrafts(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE observations
+			ADD COLUMN draft_saved_at DATETIME NULL AFTER therapy_section;
+	`).Error
+}
+
+func RollbackAddObservationDrafts(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE observations
+			DROP COLUMN draft_saved_at;
+	`).Error
+}
@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateAddObservationRevisions(tx *gorm.DB) error {
+	if err := tx.Exec(`
+		ALTER TABLE observations
+			MODIFY COLUMN status ENUM('Pending', 'Scheduled', 'InProgress', 'Complete', 'Cancelled', 'NoShow', 'Rescheduled', 'Retracted') NOT NULL DEFAULT 'Pending';
+	`).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`
+		CREATE TABLE observation_revisions (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			observation_id INTEGER NOT NULL,
+			revision INTEGER NOT NULL,
+			total_score INTEGER NOT NULL DEFAULT 0,
+			conclusion TEXT NULL,
+			recommendation TEXT NULL,
+			suggested_risk_level ENUM('Rendah', 'Sedang', 'Tinggi') NULL,
+			suggested_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NULL,
+			risk_level ENUM('Rendah', 'Sedang', 'Tinggi') NULL,
+			therapy_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NULL,
+			answers JSON NOT NULL,
+			domain_scores JSON NOT NULL,
+			reason TEXT NOT NULL,
+			actor_id CHAR(26) NULL,
+			actor_role VARCHAR(20) NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+			UNIQUE KEY unique_observation_revision (observation_id, revision),
+			FOREIGN KEY (observation_id) REFERENCES observations(id) ON DELETE CASCADE
+		);
+	`).Error
+}
+
+func RollbackAddObservationRevisions(tx *gorm.DB) error {
+	if err := tx.Exec("DROP TABLE observation_revisions;").Error; err != nil {
+		return err
+	}
+
+	if err := tx.Exec(`
+		UPDATE observations SET status = 'Complete' WHERE status = 'Retracted';
+	`).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`
+		ALTER TABLE observations
+			MODIFY COLUMN status ENUM('Pending', 'Scheduled', 'InProgress', 'Complete', 'Cancelled', 'NoShow', 'Rescheduled') NOT NULL DEFAULT 'Pending';
+	`).Error
+}
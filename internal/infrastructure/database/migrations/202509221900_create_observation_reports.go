@@ -0,0 +1,26 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateCreateObservationReports(tx *gorm.DB) error {
+	return tx.Exec(`
+		CREATE TABLE observation_reports (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			observation_id INTEGER NOT NULL,
+			file_name VARCHAR(150) NOT NULL,
+			content MEDIUMBLOB NOT NULL,
+			checksum CHAR(64) NOT NULL,
+			source_updated_at DATETIME NOT NULL,
+			generated_at DATETIME NOT NULL,
+
+			UNIQUE KEY unique_observation_report (observation_id),
+			FOREIGN KEY (observation_id) REFERENCES observations(id) ON DELETE CASCADE
+		);
+	`).Error
+}
+
+func RollbackCreateObservationReports(tx *gorm.DB) error {
+	return tx.Exec("DROP TABLE observation_reports;").Error
+}
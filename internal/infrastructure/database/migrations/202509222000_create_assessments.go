@@ -0,0 +1,119 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateCreateAssessments(tx *gorm.DB) error {
+	statements := []string{
+		`CREATE TABLE assessment_instruments (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			code VARCHAR(10) NOT NULL,
+			name VARCHAR(100) NOT NULL,
+			therapy_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NOT NULL,
+			description TEXT NULL,
+			sort_order INTEGER NOT NULL DEFAULT 0,
+			is_active BOOLEAN DEFAULT TRUE,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			UNIQUE KEY unique_assessment_instruments_code (code),
+			INDEX assessment_instruments_section_idx (therapy_section)
+		);`,
+		`CREATE TABLE assessment_instrument_items (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			instrument_id INTEGER NOT NULL,
+			item_number INTEGER NOT NULL,
+			item_text TEXT NOT NULL,
+			max_score INTEGER NOT NULL,
+
+			UNIQUE KEY unique_instrument_item_number (instrument_id, item_number),
+			FOREIGN KEY (instrument_id) REFERENCES assessment_instruments(id) ON DELETE CASCADE
+		);`,
+		`CREATE TABLE assessments (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			observation_id INTEGER NOT NULL,
+			child_id CHAR(26) NOT NULL,
+			therapy_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NOT NULL,
+			assessor_id CHAR(26) NOT NULL,
+			scheduled_date DATE NOT NULL,
+			status ENUM('Scheduled', 'InProgress', 'Completed', 'Cancelled') NOT NULL DEFAULT 'Scheduled',
+			summary TEXT NULL,
+			recommendation TEXT NULL,
+			sessions_per_week INTEGER NULL,
+			cancel_reason VARCHAR(500) NULL,
+			completed_at DATETIME NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			INDEX assessments_observation_section_idx (observation_id, therapy_section),
+			INDEX assessments_assessor_status_idx (assessor_id, status),
+			INDEX assessments_child_idx (child_id),
+			FOREIGN KEY (observation_id) REFERENCES observations(id) ON DELETE CASCADE,
+			FOREIGN KEY (child_id) REFERENCES childrens(id) ON DELETE CASCADE,
+			FOREIGN KEY (assessor_id) REFERENCES therapists(id)
+		);`,
+		`CREATE TABLE assessment_scores (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			assessment_id INTEGER NOT NULL,
+			item_id INTEGER NOT NULL,
+			score INTEGER NOT NULL,
+			note VARCHAR(500) NULL,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			UNIQUE KEY unique_assessment_item (assessment_id, item_id),
+			FOREIGN KEY (assessment_id) REFERENCES assessments(id) ON DELETE CASCADE,
+			FOREIGN KEY (item_id) REFERENCES assessment_instrument_items(id)
+		);`,
+		`CREATE TABLE care_plans (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			child_id CHAR(26) NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			UNIQUE KEY unique_care_plans_child (child_id),
+			FOREIGN KEY (child_id) REFERENCES childrens(id) ON DELETE CASCADE
+		);`,
+		`CREATE TABLE care_plan_entries (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			care_plan_id INTEGER NOT NULL,
+			therapy_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NOT NULL,
+			assessment_id INTEGER NULL,
+			summary TEXT NOT NULL,
+			recommendation TEXT NOT NULL,
+			sessions_per_week INTEGER NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+			UNIQUE KEY unique_care_plan_entries_assessment (assessment_id),
+			FOREIGN KEY (care_plan_id) REFERENCES care_plans(id) ON DELETE CASCADE,
+			FOREIGN KEY (assessment_id) REFERENCES assessments(id) ON DELETE SET NULL
+		);`,
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func RollbackCreateAssessments(tx *gorm.DB) error {
+	statements := []string{
+		"DROP TABLE care_plan_entries;",
+		"DROP TABLE care_plans;",
+		"DROP TABLE assessment_scores;",
+		"DROP TABLE assessments;",
+		"DROP TABLE assessment_instrument_items;",
+		"DROP TABLE assessment_instruments;",
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateCreateCaseConferences(tx *gorm.DB) error {
+	statements := []string{
+		`CREATE TABLE case_conferences (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			child_id CHAR(26) NOT NULL,
+			scheduled_at DATETIME NOT NULL,
+			location VARCHAR(255) NULL,
+			status ENUM('Scheduled', 'Held', 'Cancelled') NOT NULL DEFAULT 'Scheduled',
+			agenda TEXT NULL,
+			decisions TEXT NULL,
+			parent_consent ENUM('Pending', 'Agreed', 'Partial', 'Declined') NOT NULL DEFAULT 'Pending',
+			consent_note VARCHAR(1000) NULL,
+			consent_recorded_at DATETIME NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			INDEX case_conferences_child_idx (child_id),
+			INDEX case_conferences_status_idx (status),
+			FOREIGN KEY (child_id) REFERENCES childrens(id) ON DELETE CASCADE
+		);`,
+		`CREATE TABLE case_conference_participants (
+			conference_id INTEGER NOT NULL,
+			therapist_id CHAR(26) NOT NULL,
+
+			PRIMARY KEY (conference_id, therapist_id),
+			INDEX case_conference_participants_therapist_idx (therapist_id),
+			FOREIGN KEY (conference_id) REFERENCES case_conferences(id) ON DELETE CASCADE,
+			FOREIGN KEY (therapist_id) REFERENCES therapists(id)
+		);`,
+		`CREATE TABLE case_conference_sections (
+			conference_id INTEGER NOT NULL,
+			therapy_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NOT NULL,
+			sessions_per_week INTEGER NOT NULL,
+
+			PRIMARY KEY (conference_id, therapy_section),
+			FOREIGN KEY (conference_id) REFERENCES case_conferences(id) ON DELETE CASCADE
+		);`,
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func RollbackCreateCaseConferences(tx *gorm.DB) error {
+	statements := []string{
+		"DROP TABLE case_conference_sections;",
+		"DROP TABLE case_conference_participants;",
+		"DROP TABLE case_conferences;",
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
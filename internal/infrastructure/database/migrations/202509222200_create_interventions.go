@@ -0,0 +1,94 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateCreateInterventions(tx *gorm.DB) error {
+	statements := []string{
+		`CREATE TABLE intervention_plans (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			child_id CHAR(26) NOT NULL,
+			therapist_id CHAR(26) NOT NULL,
+			therapy_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NOT NULL,
+			start_date DATE NOT NULL,
+			end_date DATE NULL,
+			session_minutes SMALLINT NOT NULL,
+			is_active BOOLEAN DEFAULT TRUE,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			INDEX intervention_plans_child_section_idx (child_id, therapy_section),
+			INDEX intervention_plans_therapist_idx (therapist_id, is_active),
+			FOREIGN KEY (child_id) REFERENCES childrens(id) ON DELETE CASCADE,
+			FOREIGN KEY (therapist_id) REFERENCES therapists(id)
+		);`,
+		`CREATE TABLE intervention_plan_slots (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			plan_id INTEGER NOT NULL,
+			day_of_week TINYINT NOT NULL,
+			start_time TIME NOT NULL,
+
+			UNIQUE KEY unique_plan_slot (plan_id, day_of_week, start_time),
+			FOREIGN KEY (plan_id) REFERENCES intervention_plans(id) ON DELETE CASCADE
+		);`,
+		`CREATE TABLE intervention_sessions (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			plan_id INTEGER NOT NULL,
+			child_id CHAR(26) NOT NULL,
+			therapist_id CHAR(26) NOT NULL,
+			therapy_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NOT NULL,
+			session_date DATE NOT NULL,
+			start_time TIME NOT NULL,
+			status ENUM('Attended', 'Cancelled', 'NoShow') NOT NULL,
+			cancel_reason VARCHAR(500) NULL,
+			subjective TEXT NULL,
+			objective TEXT NULL,
+			assessment TEXT NULL,
+			plan TEXT NULL,
+			recorded_at DATETIME NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			UNIQUE KEY unique_plan_occurrence (plan_id, session_date, start_time),
+			INDEX intervention_sessions_child_idx (child_id, session_date),
+			INDEX intervention_sessions_therapist_idx (therapist_id, session_date),
+			FOREIGN KEY (plan_id) REFERENCES intervention_plans(id) ON DELETE CASCADE,
+			FOREIGN KEY (child_id) REFERENCES childrens(id) ON DELETE CASCADE,
+			FOREIGN KEY (therapist_id) REFERENCES therapists(id)
+		);`,
+		`CREATE TABLE intervention_session_goals (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			session_id INTEGER NOT NULL,
+			description VARCHAR(500) NOT NULL,
+			note VARCHAR(1000) NULL,
+
+			FOREIGN KEY (session_id) REFERENCES intervention_sessions(id) ON DELETE CASCADE
+		);`,
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func RollbackCreateInterventions(tx *gorm.DB) error {
+	statements := []string{
+		"DROP TABLE intervention_session_goals;",
+		"DROP TABLE intervention_sessions;",
+		"DROP TABLE intervention_plan_slots;",
+		"DROP TABLE intervention_plans;",
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateCreateCarePlanGoals(tx *gorm.DB) error {
+	statements := []string{
+		`CREATE TABLE care_plan_goals (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			care_plan_id INTEGER NOT NULL,
+			therapy_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NOT NULL,
+			description VARCHAR(500) NOT NULL,
+			baseline DECIMAL(10,2) NOT NULL,
+			target DECIMAL(10,2) NOT NULL,
+			measurement_criteria VARCHAR(1000) NOT NULL,
+			review_date DATE NOT NULL,
+			status ENUM('Active', 'Achieved', 'Discontinued') DEFAULT 'Active',
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			INDEX care_plan_goals_section_idx (care_plan_id, therapy_section),
+			FOREIGN KEY (care_plan_id) REFERENCES care_plans(id) ON DELETE CASCADE
+		);`,
+		`CREATE TABLE care_plan_goal_measurements (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			goal_id INTEGER NOT NULL,
+			session_id INTEGER NULL,
+			therapist_id CHAR(26) NOT NULL,
+			value DECIMAL(10,2) NOT NULL,
+			note VARCHAR(1000) NULL,
+			measured_on DATE NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+			INDEX care_plan_goal_measurements_goal_idx (goal_id, measured_on),
+			FOREIGN KEY (goal_id) REFERENCES care_plan_goals(id) ON DELETE CASCADE,
+			FOREIGN KEY (session_id) REFERENCES intervention_sessions(id) ON DELETE CASCADE,
+			FOREIGN KEY (therapist_id) REFERENCES therapists(id)
+		);`,
+		`ALTER TABLE intervention_session_goals
+			ADD COLUMN care_plan_goal_id INTEGER NULL AFTER session_id,
+			ADD CONSTRAINT fk_session_goals_care_plan_goal FOREIGN KEY (care_plan_goal_id) REFERENCES care_plan_goals(id) ON DELETE SET NULL;`,
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func RollbackCreateCarePlanGoals(tx *gorm.DB) error {
+	statements := []string{
+		`ALTER TABLE intervention_session_goals
+			DROP FOREIGN KEY fk_session_goals_care_plan_goal,
+			DROP COLUMN care_plan_goal_id;`,
+		"DROP TABLE care_plan_goal_measurements;",
+		"DROP TABLE care_plan_goals;",
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+func MigrateCreateEvaluations(tx *gorm.DB) error {
+	statements := []string{
+		`ALTER TABLE care_plans
+			ADD COLUMN programme_status ENUM('Active', 'Paused', 'Graduated', 'Discharged') NOT NULL DEFAULT 'Active' AFTER child_id,
+			ADD COLUMN next_evaluation_date DATE NULL AFTER programme_status,
+			ADD COLUMN last_evaluated_on DATE NULL AFTER next_evaluation_date,
+			ADD INDEX care_plans_next_evaluation_idx (programme_status, next_evaluation_date);`,
+		`CREATE TABLE evaluations (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			child_id CHAR(26) NOT NULL,
+			evaluation_date DATE NOT NULL,
+			summary TEXT NOT NULL,
+			decision ENUM('Continue', 'Change', 'End') NOT NULL,
+			programme_status ENUM('Active', 'Paused', 'Graduated', 'Discharged') NOT NULL,
+			next_evaluation_date DATE NULL,
+			observation_id INTEGER NULL,
+			recorded_by CHAR(26) NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+			INDEX evaluations_child_idx (child_id, evaluation_date),
+			FOREIGN KEY (child_id) REFERENCES childrens(id) ON DELETE CASCADE,
+			FOREIGN KEY (observation_id) REFERENCES observations(id) ON DELETE SET NULL,
+			FOREIGN KEY (recorded_by) REFERENCES users(id)
+		);`,
+		`CREATE TABLE evaluation_goals (
+			evaluation_id INTEGER NOT NULL,
+			goal_id INTEGER NOT NULL,
+			therapy_section ENUM('Okupasi', 'Fisio', 'Wicara', 'Paedagog') NOT NULL,
+			description VARCHAR(500) NOT NULL,
+			value DECIMAL(10,2) NULL,
+			attainment DECIMAL(5,1) NOT NULL,
+			status ENUM('Active', 'Achieved', 'Discontinued') NOT NULL,
+			note VARCHAR(1000) NULL,
+
+			PRIMARY KEY (evaluation_id, goal_id),
+			FOREIGN KEY (evaluation_id) REFERENCES evaluations(id) ON DELETE CASCADE,
+			FOREIGN KEY (goal_id) REFERENCES care_plan_goals(id) ON DELETE CASCADE
+		);`,
+		`ALTER TABLE care_plan_goal_measurements
+			MODIFY therapist_id CHAR(26) NULL,
+			ADD COLUMN evaluation_id INTEGER NULL AFTER session_id,
+			ADD CONSTRAINT fk_goal_measurements_evaluation FOREIGN KEY (evaluation_id) REFERENCES evaluations(id) ON DELETE CASCADE;`,
+		// Children already in intervention are due one interval after their
+		// first plan started.
+		`INSERT INTO care_plans (child_id, next_evaluation_date)
+			SELECT child_id, DATE_ADD(MIN(start_date), INTERVAL 3 MONTH)
+			FROM intervention_plans
+			GROUP BY child_id
+			ON DUPLICATE KEY UPDATE next_evaluation_date = COALESCE(care_plans.next_evaluation_date, VALUES(next_evaluation_date));`,
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func RollbackCreateEvaluations(tx *gorm.DB) error {
+	statements := []string{
+		`ALTER TABLE care_plan_goal_measurements
+			DROP FOREIGN KEY fk_goal_measurements_evaluation,
+			DROP COLUMN evaluation_id;`,
+		"DROP TABLE evaluation_goals;",
+		"DROP TABLE evaluations;",
+		`ALTER TABLE care_plans
+			DROP INDEX care_plans_next_evaluation_idx,
+			DROP COLUMN last_evaluated_on,
+			DROP COLUMN next_evaluation_date,
+			DROP COLUMN programme_status;`,
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
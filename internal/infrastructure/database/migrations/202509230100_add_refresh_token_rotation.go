@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// MigrateAddRefreshTokenRotation groups refresh tokens into families, one per
+// login, and links each rotated token to the one that replaced it. Tokens
+// issued before this migration each become a family of their own.
+func MigrateAddRefreshTokenRotation(tx *gorm.DB) error {
+	statements := []string{
+		`ALTER TABLE refresh_tokens
+			MODIFY COLUMN token CHAR(64) NOT NULL,
+			ADD COLUMN family_id CHAR(26) NULL AFTER token,
+			ADD COLUMN replaced_by_id INTEGER NULL AFTER family_id;`,
+		"UPDATE refresh_tokens SET family_id = LPAD(id, 26, '0') WHERE family_id IS NULL;",
+		`ALTER TABLE refresh_tokens
+			MODIFY COLUMN family_id CHAR(26) NOT NULL,
+			ADD UNIQUE KEY refresh_tokens_token_unique (token),
+			ADD INDEX refresh_tokens_family_idx (user_id, family_id),
+			ADD CONSTRAINT fk_refresh_tokens_replaced_by FOREIGN KEY (replaced_by_id) REFERENCES refresh_tokens (id) ON DELETE SET NULL;`,
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func RollbackAddRefreshTokenRotation(tx *gorm.DB) error {
+	return tx.Exec(`
+        ALTER TABLE refresh_tokens
+			DROP FOREIGN KEY fk_refresh_tokens_replaced_by,
+			DROP INDEX refresh_tokens_family_idx,
+			DROP INDEX refresh_tokens_token_unique,
+			DROP COLUMN replaced_by_id,
+			DROP COLUMN family_id,
+			MODIFY COLUMN token TEXT NOT NULL;
+    `).Error
+}
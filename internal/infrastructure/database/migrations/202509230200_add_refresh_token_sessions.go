@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// MigrateAddRefreshTokenSessions records where each login came from. The
+// values are carried from token to token within a family, which is what the
+// user sees as one session.
+func MigrateAddRefreshTokenSessions(tx *gorm.DB) error {
+	statements := []string{
+		`ALTER TABLE refresh_tokens
+			ADD COLUMN user_agent VARCHAR(255) NOT NULL DEFAULT '' AFTER replaced_by_id,
+			ADD COLUMN ip_address VARCHAR(45) NOT NULL DEFAULT '' AFTER user_agent,
+			ADD COLUMN signed_in_at DATETIME NULL AFTER ip_address,
+			ADD COLUMN last_used_at DATETIME NULL AFTER signed_in_at,
+			ADD INDEX refresh_tokens_active_idx (user_id, revoked, expires_at);`,
+		"UPDATE refresh_tokens SET signed_in_at = created_at, last_used_at = created_at;",
+		`ALTER TABLE refresh_tokens
+			MODIFY COLUMN signed_in_at DATETIME NOT NULL,
+			MODIFY COLUMN last_used_at DATETIME NOT NULL;`,
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func RollbackAddRefreshTokenSessions(tx *gorm.DB) error {
+	return tx.Exec(`
+        ALTER TABLE refresh_tokens
+			DROP INDEX refresh_tokens_active_idx,
+			DROP COLUMN last_used_at,
+			DROP COLUMN signed_in_at,
+			DROP COLUMN ip_address,
+			DROP COLUMN user_agent;
+    `).Error
+}
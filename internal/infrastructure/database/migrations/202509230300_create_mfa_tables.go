@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// MigrateCreateMfaTables adds TOTP two-factor authentication. An enrollment
+// stays pending, with enabled_at empty, until the user confirms a code.
+func MigrateCreateMfaTables(tx *gorm.DB) error {
+	statements := []string{
+		`CREATE TABLE mfa_enrollments (
+			user_id CHAR(26) PRIMARY KEY,
+			secret VARBINARY(255) NOT NULL,
+			enabled_at DATETIME NULL,
+			last_used_step BIGINT NOT NULL DEFAULT 0,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+			FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+		);`,
+		`CREATE TABLE mfa_recovery_codes (
+			id INTEGER PRIMARY KEY AUTO_INCREMENT,
+			user_id CHAR(26) NOT NULL,
+			code_hash CHAR(64) NOT NULL,
+			used_at DATETIME NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+			UNIQUE INDEX mfa_recovery_codes_user_code_idx (user_id, code_hash),
+			FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+		);`,
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func RollbackCreateMfaTables(tx *gorm.DB) error {
+	statements := []string{
+		"DROP TABLE mfa_recovery_codes;",
+		"DROP TABLE mfa_enrollments;",
+	}
+
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// MigrateAddObservationReportSourceChecksum keys stored reports on what they
+// print rather than on the observation alone. Existing reports start without
+// a checksum, so each is rendered again on its next download.
+func MigrateAddObservationReportSourceChecksum(tx *gorm.DB) error {
+	return tx.Exec(`
+        ALTER TABLE observation_reports
+			ADD COLUMN source_checksum CHAR(64) NOT NULL DEFAULT '' AFTER source_updated_at;
+    `).Error
+}
+
+func RollbackAddObservationReportSourceChecksum(tx *gorm.DB) error {
+	return tx.Exec(`
+        ALTER TABLE observation_reports
+			DROP COLUMN source_checksum;
+    `).Error
+}
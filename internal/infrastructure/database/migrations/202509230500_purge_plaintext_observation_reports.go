@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// MigratePurgePlaintextObservationReports drops reports stored before their
+// content was encrypted, since they print decrypted guardian details. Each is
+// rendered again, encrypted, on its next download.
+func MigratePurgePlaintextObservationReports(tx *gorm.DB) error {
+	return tx.Exec(`
+        DELETE FROM observation_reports;
+    `).Error
+}
+
+func RollbackPurgePlaintextObservationReports(tx *gorm.DB) error {
+	return nil
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"backend-golang/internal/helpers"
+	"time"
+)
+
+type AssessmentInstrument struct {
+	Id             int       `gorm:"primary_key;type:integer;auto_increment"`
+	Code           string    `gorm:"type:varchar(10);not null;unique"`
+	Name           string    `gorm:"type:varchar(100);not null"`
+	TherapySection string    `gorm:"type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');not null;index"`
+	Description    string    `gorm:"type:text;null"`
+	SortOrder      int       `gorm:"type:integer;not null;default:0"`
+	IsActive       bool      `gorm:"type:bool;default:true"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+
+	Items []AssessmentInstrumentItem `gorm:"foreignKey:InstrumentId;constraint:OnDelete:CASCADE;"`
+}
+
+type AssessmentInstrumentItem struct {
+	Id           int    `gorm:"primary_key;type:integer;auto_increment"`
+	InstrumentId int    `gorm:"type:integer;not null"`
+	ItemNumber   int    `gorm:"type:integer;not null"`
+	ItemText     string `gorm:"type:text;not null"`
+	MaxScore     int    `gorm:"type:integer;not null"`
+}
+
+type Assessment struct {
+	Id              int              `gorm:"primary_key;type:integer;auto_increment"`
+	ObservationId   int              `gorm:"type:integer;not null;index"`
+	ChildId         string           `gorm:"type:char(26);not null;index"`
+	TherapySection  string           `gorm:"type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');not null"`
+	AssessorId      string           `gorm:"type:char(26);not null;index"`
+	ScheduledDate   helpers.DateOnly `gorm:"type:date;not null"`
+	Status          string           `gorm:"type:enum('Scheduled', 'InProgress', 'Completed', 'Cancelled');default:'Scheduled';not null;index"`
+	Summary         string           `gorm:"type:text;null"`
+	Recommendation  string           `gorm:"type:text;null"`
+	SessionsPerWeek *int             `gorm:"type:integer;null"`
+	CancelReason    string           `gorm:"type:varchar(500);null"`
+	CompletedAt     *time.Time       `gorm:"type:datetime;null"`
+	CreatedAt       time.Time        `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time        `gorm:"autoUpdateTime"`
+
+	Children    *Children    `gorm:"foreignKey:ChildId;constraint:OnDelete:CASCADE;"`
+	Assessor    *Therapist   `gorm:"foreignKey:AssessorId"`
+	Observation *Observation `gorm:"foreignKey:ObservationId;constraint:OnDelete:CASCADE;"`
+}
+
+type AssessmentScore struct {
+	Id           int       `gorm:"primary_key;type:integer;auto_increment"`
+	AssessmentId int       `gorm:"type:integer;not null;uniqueIndex:unique_assessment_item"`
+	ItemId       int       `gorm:"type:integer;not null;uniqueIndex:unique_assessment_item"`
+	Score        int       `gorm:"type:integer;not null"`
+	Note         *string   `gorm:"type:varchar(500);null"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+}
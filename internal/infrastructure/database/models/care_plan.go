@@ -0,0 +1,58 @@
+package models
+
+import (
+	"backend-golang/internal/helpers"
+	"time"
+)
+
+type CarePlan struct {
+	Id                 int               `gorm:"primary_key;type:integer;auto_increment"`
+	ChildId            string            `gorm:"type:char(26);not null;unique"`
+	ProgrammeStatus    string            `gorm:"type:enum('Active', 'Paused', 'Graduated', 'Discharged');default:'Active'"`
+	NextEvaluationDate *helpers.DateOnly `gorm:"type:date;null"`
+	LastEvaluatedOn    *helpers.DateOnly `gorm:"type:date;null"`
+	CreatedAt          time.Time         `gorm:"autoCreateTime"`
+	UpdatedAt          time.Time         `gorm:"autoUpdateTime"`
+
+	Children *Children       `gorm:"foreignKey:ChildId"`
+	Entries  []CarePlanEntry `gorm:"foreignKey:CarePlanId;constraint:OnDelete:CASCADE;"`
+}
+
+type CarePlanEntry struct {
+	Id              int       `gorm:"primary_key;type:integer;auto_increment"`
+	CarePlanId      int       `gorm:"type:integer;not null;index"`
+	TherapySection  string    `gorm:"type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');not null"`
+	AssessmentId    *int      `gorm:"type:integer;null;unique"`
+	Summary         string    `gorm:"type:text;not null"`
+	Recommendation  string    `gorm:"type:text;not null"`
+	SessionsPerWeek *int      `gorm:"type:integer;null"`
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+}
+
+type CarePlanGoal struct {
+	Id                  int              `gorm:"primary_key;type:integer;auto_increment"`
+	CarePlanId          int              `gorm:"type:integer;not null;index"`
+	TherapySection      string           `gorm:"type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');not null"`
+	Description         string           `gorm:"type:varchar(500);not null"`
+	Baseline            float64          `gorm:"type:decimal(10,2);not null"`
+	Target              float64          `gorm:"type:decimal(10,2);not null"`
+	MeasurementCriteria string           `gorm:"type:varchar(1000);not null"`
+	ReviewDate          helpers.DateOnly `gorm:"type:date;not null"`
+	Status              string           `gorm:"type:enum('Active', 'Achieved', 'Discontinued');default:'Active'"`
+	CreatedAt           time.Time        `gorm:"autoCreateTime"`
+	UpdatedAt           time.Time        `gorm:"autoUpdateTime"`
+
+	CarePlan *CarePlan `gorm:"foreignKey:CarePlanId"`
+}
+
+type CarePlanGoalMeasurement struct {
+	Id           int              `gorm:"primary_key;type:integer;auto_increment"`
+	GoalId       int              `gorm:"type:integer;not null;index"`
+	SessionId    *int             `gorm:"type:integer;null;index"`
+	EvaluationId *int             `gorm:"type:integer;null;index"`
+	TherapistId  *string          `gorm:"type:char(26);null"`
+	Value        float64          `gorm:"type:decimal(10,2);not null"`
+	Note         string           `gorm:"type:varchar(1000);null"`
+	MeasuredOn   helpers.DateOnly `gorm:"type:date;not null"`
+	CreatedAt    time.Time        `gorm:"autoCreateTime"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+type CaseConference struct {
+	Id                int        `gorm:"primary_key;type:integer;auto_increment"`
+	ChildId           string     `gorm:"type:char(26);not null;index"`
+	ScheduledAt       time.Time  `gorm:"type:datetime;not null"`
+	Location          string     `gorm:"type:varchar(255);null"`
+	Status            string     `gorm:"type:enum('Scheduled', 'Held', 'Cancelled');default:'Scheduled';not null;index"`
+	Agenda            string     `gorm:"type:text;null"`
+	Decisions         string     `gorm:"type:text;null"`
+	ParentConsent     string     `gorm:"type:enum('Pending', 'Agreed', 'Partial', 'Declined');default:'Pending';not null"`
+	ConsentNote       string     `gorm:"type:varchar(1000);null"`
+	ConsentRecordedAt *time.Time `gorm:"type:datetime;null"`
+	CreatedAt         time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt         time.Time  `gorm:"autoUpdateTime"`
+
+	Children     *Children                   `gorm:"foreignKey:ChildId;constraint:OnDelete:CASCADE;"`
+	Participants []CaseConferenceParticipant `gorm:"foreignKey:ConferenceId;constraint:OnDelete:CASCADE;"`
+	Sections     []CaseConferenceSection     `gorm:"foreignKey:ConferenceId;constraint:OnDelete:CASCADE;"`
+}
+
+type CaseConferenceParticipant struct {
+	ConferenceId int    `gorm:"primaryKey;autoIncrement:false;type:integer;not null"`
+	TherapistId  string `gorm:"primaryKey;type:char(26);not null;index"`
+
+	Therapist *Therapist `gorm:"foreignKey:TherapistId"`
+}
+
+type CaseConferenceSection struct {
+	ConferenceId    int    `gorm:"primaryKey;autoIncrement:false;type:integer;not null"`
+	TherapySection  string `gorm:"primaryKey;type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');not null"`
+	SessionsPerWeek int    `gorm:"type:integer;not null"`
+}
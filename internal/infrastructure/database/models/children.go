@@ -13,6 +13,7 @@ type Children struct {
 	ChildBirthPlace    string           `gorm:"type:varchar(100);not null"`
 	ChildBirthDate     helpers.DateOnly `gorm:"type:date;not null"`
 	ChildAddress       []byte           `gorm:"type:varbinary(500);not null"`
+	ChildAddressIndex  string           `gorm:"type:char(64);null;index"`
 	ChildComplaint     string           `gorm:"type:text;not null"`
 	ChildSchool        *string          `gorm:"type:varchar(100);null"`
 	ChildServiceChoice string           `gorm:"type:varchar(250);not null"`
@@ -20,6 +21,6 @@ type Children struct {
 	CreatedAt          time.Time        `gorm:"autoCreateTime"`
 	UpdatedAt          time.Time        `gorm:"autoUpdateTime"`
 
-	Parent      *Parent      `gorm:"foreignKey:ParentId;constraint:OnDelete:CASCADE;"`
-	Observation *Observation `gorm:"foreignKey:ChildId;constraint:OnDelete:CASCADE;"`
+	Parent       *Parent       `gorm:"foreignKey:ParentId;constraint:OnDelete:CASCADE;"`
+	Observations []Observation `gorm:"foreignKey:ChildId;constraint:OnDelete:CASCADE;"`
 }
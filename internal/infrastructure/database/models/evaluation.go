@@ -0,0 +1,33 @@
+package models
+
+import (
+	"backend-golang/internal/helpers"
+	"time"
+)
+
+type Evaluation struct {
+	Id                 int               `gorm:"primary_key;type:integer;auto_increment"`
+	ChildId            string            `gorm:"type:char(26);not null;index"`
+	EvaluationDate     helpers.DateOnly  `gorm:"type:date;not null"`
+	Summary            string            `gorm:"type:text;not null"`
+	Decision           string            `gorm:"type:enum('Continue', 'Change', 'End');not null"`
+	ProgrammeStatus    string            `gorm:"type:enum('Active', 'Paused', 'Graduated', 'Discharged');not null"`
+	NextEvaluationDate *helpers.DateOnly `gorm:"type:date;null"`
+	ObservationId      *int              `gorm:"type:integer;null"`
+	RecordedBy         string            `gorm:"type:char(26);not null"`
+	CreatedAt          time.Time         `gorm:"autoCreateTime"`
+
+	Children *Children        `gorm:"foreignKey:ChildId;constraint:OnDelete:CASCADE;"`
+	Goals    []EvaluationGoal `gorm:"foreignKey:EvaluationId;constraint:OnDelete:CASCADE;"`
+}
+
+type EvaluationGoal struct {
+	EvaluationId   int      `gorm:"primaryKey;autoIncrement:false;type:integer"`
+	GoalId         int      `gorm:"primaryKey;autoIncrement:false;type:integer"`
+	TherapySection string   `gorm:"type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');not null"`
+	Description    string   `gorm:"type:varchar(500);not null"`
+	Value          *float64 `gorm:"type:decimal(10,2);null"`
+	Attainment     float64  `gorm:"type:decimal(5,1);not null"`
+	Status         string   `gorm:"type:enum('Active', 'Achieved', 'Discontinued');not null"`
+	Note           string   `gorm:"type:varchar(1000);null"`
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"backend-golang/internal/helpers"
+	"time"
+)
+
+type InterventionPlan struct {
+	Id             int               `gorm:"primary_key;type:integer;auto_increment"`
+	ChildId        string            `gorm:"type:char(26);not null;index"`
+	TherapistId    string            `gorm:"type:char(26);not null;index"`
+	TherapySection string            `gorm:"type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');not null"`
+	StartDate      helpers.DateOnly  `gorm:"type:date;not null"`
+	EndDate        *helpers.DateOnly `gorm:"type:date;null"`
+	SessionMinutes int               `gorm:"type:smallint;not null"`
+	IsActive       bool              `gorm:"type:bool;default:true"`
+	CreatedAt      time.Time         `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time         `gorm:"autoUpdateTime"`
+
+	Children  *Children              `gorm:"foreignKey:ChildId;constraint:OnDelete:CASCADE;"`
+	Therapist *Therapist             `gorm:"foreignKey:TherapistId"`
+	Slots     []InterventionPlanSlot `gorm:"foreignKey:PlanId;constraint:OnDelete:CASCADE;"`
+}
+
+type InterventionPlanSlot struct {
+	Id        int    `gorm:"primary_key;type:integer;auto_increment"`
+	PlanId    int    `gorm:"type:integer;not null;uniqueIndex:unique_plan_slot"`
+	DayOfWeek int    `gorm:"type:tinyint;not null;uniqueIndex:unique_plan_slot"`
+	StartTime string `gorm:"type:time;not null;uniqueIndex:unique_plan_slot"`
+}
+
+type InterventionSession struct {
+	Id             int              `gorm:"primary_key;type:integer;auto_increment"`
+	PlanId         int              `gorm:"type:integer;not null;uniqueIndex:unique_plan_occurrence"`
+	ChildId        string           `gorm:"type:char(26);not null;index"`
+	TherapistId    string           `gorm:"type:char(26);not null;index"`
+	TherapySection string           `gorm:"type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');not null"`
+	SessionDate    helpers.DateOnly `gorm:"type:date;not null;uniqueIndex:unique_plan_occurrence"`
+	StartTime      string           `gorm:"type:time;not null;uniqueIndex:unique_plan_occurrence"`
+	Status         string           `gorm:"type:enum('Attended', 'Cancelled', 'NoShow');not null"`
+	CancelReason   string           `gorm:"type:varchar(500);null"`
+	Subjective     string           `gorm:"type:text;null"`
+	Objective      string           `gorm:"type:text;null"`
+	Assessment     string           `gorm:"type:text;null"`
+	Plan           string           `gorm:"type:text;null"`
+	RecordedAt     time.Time        `gorm:"type:datetime;not null"`
+	CreatedAt      time.Time        `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time        `gorm:"autoUpdateTime"`
+
+	Children     *Children                 `gorm:"foreignKey:ChildId;constraint:OnDelete:CASCADE;"`
+	Therapist    *Therapist                `gorm:"foreignKey:TherapistId"`
+	Goals        []InterventionSessionGoal `gorm:"foreignKey:SessionId;constraint:OnDelete:CASCADE;"`
+	Measurements []CarePlanGoalMeasurement `gorm:"foreignKey:SessionId;constraint:OnDelete:CASCADE;"`
+}
+
+type InterventionSessionGoal struct {
+	Id             int    `gorm:"primary_key;type:integer;auto_increment"`
+	SessionId      int    `gorm:"type:integer;not null;index"`
+	CarePlanGoalId *int   `gorm:"type:integer;null;index"`
+	Description    string `gorm:"type:varchar(500);not null"`
+	Note           string `gorm:"type:varchar(1000);null"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+type MfaEnrollment struct {
+	UserId       string     `gorm:"primaryKey;type:char(26)"`
+	Secret       []byte     `gorm:"type:varbinary(255);not null"`
+	EnabledAt    *time.Time `gorm:"null"`
+	LastUsedStep int64      `gorm:"not null;default:0"`
+	CreatedAt    time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time  `gorm:"autoUpdateTime"`
+
+	User *User `gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE;"`
+}
+
+type MfaRecoveryCode struct {
+	Id        int        `gorm:"primary_key;type:integer;auto_increment"`
+	UserId    string     `gorm:"type:char(26);not null;uniqueIndex:mfa_recovery_codes_user_code_idx"`
+	CodeHash  string     `gorm:"type:char(64);not null;uniqueIndex:mfa_recovery_codes_user_code_idx"`
+	UsedAt    *time.Time `gorm:"null"`
+	CreatedAt time.Time  `gorm:"autoCreateTime"`
+}
@@ -6,18 +6,29 @@ import (
 )
 
 type Observation struct {
-	Id             int              `gorm:"primary_key;type:integer;auto_increment;"`
-	ChildId        string           `gorm:"type:char(26);not null;index"`
-	TherapistId    string           `gorm:"type:char(26);not null;index"`
-	ScheduledDate  helpers.DateOnly `gorm:"type:date;not null"`
-	AgeCategory    string           `gorm:"type:enum('Balita', 'Anak-anak', 'Remaja', 'Lainnya');not null"`
-	TotalScore     int              `gorm:"type:integer;null"`
-	Conclusion     string           `gorm:"type:text;null"`
-	Recommendation string           `gorm:"type:text;null"`
-	Status         string           `gorm:"type:enum('Pending', 'Scheduled','Complete');default:'Pending';not null;index"`
-	CreatedAt      time.Time        `gorm:"autoCreateTime"`
-	UpdatedAt      time.Time        `gorm:"autoUpdateTime"`
+	Id                     int              `gorm:"primary_key;type:integer;auto_increment;"`
+	ChildId                string           `gorm:"type:char(26);not null;index"`
+	TherapistId            *string          `gorm:"type:char(26);null;index"`
+	ScheduledDate          helpers.DateOnly `gorm:"type:date;not null"`
+	ScheduledStart         *time.Time       `gorm:"type:datetime;null"`
+	ScheduledEnd           *time.Time       `gorm:"type:datetime;null"`
+	AgeCategory            string           `gorm:"type:varchar(20);not null"`
+	QuestionnaireVersionId *int             `gorm:"type:integer;null"`
+	TotalScore             int              `gorm:"type:integer;null"`
+	Conclusion             string           `gorm:"type:text;null"`
+	Recommendation         string           `gorm:"type:text;null"`
+	SuggestedRiskLevel     string           `gorm:"type:enum('Rendah', 'Sedang', 'Tinggi');null"`
+	SuggestedSection       string           `gorm:"type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');null"`
+	RiskLevel              string           `gorm:"type:enum('Rendah', 'Sedang', 'Tinggi');null"`
+	TherapySection         string           `gorm:"type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');null"`
+	DraftSavedAt           *time.Time       `gorm:"type:datetime;null"`
+	Status                 string           `gorm:"type:enum('Pending', 'Scheduled', 'InProgress', 'Complete', 'Cancelled', 'NoShow', 'Rescheduled', 'Retracted');default:'Pending';not null;index"`
+	CreatedAt              time.Time        `gorm:"autoCreateTime"`
+	UpdatedAt              time.Time        `gorm:"autoUpdateTime"`
 
-	Children          *Children           `gorm:"foreignKey:ChildId;constraint:OnDelete:CASCADE;"`
-	ObservationAnswer []ObservationAnswer `gorm:"foreignKey:ObservationId;constraint:OnDelete:CASCADE;"`
+	Children          *Children                  `gorm:"foreignKey:ChildId;constraint:OnDelete:CASCADE;"`
+	Therapist         *Therapist                 `gorm:"foreignKey:TherapistId"`
+	ObservationAnswer []ObservationAnswer        `gorm:"foreignKey:ObservationId;constraint:OnDelete:CASCADE;"`
+	StatusHistory     []ObservationStatusHistory `gorm:"foreignKey:ObservationId;constraint:OnDelete:CASCADE;"`
+	DomainScores      []ObservationDomainScore   `gorm:"foreignKey:ObservationId;constraint:OnDelete:CASCADE;"`
 }
@@ -3,14 +3,17 @@ package models
 import "time"
 
 type ObservationQuestion struct {
-	Id             int       `gorm:"primary_key;type:integer;auto_increment"`
-	QuestionCode   string    `gorm:"type:varchar(6);unique;not null"`
-	AgeCategory    string    `gorm:"type:enum('Balita', 'Anak-anak', 'Remaja', 'Lainya');not null"`
-	QuestionNumber int       `gorm:"type:integer;not null"`
-	QuestionText   string    `gorm:"type:text;not null"`
-	Score          int       `gorm:"type:integer;not null"`
-	IsActive       bool      `gorm:"type:bool;default:true"`
-	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	Id                     int       `gorm:"primary_key;type:integer;auto_increment"`
+	QuestionnaireVersionId int       `gorm:"type:integer;not null;uniqueIndex:unique_version_question_code"`
+	QuestionCode           string    `gorm:"type:varchar(10);not null;uniqueIndex:unique_version_question_code"`
+	AgeCategory            string    `gorm:"type:varchar(20);not null"`
+	Domain                 string    `gorm:"type:varchar(5);not null"`
+	QuestionNumber         int       `gorm:"type:integer;not null"`
+	QuestionText           string    `gorm:"type:text;not null"`
+	Score                  int       `gorm:"type:integer;not null"`
+	IsActive               bool      `gorm:"type:bool;default:true"`
+	CreatedAt              time.Time `gorm:"autoCreateTime"`
 
-	ObservationAnswer []ObservationAnswer `gorm:"foreignKey:QuestionId;constraint:OnDelete:CASCADE;"`
+	QuestionnaireVersion *QuestionnaireVersion `gorm:"foreignKey:QuestionnaireVersionId;constraint:OnDelete:CASCADE;"`
+	ObservationAnswer    []ObservationAnswer   `gorm:"foreignKey:QuestionId;constraint:OnDelete:CASCADE;"`
 }
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+type ObservationReport struct {
+	Id              int       `gorm:"primary_key;type:integer;auto_increment;"`
+	ObservationId   int       `gorm:"type:integer;not null;uniqueIndex:unique_observation_report"`
+	FileName        string    `gorm:"type:varchar(150);not null"`
+	Content         []byte    `gorm:"type:mediumblob;not null"`
+	Checksum        string    `gorm:"type:char(64);not null"`
+	SourceUpdatedAt time.Time `gorm:"type:datetime;not null"`
+	SourceChecksum  string    `gorm:"type:char(64);not null;default:''"`
+	GeneratedAt     time.Time `gorm:"type:datetime;not null"`
+
+	Observation *Observation `gorm:"foreignKey:ObservationId;constraint:OnDelete:CASCADE;"`
+}
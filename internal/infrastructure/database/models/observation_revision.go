@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+type ObservationRevision struct {
+	Id                 int       `gorm:"primary_key;type:integer;auto_increment;"`
+	ObservationId      int       `gorm:"type:integer;not null;uniqueIndex:unique_observation_revision"`
+	Revision           int       `gorm:"type:integer;not null;uniqueIndex:unique_observation_revision"`
+	TotalScore         int       `gorm:"type:integer;not null;default:0"`
+	Conclusion         string    `gorm:"type:text;null"`
+	Recommendation     string    `gorm:"type:text;null"`
+	SuggestedRiskLevel *string   `gorm:"type:enum('Rendah', 'Sedang', 'Tinggi');null"`
+	SuggestedSection   *string   `gorm:"type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');null"`
+	RiskLevel          *string   `gorm:"type:enum('Rendah', 'Sedang', 'Tinggi');null"`
+	TherapySection     *string   `gorm:"type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');null"`
+	Answers            string    `gorm:"type:json;not null"`
+	DomainScores       string    `gorm:"type:json;not null"`
+	Reason             string    `gorm:"type:text;not null"`
+	ActorId            string    `gorm:"type:char(26);null"`
+	ActorRole          string    `gorm:"type:varchar(20);null"`
+	CreatedAt          time.Time `gorm:"autoCreateTime"`
+
+	Observation *Observation `gorm:"foreignKey:ObservationId;constraint:OnDelete:CASCADE;"`
+}
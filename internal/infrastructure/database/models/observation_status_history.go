@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+type ObservationStatusHistory struct {
+	Id            int       `gorm:"primary_key;type:integer;auto_increment;"`
+	ObservationId int       `gorm:"type:integer;not null;index"`
+	FromStatus    string    `gorm:"type:varchar(20);not null"`
+	ToStatus      string    `gorm:"type:varchar(20);not null"`
+	Reason        string    `gorm:"type:text;null"`
+	ActorId       string    `gorm:"type:char(26);null"`
+	ActorRole     string    `gorm:"type:varchar(20);null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+
+	Observation *Observation `gorm:"foreignKey:ObservationId;constraint:OnDelete:CASCADE;"`
+}
@@ -8,9 +8,11 @@ type ParentDetail struct {
 	ParentType            string    `gorm:"type:enum('Ayah','Ibu','Wali');not null;index"`
 	ParentName            string    `gorm:"type:varchar(100);not null"`
 	ParentPhone           []byte    `gorm:"type:varbinary(100);not null"`
+	ParentPhoneIndex      string    `gorm:"type:char(64);null;index"`
 	ParentBirthDate       *string   `gorm:"type:int;null"`
 	ParentOccupation      *string   `gorm:"type:varchar(100);null"`
 	RelationshipWithChild *string   `gorm:"type:varchar(100);null"`
+	IsPrimary             bool      `gorm:"type:bool;not null;default:false"`
 	CreatedAt             time.Time `gorm:"autoCreateTime"`
 	UpdatedAt             time.Time `gorm:"autoUpdateTime"`
 
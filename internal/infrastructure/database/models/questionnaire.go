@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+type QuestionnaireVersion struct {
+	Id          int        `gorm:"primary_key;type:integer;auto_increment"`
+	Version     int        `gorm:"type:integer;not null;unique"`
+	Name        string     `gorm:"type:varchar(100);not null"`
+	Status      string     `gorm:"type:enum('Draft', 'Published', 'Archived');default:'Draft';not null;index"`
+	PublishedAt *time.Time `gorm:"type:datetime;null"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime"`
+
+	Questions []ObservationQuestion `gorm:"foreignKey:QuestionnaireVersionId;constraint:OnDelete:CASCADE;"`
+}
+
+type ObservationDomain struct {
+	Id             int       `gorm:"primary_key;type:integer;auto_increment"`
+	Code           string    `gorm:"type:varchar(5);not null;unique"`
+	Name           string    `gorm:"type:varchar(100);not null"`
+	TherapySection string    `gorm:"type:enum('Okupasi', 'Fisio', 'Wicara', 'Paedagog');not null"`
+	SortOrder      int       `gorm:"type:integer;not null;default:0"`
+	IsActive       bool      `gorm:"type:bool;default:true"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+type AgeCategory struct {
+	Id        int       `gorm:"primary_key;type:integer;auto_increment"`
+	Name      string    `gorm:"type:varchar(20);not null;unique"`
+	MinAge    int       `gorm:"type:integer;not null"`
+	MaxAge    *int      `gorm:"type:integer;null"`
+	SortOrder int       `gorm:"type:integer;not null;default:0"`
+	IsActive  bool      `gorm:"type:bool;default:true"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
@@ -5,12 +5,18 @@ import (
 )
 
 type RefreshToken struct {
-	Id        int       `gorm:"primary_key;auto_increment;"`
-	UserId    string    `gorm:"type:char(36);not null;index"`
-	Token     string    `gorm:"not null"`
-	ExpiresAt time.Time `gorm:"not null"`
-	CreatedAt time.Time `gorm:"autoCreateTime"`
-	Revoked   bool      `gorm:"default:false"`
+	Id           int    `gorm:"primary_key;auto_increment;"`
+	UserId       string `gorm:"type:char(36);not null;index"`
+	Token        string `gorm:"type:char(64);not null;uniqueIndex"`
+	FamilyId     string `gorm:"type:char(26);not null"`
+	ReplacedById *int
+	UserAgent    string    `gorm:"type:varchar(255);not null;default:''"`
+	IpAddress    string    `gorm:"type:varchar(45);not null;default:''"`
+	SignedInAt   time.Time `gorm:"not null"`
+	LastUsedAt   time.Time `gorm:"not null"`
+	ExpiresAt    time.Time `gorm:"not null"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	Revoked      bool      `gorm:"default:false"`
 
 	User User `gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE;"`
 }
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+type ScoringThreshold struct {
+	Id            int       `gorm:"primary_key;type:integer;auto_increment;"`
+	AgeCategory   string    `gorm:"type:varchar(20);not null;uniqueIndex:unique_scoring_thresholds_category_domain"`
+	Domain        string    `gorm:"type:varchar(5);not null;uniqueIndex:unique_scoring_thresholds_category_domain"`
+	ModerateScore int       `gorm:"type:integer;not null"`
+	HighScore     int       `gorm:"type:integer;not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+}
+
+type ObservationDomainScore struct {
+	Id            int       `gorm:"primary_key;type:integer;auto_increment;"`
+	ObservationId int       `gorm:"type:integer;not null;index"`
+	Domain        string    `gorm:"type:varchar(5);not null"`
+	Score         int       `gorm:"type:integer;not null"`
+	MaxScore      int       `gorm:"type:integer;not null"`
+	RiskLevel     string    `gorm:"type:enum('Rendah', 'Sedang', 'Tinggi');not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+
+	Observation *Observation `gorm:"foreignKey:ObservationId;constraint:OnDelete:CASCADE;"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type TherapistTimeOff struct {
+	Id          string    `gorm:"primary_key;type:char(26);"`
+	TherapistId string    `gorm:"type:char(26);not null;index"`
+	StartAt     time.Time `gorm:"type:datetime;not null"`
+	EndAt       time.Time `gorm:"type:datetime;not null"`
+	Reason      string    `gorm:"type:varchar(255);null"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+
+	Therapist *Therapist `gorm:"foreignKey:TherapistId;constraint:OnDelete:CASCADE;"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+type TherapistWorkingHour struct {
+	Id          string    `gorm:"primary_key;type:char(26);"`
+	TherapistId string    `gorm:"type:char(26);not null;index"`
+	DayOfWeek   int       `gorm:"type:tinyint;not null"`
+	StartTime   string    `gorm:"type:time;not null"`
+	EndTime     string    `gorm:"type:time;not null"`
+	SlotMinutes int       `gorm:"type:smallint;not null"`
+	AgeCategory *string   `gorm:"type:varchar(20);null"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+
+	Therapist *Therapist `gorm:"foreignKey:TherapistId;constraint:OnDelete:CASCADE;"`
+}
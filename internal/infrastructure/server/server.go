@@ -3,6 +3,7 @@ package server
 import (
 	"backend-golang/internal/adapters/http/middlewares"
 	"backend-golang/internal/adapters/http/routes"
+	"backend-golang/internal/helpers"
 	"backend-golang/internal/infrastructure/container"
 	"context"
 	"net/http"
@@ -52,19 +53,51 @@ func (s *Server) setupRoutes() {
 		s.container.TherapistHandler,
 		s.container.ChildHandler,
 		s.container.ObservationHandler,
+		s.container.SearchHandler,
+		s.container.ScheduleHandler,
+		s.container.ScoringHandler,
+		s.container.QuestionnaireHandler,
+		s.container.ReportHandler,
+		s.container.AssessmentHandler,
+		s.container.CaseConferenceHandler,
+		s.container.InterventionHandler,
+		s.container.CarePlanHandler,
+		s.container.EvaluationHandler,
+		s.container.SessionHandler,
+	)
+	authRoutes := routes.NewAuthRoutes(s.container.AuthHandler, s.container.SessionHandler, s.container.MfaHandler)
+	therapistRoutes := routes.NewTherapistRoutes(
+		s.container.ObservationHandler,
+		s.container.ChildHandler,
+		s.container.AssessmentHandler,
+		s.container.CaseConferenceHandler,
+		s.container.InterventionHandler,
+		s.container.CarePlanHandler,
+		s.container.EvaluationHandler,
 	)
-	authRoutes := routes.NewAuthRoutes(s.container.AuthHandler)
-	therapistRoutes := routes.NewTherapistRoutes(s.container.ObservationHandler)
 	registrationRoutes := routes.NewRegistrationRoutes(s.container.RegistrationHandler)
+	parentRoutes := routes.NewParentRoutes(
+		s.container.ParentHandler,
+		s.container.RegistrationHandler,
+		s.container.ReportHandler,
+		s.container.ChildHandler,
+		s.container.CarePlanHandler,
+	)
 
 	adminRoutes.Setup(api)
 	authRoutes.Setup(api)
 	therapistRoutes.Setup(api)
 	registrationRoutes.Setup(api)
+	parentRoutes.Setup(api)
 
 	s.router.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{"status": "ok"})
 	})
+
+	s.router.GET("/.well-known/jwks.json", func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(200, helpers.JWKS())
+	})
 }
 
 func (s *Server) Start(addr string) error {
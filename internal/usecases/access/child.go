@@ -0,0 +1,98 @@
+package access
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ChildRepositories are what Child needs to decide who may reach a child.
+type ChildRepositories struct {
+	ChildRepo     repositories.ChildRepository
+	TherapistRepo repositories.TherapistRepository
+	ParentRepo    repositories.ParentRepository
+	PlanRepo      repositories.InterventionPlanRepository
+}
+
+// Child lets admins reach any child, therapists the children they have
+// observed or run an intervention plan for, and parents their own. Any other
+// child is reported as missing. The therapist is returned for therapists and
+// nil otherwise.
+func Child(ctx context.Context, repos ChildRepositories, childId string) (*entities.Children, *entities.Therapist, error) {
+	if childId == "" {
+		return nil, nil, errors.ErrChildNotFound
+	}
+
+	role, ok := helpers.GetUserRole(ctx)
+	if !ok {
+		return nil, nil, errors.ErrUnauthorized
+	}
+
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return nil, nil, errors.ErrUnauthorized
+	}
+
+	child, err := repos.ChildRepo.GetById(ctx, childId)
+	if err != nil || child == nil {
+		return nil, nil, errors.ErrChildNotFound
+	}
+
+	switch role {
+	case string(constants.RoleAdmin):
+		return child, nil, nil
+
+	case string(constants.RoleTherapist):
+		therapist, err := therapistOfUser(ctx, repos.TherapistRepo, userId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, observation := range child.Observations {
+			if observation.TherapistId == therapist.Id {
+				return child, therapist, nil
+			}
+		}
+
+		treating, err := repos.PlanRepo.ExistByChildTherapist(ctx, child.Id, therapist.Id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+		}
+		if !treating {
+			return nil, nil, errors.ErrChildNotFound
+		}
+		return child, therapist, nil
+
+	case string(constants.RoleUser):
+		parent, err := repos.ParentRepo.GetByUserId(ctx, userId)
+		if err != nil {
+			log.Warn().Err(err).Str("userId", userId).Msg("Parent not found for user")
+			return nil, nil, errors.ErrParentNotFound
+		}
+
+		if child.ParentId != parent.Id {
+			log.Warn().Str("parentId", parent.Id).Str("childId", childId).Msg("Parent tried to access a child they do not own")
+			return nil, nil, errors.ErrChildNotFound
+		}
+		return child, nil, nil
+
+	default:
+		return nil, nil, errors.ErrForbidden
+	}
+}
+
+func therapistOfUser(ctx context.Context, therapistRepo repositories.TherapistRepository, userId string) (*entities.Therapist, error) {
+	therapist, err := therapistRepo.GetByUserId(ctx, userId)
+	if err != nil {
+		log.Warn().Err(err).Str("userId", userId).Msg("Therapist not found for user")
+		return nil, errors.ErrTherapistNotFound
+	}
+
+	return therapist, nil
+}
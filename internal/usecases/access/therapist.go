@@ -0,0 +1,30 @@
+package access
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+)
+
+// CurrentTherapist returns the therapist making the request, or nil for
+// callers of any other role, who are not limited to their own records.
+func CurrentTherapist(ctx context.Context, therapistRepo repositories.TherapistRepository) (*entities.Therapist, error) {
+	role, ok := helpers.GetUserRole(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	if role != string(constants.RoleTherapist) {
+		return nil, nil
+	}
+
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	return therapistOfUser(ctx, therapistRepo, userId)
+}
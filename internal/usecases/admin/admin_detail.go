@@ -37,7 +37,7 @@ func (uc *findAdminDetailUseCase) Execute(ctx context.Context, adminId string) (
 
 	response, err := uc.deps.Mapper.AdminsResponse(user, adminDetail)
 	if err != nil {
-		return nil, fmt.Errorf("failed to map admin %d: %w", adminDetail.Id, err)
+		return nil, fmt.Errorf("failed to map admin %s: %w", adminDetail.Id, err)
 	}
 
 	return response, nil
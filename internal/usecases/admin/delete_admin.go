@@ -38,5 +38,9 @@ func (uc *deleteAdminUseCase) Execute(ctx context.Context, adminId string) error
 		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
 	}
 
+	if err := uc.deps.TokenDenylist.RevokeUser(ctx, admin.UserId); err != nil {
+		return fmt.Errorf("%w: failed to revoke access tokens: %v", errors.ErrInternalServer, err)
+	}
+
 	return nil
 }
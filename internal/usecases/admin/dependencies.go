@@ -2,26 +2,33 @@ package admin
 
 import (
 	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/domain/services"
 )
 
 type Dependencies struct {
-	TxRepo    repositories.TransactionRepository
-	UserRepo  repositories.UserRepository
-	AdminRepo repositories.AdminRepository
-	Mapper    Mapper
-	Validator Validator
+	TxRepo           repositories.TransactionRepository
+	UserRepo         repositories.UserRepository
+	AdminRepo        repositories.AdminRepository
+	RefreshTokenRepo repositories.RefreshTokenRepository
+	TokenDenylist    services.TokenDenylistService
+	Mapper           Mapper
+	Validator        Validator
 }
 
 func NewDependencies(
 	txRepo repositories.TransactionRepository,
 	userRepo repositories.UserRepository,
 	adminRepo repositories.AdminRepository,
+	refreshTokenRepo repositories.RefreshTokenRepository,
+	tokenDenylist services.TokenDenylistService,
 ) *Dependencies {
 	return &Dependencies{
-		TxRepo:    txRepo,
-		UserRepo:  userRepo,
-		AdminRepo: adminRepo,
-		Mapper:    NewAdminMapper(),
-		Validator: NewAdminValidator(),
+		TxRepo:           txRepo,
+		UserRepo:         userRepo,
+		AdminRepo:        adminRepo,
+		RefreshTokenRepo: refreshTokenRepo,
+		TokenDenylist:    tokenDenylist,
+		Mapper:           NewAdminMapper(),
+		Validator:        NewAdminValidator(),
 	}
 }
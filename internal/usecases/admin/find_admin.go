@@ -2,7 +2,7 @@ package admin
 
 import (
 	"backend-golang/internal/adapters/http/dto"
-	"backend-golang/internal/errors"
+	"backend-golang/internal/usecases/pagination"
 	"context"
 	"fmt"
 )
@@ -15,14 +15,19 @@ func NewFindAdminsUseCase(deps *Dependencies) FindAdminsUseCase {
 	return &findAdminsUseCase{deps: deps}
 }
 
-func (uc *findAdminsUseCase) Execute(ctx context.Context) ([]*dto.AdminResponse, error) {
-	admins, err := uc.deps.AdminRepo.GetAll(ctx)
+func (uc *findAdminsUseCase) Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.AdminResponse, *dto.PaginationMeta, error) {
+	query, err := pagination.ToListQuery(req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+		return nil, nil, err
+	}
+
+	admins, pageInfo, err := uc.deps.AdminRepo.GetAll(ctx, query)
+	if err != nil {
+		return nil, nil, pagination.RetrievalError(err)
 	}
 
 	if admins == nil {
-		return []*dto.AdminResponse{}, nil
+		return []*dto.AdminResponse{}, pagination.ToMeta(pageInfo), nil
 	}
 
 	responses := make([]*dto.AdminResponse, 0, len(admins))
@@ -33,7 +38,7 @@ func (uc *findAdminsUseCase) Execute(ctx context.Context) ([]*dto.AdminResponse,
 
 		response, err := uc.deps.Mapper.AdminsResponse(admin.User, admin)
 		if err != nil {
-			return nil, fmt.Errorf("failed to map admin %d: %w", admin.Id, err)
+			return nil, nil, fmt.Errorf("failed to map admin %s: %w", admin.Id, err)
 		}
 
 		if response != nil {
@@ -41,5 +46,5 @@ func (uc *findAdminsUseCase) Execute(ctx context.Context) ([]*dto.AdminResponse,
 		}
 	}
 
-	return responses, nil
+	return responses, pagination.ToMeta(pageInfo), nil
 }
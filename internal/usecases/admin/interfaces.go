@@ -10,7 +10,7 @@ type CreateAdminUseCase interface {
 }
 
 type FindAdminsUseCase interface {
-	Execute(ctx context.Context) ([]*dto.AdminResponse, error)
+	Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.AdminResponse, *dto.PaginationMeta, error)
 }
 
 type FindAdminDetailUseCase interface {
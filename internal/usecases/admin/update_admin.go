@@ -74,5 +74,14 @@ func (uc *updateAdminUseCase) Execute(ctx context.Context, adminId string, req *
 		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
 	}
 
+	if updatedUser.EndsSessions(existingAdmin.User) {
+		if _, err := uc.deps.RefreshTokenRepo.RevokeAllByUser(ctx, updatedUser.Id, ""); err != nil {
+			return fmt.Errorf("%w: failed to revoke sessions: %v", errors.ErrInternalServer, err)
+		}
+		if err := uc.deps.TokenDenylist.RevokeUser(ctx, updatedUser.Id); err != nil {
+			return fmt.Errorf("%w: failed to revoke access tokens: %v", errors.ErrInternalServer, err)
+		}
+	}
+
 	return nil
 }
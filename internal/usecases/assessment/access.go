@@ -0,0 +1,69 @@
+package assessment
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/usecases/access"
+	"context"
+)
+
+// accessibleAssessment lets a therapist reach only the assessments they
+// carry out; anyone else's is reported as missing.
+func accessibleAssessment(ctx context.Context, deps *Dependencies, assessmentId int) (*entities.Assessment, error) {
+	if assessmentId == 0 {
+		return nil, errors.ErrAssessmentNotFound
+	}
+
+	therapist, err := access.CurrentTherapist(ctx, deps.TherapistRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment, err := deps.AssessmentRepo.GetById(ctx, assessmentId)
+	if err != nil || assessment == nil {
+		return nil, errors.ErrAssessmentNotFound
+	}
+
+	if therapist != nil && assessment.AssessorId != therapist.Id {
+		return nil, errors.ErrAssessmentNotFound
+	}
+
+	return assessment, nil
+}
+
+// assignedAssessment is for writes only the assessor may make.
+func assignedAssessment(ctx context.Context, deps *Dependencies, assessmentId int) (*entities.Assessment, error) {
+	if assessmentId == 0 {
+		return nil, errors.ErrAssessmentNotFound
+	}
+
+	therapist, err := access.CurrentTherapist(ctx, deps.TherapistRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment, err := deps.AssessmentRepo.GetById(ctx, assessmentId)
+	if err != nil || assessment == nil {
+		return nil, errors.ErrAssessmentNotFound
+	}
+
+	if therapist == nil || assessment.AssessorId != therapist.Id {
+		return nil, errors.ErrAssessmentNotAssigned
+	}
+
+	return assessment, nil
+}
+
+// activeAssessor loads a therapist who can take on an assessment in section.
+func activeAssessor(ctx context.Context, deps *Dependencies, assessorId string, therapySection string) (*entities.Therapist, error) {
+	assessor, err := deps.TherapistRepo.GetById(ctx, assessorId)
+	if err != nil || assessor.User == nil || !assessor.User.IsActive {
+		return nil, errors.ErrTherapistNotFound
+	}
+
+	if assessor.TherapistSection != therapySection {
+		return nil, errors.ErrAssessorSectionMismatch
+	}
+
+	return assessor, nil
+}
@@ -0,0 +1,49 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/repositories"
+	internalError "backend-golang/internal/errors"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type cancelAssessmentUseCase struct {
+	deps *Dependencies
+}
+
+func NewCancelAssessmentUseCase(deps *Dependencies) CancelAssessmentUseCase {
+	return &cancelAssessmentUseCase{deps: deps}
+}
+
+func (uc *cancelAssessmentUseCase) Execute(ctx context.Context, assessmentId int, req *dto.CancelAssessmentRequest) error {
+	if err := uc.deps.Validator.ValidateCancelRequest(req); err != nil {
+		return err
+	}
+
+	if _, err := uc.deps.AssessmentRepo.GetById(ctx, assessmentId); err != nil {
+		return internalError.ErrAssessmentNotFound
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.AssessmentRepo.Cancel(ctx, tx, assessmentId, req.Reason); err != nil {
+		tx.Rollback()
+		if errors.Is(err, repositories.ErrStatusChanged) {
+			return internalError.ErrAssessmentNotOpen
+		}
+		return fmt.Errorf("%w: %v", internalError.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", internalError.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	internalError "backend-golang/internal/errors"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type completeAssessmentUseCase struct {
+	deps *Dependencies
+}
+
+func NewCompleteAssessmentUseCase(deps *Dependencies) CompleteAssessmentUseCase {
+	return &completeAssessmentUseCase{deps: deps}
+}
+
+// Execute closes the assessment once every item of the section's active
+// instruments is scored, and adds its recommendation to the child's care
+// plan in the same transaction.
+func (uc *completeAssessmentUseCase) Execute(ctx context.Context, assessmentId int, req *dto.CompleteAssessmentRequest) error {
+	if err := uc.deps.Validator.ValidateCompleteRequest(req); err != nil {
+		return err
+	}
+
+	assessment, err := assignedAssessment(ctx, uc.deps, assessmentId)
+	if err != nil {
+		return err
+	}
+
+	if !assessment.IsOpen() {
+		return internalError.ErrAssessmentNotOpen
+	}
+
+	_, items, err := instrumentItems(ctx, uc.deps, assessment.TherapySection)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return internalError.ErrAssessmentNoInstruments
+	}
+
+	scores, err := scoreEntities(assessment.Id, req.Scores, items)
+	if err != nil {
+		return err
+	}
+
+	saved, err := uc.deps.ScoreRepo.GetByAssessmentId(ctx, assessment.Id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", internalError.ErrRetrievalFailed, err)
+	}
+
+	scored := make(map[int]bool, len(items))
+	for _, score := range saved {
+		scored[score.ItemId] = true
+	}
+	for _, score := range scores {
+		scored[score.ItemId] = true
+	}
+	for itemId := range items {
+		if !scored[itemId] {
+			return internalError.ErrAssessmentIncomplete
+		}
+	}
+
+	now := time.Now()
+	assessment.Summary = req.Summary
+	assessment.Recommendation = req.Recommendation
+	assessment.SessionsPerWeek = req.SessionsPerWeek
+	assessment.CompletedAt = &now
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	locked, err := uc.deps.AssessmentRepo.LockById(ctx, tx, assessment.Id)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", internalError.ErrDatabaseConnection, err)
+	}
+	if !locked.IsOpen() {
+		tx.Rollback()
+		return internalError.ErrAssessmentNotOpen
+	}
+
+	if len(scores) > 0 {
+		if err := uc.deps.ScoreRepo.Upsert(ctx, tx, scores); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%w: %v", internalError.ErrUpdateFailed, err)
+		}
+	}
+
+	if err := uc.deps.AssessmentRepo.Complete(ctx, tx, assessment); err != nil {
+		tx.Rollback()
+		if errors.Is(err, repositories.ErrStatusChanged) {
+			return internalError.ErrAssessmentStatusChanged
+		}
+		return fmt.Errorf("%w: %v", internalError.ErrUpdateFailed, err)
+	}
+
+	carePlan, err := uc.deps.CarePlanRepo.GetOrCreateByChildId(ctx, tx, assessment.ChildId)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", internalError.ErrCreationFailed, err)
+	}
+
+	entry := &entities.CarePlanEntry{
+		CarePlanId:      carePlan.Id,
+		TherapySection:  assessment.TherapySection,
+		AssessmentId:    &assessment.Id,
+		Summary:         assessment.Summary,
+		Recommendation:  assessment.Recommendation,
+		SessionsPerWeek: assessment.SessionsPerWeek,
+	}
+	if err := uc.deps.CarePlanRepo.AddEntry(ctx, tx, entry); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", internalError.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", internalError.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Int("assessmentId", assessment.Id).Str("childId", assessment.ChildId).Int("carePlanId", carePlan.Id).Msg("Assessment completed")
+	return nil
+}
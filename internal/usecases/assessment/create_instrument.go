@@ -0,0 +1,50 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type createInstrumentUseCase struct {
+	deps *Dependencies
+}
+
+func NewCreateInstrumentUseCase(deps *Dependencies) CreateInstrumentUseCase {
+	return &createInstrumentUseCase{deps: deps}
+}
+
+func (uc *createInstrumentUseCase) Execute(ctx context.Context, req *dto.AssessmentInstrumentCreateRequest) error {
+	if err := uc.deps.Validator.ValidateCreateInstrumentRequest(req); err != nil {
+		return err
+	}
+
+	exists, err := uc.deps.InstrumentRepo.ExistByCode(ctx, req.Code)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+	if exists {
+		return errors.ErrAssessmentInstrumentExists
+	}
+
+	instrument := uc.deps.Mapper.CreateRequestToInstrument(req)
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.InstrumentRepo.Create(ctx, tx, instrument); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
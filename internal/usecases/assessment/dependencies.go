@@ -0,0 +1,37 @@
+package assessment
+
+import "backend-golang/internal/domain/repositories"
+
+type Dependencies struct {
+	TxRepo          repositories.TransactionRepository
+	ObservationRepo repositories.ObservationRepository
+	TherapistRepo   repositories.TherapistRepository
+	InstrumentRepo  repositories.AssessmentInstrumentRepository
+	AssessmentRepo  repositories.AssessmentRepository
+	ScoreRepo       repositories.AssessmentScoreRepository
+	CarePlanRepo    repositories.CarePlanRepository
+	Validator       Validator
+	Mapper          Mapper
+}
+
+func NewDependencies(
+	txRepo repositories.TransactionRepository,
+	observationRepo repositories.ObservationRepository,
+	therapistRepo repositories.TherapistRepository,
+	instrumentRepo repositories.AssessmentInstrumentRepository,
+	assessmentRepo repositories.AssessmentRepository,
+	scoreRepo repositories.AssessmentScoreRepository,
+	carePlanRepo repositories.CarePlanRepository,
+) *Dependencies {
+	return &Dependencies{
+		TxRepo:          txRepo,
+		ObservationRepo: observationRepo,
+		TherapistRepo:   therapistRepo,
+		InstrumentRepo:  instrumentRepo,
+		AssessmentRepo:  assessmentRepo,
+		ScoreRepo:       scoreRepo,
+		CarePlanRepo:    carePlanRepo,
+		Validator:       NewAssessmentValidator(),
+		Mapper:          NewAssessmentMapper(),
+	}
+}
@@ -0,0 +1,35 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findAssessmentDetailUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindAssessmentDetailUseCase(deps *Dependencies) FindAssessmentDetailUseCase {
+	return &findAssessmentDetailUseCase{deps: deps}
+}
+
+func (uc *findAssessmentDetailUseCase) Execute(ctx context.Context, assessmentId int) (*dto.AssessmentDetailResponse, error) {
+	assessment, err := accessibleAssessment(ctx, uc.deps, assessmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	instruments, _, err := instrumentItems(ctx, uc.deps, assessment.TherapySection)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := uc.deps.ScoreRepo.GetByAssessmentId(ctx, assessment.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	return uc.deps.Mapper.AssessmentDetailResponse(assessment, instruments, scores), nil
+}
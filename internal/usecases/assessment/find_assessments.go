@@ -0,0 +1,51 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/usecases/access"
+	"backend-golang/internal/usecases/pagination"
+	"context"
+)
+
+type findAssessmentsUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindAssessmentsUseCase(deps *Dependencies) FindAssessmentsUseCase {
+	return &findAssessmentsUseCase{deps: deps}
+}
+
+// Execute lists every assessment for admins and only their own for
+// therapists.
+func (uc *findAssessmentsUseCase) Execute(ctx context.Context, req *dto.AssessmentListQuery) ([]*dto.AssessmentResponse, *dto.PaginationMeta, error) {
+	if err := uc.deps.Validator.ValidateListQuery(req); err != nil {
+		return nil, nil, err
+	}
+
+	query, err := pagination.ToListQuery(&req.ListQueryRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	therapist, err := access.CurrentTherapist(ctx, uc.deps.TherapistRepo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var assessorId string
+	if therapist != nil {
+		assessorId = therapist.Id
+	}
+
+	assessments, pageInfo, err := uc.deps.AssessmentRepo.GetAll(ctx, query, req.Status, assessorId)
+	if err != nil {
+		return nil, nil, pagination.RetrievalError(err)
+	}
+
+	responses := make([]*dto.AssessmentResponse, 0, len(assessments))
+	for _, assessment := range assessments {
+		responses = append(responses, uc.deps.Mapper.AssessmentResponse(assessment))
+	}
+
+	return responses, pagination.ToMeta(pageInfo), nil
+}
@@ -0,0 +1,34 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findInstrumentsUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindInstrumentsUseCase(deps *Dependencies) FindInstrumentsUseCase {
+	return &findInstrumentsUseCase{deps: deps}
+}
+
+func (uc *findInstrumentsUseCase) Execute(ctx context.Context, req *dto.AssessmentInstrumentQuery) ([]*dto.AssessmentInstrumentResponse, error) {
+	if err := uc.deps.Validator.ValidateInstrumentQuery(req); err != nil {
+		return nil, err
+	}
+
+	instruments, err := uc.deps.InstrumentRepo.GetAll(ctx, req.TherapySection)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	responses := make([]*dto.AssessmentInstrumentResponse, 0, len(instruments))
+	for _, instrument := range instruments {
+		responses = append(responses, uc.deps.Mapper.InstrumentResponse(instrument, nil))
+	}
+
+	return responses, nil
+}
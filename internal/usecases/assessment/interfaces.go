@@ -0,0 +1,46 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type CreateInstrumentUseCase interface {
+	Execute(ctx context.Context, req *dto.AssessmentInstrumentCreateRequest) error
+}
+
+type FindInstrumentsUseCase interface {
+	Execute(ctx context.Context, req *dto.AssessmentInstrumentQuery) ([]*dto.AssessmentInstrumentResponse, error)
+}
+
+type UpdateInstrumentUseCase interface {
+	Execute(ctx context.Context, instrumentId int, req *dto.AssessmentInstrumentUpdateRequest) error
+}
+
+type OpenAssessmentUseCase interface {
+	Execute(ctx context.Context, req *dto.OpenAssessmentRequest) error
+}
+
+type FindAssessmentsUseCase interface {
+	Execute(ctx context.Context, req *dto.AssessmentListQuery) ([]*dto.AssessmentResponse, *dto.PaginationMeta, error)
+}
+
+type FindAssessmentDetailUseCase interface {
+	Execute(ctx context.Context, assessmentId int) (*dto.AssessmentDetailResponse, error)
+}
+
+type ReassignAssessmentUseCase interface {
+	Execute(ctx context.Context, assessmentId int, req *dto.ReassignAssessmentRequest) error
+}
+
+type CancelAssessmentUseCase interface {
+	Execute(ctx context.Context, assessmentId int, req *dto.CancelAssessmentRequest) error
+}
+
+type SaveAssessmentScoresUseCase interface {
+	Execute(ctx context.Context, assessmentId int, req *dto.SaveAssessmentScoresRequest) error
+}
+
+type CompleteAssessmentUseCase interface {
+	Execute(ctx context.Context, assessmentId int, req *dto.CompleteAssessmentRequest) error
+}
@@ -0,0 +1,142 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"time"
+)
+
+type Mapper interface {
+	CreateRequestToInstrument(req *dto.AssessmentInstrumentCreateRequest) *entities.AssessmentInstrument
+	OpenRequestToAssessment(req *dto.OpenAssessmentRequest, observation *entities.Observation, therapySection string) *entities.Assessment
+	InstrumentResponse(instrument *entities.AssessmentInstrument, scores map[int]*entities.AssessmentScore) *dto.AssessmentInstrumentResponse
+	AssessmentResponse(assessment *entities.Assessment) *dto.AssessmentResponse
+	AssessmentDetailResponse(assessment *entities.Assessment, instruments []*entities.AssessmentInstrument, scores []*entities.AssessmentScore) *dto.AssessmentDetailResponse
+}
+
+type assessmentMapper struct{}
+
+func NewAssessmentMapper() Mapper {
+	return &assessmentMapper{}
+}
+
+func (m *assessmentMapper) CreateRequestToInstrument(req *dto.AssessmentInstrumentCreateRequest) *entities.AssessmentInstrument {
+	now := time.Now()
+	instrument := &entities.AssessmentInstrument{
+		Code:           req.Code,
+		Name:           req.Name,
+		TherapySection: req.TherapySection,
+		Description:    req.Description,
+		SortOrder:      req.SortOrder,
+		IsActive:       true,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		Items:          make([]entities.AssessmentInstrumentItem, 0, len(req.Items)),
+	}
+
+	for _, item := range req.Items {
+		instrument.Items = append(instrument.Items, entities.AssessmentInstrumentItem{
+			ItemNumber: item.ItemNumber,
+			ItemText:   item.ItemText,
+			MaxScore:   item.MaxScore,
+		})
+	}
+
+	return instrument
+}
+
+func (m *assessmentMapper) OpenRequestToAssessment(req *dto.OpenAssessmentRequest, observation *entities.Observation, therapySection string) *entities.Assessment {
+	return &entities.Assessment{
+		ObservationId:  observation.Id,
+		ChildId:        observation.ChildId,
+		TherapySection: therapySection,
+		AssessorId:     req.AssessorId,
+		ScheduledDate:  req.ScheduledDate,
+		Status:         string(constants.AssessmentStatusScheduled),
+	}
+}
+
+func (m *assessmentMapper) InstrumentResponse(instrument *entities.AssessmentInstrument, scores map[int]*entities.AssessmentScore) *dto.AssessmentInstrumentResponse {
+	items := make([]*dto.AssessmentInstrumentItemResponse, 0, len(instrument.Items))
+	for _, item := range instrument.Items {
+		response := &dto.AssessmentInstrumentItemResponse{
+			ItemId:     item.Id,
+			ItemNumber: item.ItemNumber,
+			ItemText:   item.ItemText,
+			MaxScore:   item.MaxScore,
+		}
+
+		if score, ok := scores[item.Id]; ok {
+			value := score.Score
+			response.Score = &value
+			if score.Note != nil {
+				response.Note = *score.Note
+			}
+		}
+
+		items = append(items, response)
+	}
+
+	return &dto.AssessmentInstrumentResponse{
+		InstrumentId:   instrument.Id,
+		Code:           instrument.Code,
+		Name:           instrument.Name,
+		TherapySection: instrument.TherapySection,
+		Description:    instrument.Description,
+		SortOrder:      instrument.SortOrder,
+		IsActive:       instrument.IsActive,
+		Items:          items,
+	}
+}
+
+func (m *assessmentMapper) AssessmentResponse(assessment *entities.Assessment) *dto.AssessmentResponse {
+	response := &dto.AssessmentResponse{
+		AssessmentId:   assessment.Id,
+		ObservationId:  assessment.ObservationId,
+		ChildId:        assessment.ChildId,
+		TherapySection: assessment.TherapySection,
+		AssessorId:     assessment.AssessorId,
+		ScheduledDate:  assessment.ScheduledDate,
+		Status:         assessment.Status,
+	}
+
+	if assessment.Children != nil {
+		response.ChildName = assessment.Children.ChildName
+	}
+
+	if assessment.Assessor != nil {
+		response.AssessorName = assessment.Assessor.TherapistName
+	}
+
+	return response
+}
+
+func (m *assessmentMapper) AssessmentDetailResponse(assessment *entities.Assessment, instruments []*entities.AssessmentInstrument, scores []*entities.AssessmentScore) *dto.AssessmentDetailResponse {
+	scoreByItem := make(map[int]*entities.AssessmentScore, len(scores))
+	for _, score := range scores {
+		scoreByItem[score.ItemId] = score
+	}
+
+	response := &dto.AssessmentDetailResponse{
+		AssessmentResponse: *m.AssessmentResponse(assessment),
+		Summary:            assessment.Summary,
+		Recommendation:     assessment.Recommendation,
+		SessionsPerWeek:    assessment.SessionsPerWeek,
+		CancelReason:       assessment.CancelReason,
+		CompletedAt:        assessment.CompletedAt,
+		Instruments:        make([]*dto.AssessmentInstrumentResponse, 0, len(instruments)),
+	}
+
+	for _, instrument := range instruments {
+		for _, item := range instrument.Items {
+			response.TotalItems++
+			if _, ok := scoreByItem[item.Id]; ok {
+				response.ScoredItems++
+			}
+		}
+		response.Instruments = append(response.Instruments, m.InstrumentResponse(instrument, scoreByItem))
+	}
+
+	return response
+}
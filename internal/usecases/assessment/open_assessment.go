@@ -0,0 +1,93 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type openAssessmentUseCase struct {
+	deps *Dependencies
+}
+
+func NewOpenAssessmentUseCase(deps *Dependencies) OpenAssessmentUseCase {
+	return &openAssessmentUseCase{deps: deps}
+}
+
+// Execute opens an assessment from a completed observation. The therapy
+// section defaults to the one the observation settled on.
+func (uc *openAssessmentUseCase) Execute(ctx context.Context, req *dto.OpenAssessmentRequest) error {
+	if err := uc.deps.Validator.ValidateOpenRequest(req); err != nil {
+		return err
+	}
+
+	observation, err := uc.deps.ObservationRepo.GetById(ctx, req.ObservationId)
+	if err != nil || observation == nil {
+		return errors.ErrObservationNotFound
+	}
+
+	if observation.Status != string(constants.ObservationStatusCompleted) {
+		return errors.ErrAssessmentRequiresCompleted
+	}
+
+	therapySection := req.TherapySection
+	if therapySection == "" {
+		therapySection = observation.TherapySection
+	}
+	if therapySection == "" {
+		return errors.ErrTherapySectionRequired
+	}
+
+	instruments, _, err := instrumentItems(ctx, uc.deps, therapySection)
+	if err != nil {
+		return err
+	}
+	if len(instruments) == 0 {
+		return errors.ErrAssessmentNoInstruments
+	}
+
+	if _, err := activeAssessor(ctx, uc.deps, req.AssessorId, therapySection); err != nil {
+		return err
+	}
+
+	assessment := uc.deps.Mapper.OpenRequestToAssessment(req, observation, therapySection)
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Opening serialises on the observation row so a section is not opened twice.
+	if _, err := uc.deps.ObservationRepo.LockById(ctx, tx, observation.Id); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	open, err := uc.deps.AssessmentRepo.ExistOpenByObservation(ctx, tx, observation.Id, therapySection)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+	if open {
+		tx.Rollback()
+		return errors.ErrAssessmentAlreadyOpen
+	}
+
+	if err := uc.deps.AssessmentRepo.Create(ctx, tx, assessment); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Int("assessmentId", assessment.Id).Int("observationId", observation.Id).Str("therapySection", therapySection).Msg("Assessment opened")
+	return nil
+}
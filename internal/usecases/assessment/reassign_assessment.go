@@ -0,0 +1,58 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/repositories"
+	internalError "backend-golang/internal/errors"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type reassignAssessmentUseCase struct {
+	deps *Dependencies
+}
+
+func NewReassignAssessmentUseCase(deps *Dependencies) ReassignAssessmentUseCase {
+	return &reassignAssessmentUseCase{deps: deps}
+}
+
+func (uc *reassignAssessmentUseCase) Execute(ctx context.Context, assessmentId int, req *dto.ReassignAssessmentRequest) error {
+	if err := uc.deps.Validator.ValidateReassignRequest(req); err != nil {
+		return err
+	}
+
+	assessment, err := uc.deps.AssessmentRepo.GetById(ctx, assessmentId)
+	if err != nil {
+		return internalError.ErrAssessmentNotFound
+	}
+
+	if !assessment.IsOpen() {
+		return internalError.ErrAssessmentNotOpen
+	}
+
+	if _, err := activeAssessor(ctx, uc.deps, req.AssessorId, assessment.TherapySection); err != nil {
+		return err
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.AssessmentRepo.UpdateAssessor(ctx, tx, assessment.Id, req.AssessorId, req.ScheduledDate); err != nil {
+		tx.Rollback()
+		if errors.Is(err, repositories.ErrStatusChanged) {
+			return internalError.ErrAssessmentNotOpen
+		}
+		return fmt.Errorf("%w: %v", internalError.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", internalError.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/repositories"
+	internalError "backend-golang/internal/errors"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type saveAssessmentScoresUseCase struct {
+	deps *Dependencies
+}
+
+func NewSaveAssessmentScoresUseCase(deps *Dependencies) SaveAssessmentScoresUseCase {
+	return &saveAssessmentScoresUseCase{deps: deps}
+}
+
+// Execute stores scores as the assessor goes; the first save starts the
+// assessment.
+func (uc *saveAssessmentScoresUseCase) Execute(ctx context.Context, assessmentId int, req *dto.SaveAssessmentScoresRequest) error {
+	if err := uc.deps.Validator.ValidateSaveScoresRequest(req); err != nil {
+		return err
+	}
+
+	assessment, err := assignedAssessment(ctx, uc.deps, assessmentId)
+	if err != nil {
+		return err
+	}
+
+	if !assessment.IsOpen() {
+		return internalError.ErrAssessmentNotOpen
+	}
+
+	_, items, err := instrumentItems(ctx, uc.deps, assessment.TherapySection)
+	if err != nil {
+		return err
+	}
+
+	scores, err := scoreEntities(assessment.Id, req.Scores, items)
+	if err != nil {
+		return err
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	locked, err := uc.deps.AssessmentRepo.LockById(ctx, tx, assessment.Id)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", internalError.ErrDatabaseConnection, err)
+	}
+	if !locked.IsOpen() {
+		tx.Rollback()
+		return internalError.ErrAssessmentNotOpen
+	}
+
+	if locked.Status == string(constants.AssessmentStatusScheduled) {
+		err := uc.deps.AssessmentRepo.UpdateStatus(ctx, tx, locked.Id, constants.AssessmentStatusScheduled, constants.AssessmentStatusInProgress)
+		if err != nil {
+			tx.Rollback()
+			if errors.Is(err, repositories.ErrStatusChanged) {
+				return internalError.ErrAssessmentStatusChanged
+			}
+			return fmt.Errorf("%w: %v", internalError.ErrUpdateFailed, err)
+		}
+	}
+
+	if err := uc.deps.ScoreRepo.Upsert(ctx, tx, scores); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", internalError.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", internalError.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
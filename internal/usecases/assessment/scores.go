@@ -0,0 +1,58 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+// instrumentItems returns the active instruments of the section and their
+// items keyed by id.
+func instrumentItems(ctx context.Context, deps *Dependencies, therapySection string) ([]*entities.AssessmentInstrument, map[int]entities.AssessmentInstrumentItem, error) {
+	instruments, err := deps.InstrumentRepo.GetActiveBySection(ctx, therapySection)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	items := make(map[int]entities.AssessmentInstrumentItem)
+	for _, instrument := range instruments {
+		for _, item := range instrument.Items {
+			items[item.Id] = item
+		}
+	}
+
+	return instruments, items, nil
+}
+
+// scoreEntities checks every score against its item; the last score given for
+// an item wins.
+func scoreEntities(assessmentId int, inputs []dto.AssessmentScoreInput, items map[int]entities.AssessmentInstrumentItem) ([]*entities.AssessmentScore, error) {
+	byItem := make(map[int]*entities.AssessmentScore, len(inputs))
+	order := make([]int, 0, len(inputs))
+
+	for _, input := range inputs {
+		item, ok := items[input.ItemId]
+		if !ok || *input.Score > item.MaxScore {
+			return nil, errors.ErrAssessmentItemInvalid
+		}
+
+		if _, seen := byItem[input.ItemId]; !seen {
+			order = append(order, input.ItemId)
+		}
+		byItem[input.ItemId] = &entities.AssessmentScore{
+			AssessmentId: assessmentId,
+			ItemId:       input.ItemId,
+			Score:        *input.Score,
+			Note:         input.Note,
+		}
+	}
+
+	scores := make([]*entities.AssessmentScore, 0, len(order))
+	for _, itemId := range order {
+		scores = append(scores, byItem[itemId])
+	}
+
+	return scores, nil
+}
@@ -0,0 +1,52 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+type updateInstrumentUseCase struct {
+	deps *Dependencies
+}
+
+func NewUpdateInstrumentUseCase(deps *Dependencies) UpdateInstrumentUseCase {
+	return &updateInstrumentUseCase{deps: deps}
+}
+
+func (uc *updateInstrumentUseCase) Execute(ctx context.Context, instrumentId int, req *dto.AssessmentInstrumentUpdateRequest) error {
+	if err := uc.deps.Validator.ValidateUpdateInstrumentRequest(req); err != nil {
+		return err
+	}
+
+	instrument, err := uc.deps.InstrumentRepo.GetById(ctx, instrumentId)
+	if err != nil {
+		return errors.ErrAssessmentInstrumentNotFound
+	}
+
+	instrument.Name = req.Name
+	instrument.Description = req.Description
+	instrument.SortOrder = req.SortOrder
+	instrument.IsActive = *req.IsActive
+	instrument.UpdatedAt = time.Now()
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.InstrumentRepo.Update(ctx, tx, instrument); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
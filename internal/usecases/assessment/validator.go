@@ -0,0 +1,91 @@
+package assessment
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"backend-golang/internal/validator"
+	"time"
+)
+
+type Validator interface {
+	ValidateCreateInstrumentRequest(req *dto.AssessmentInstrumentCreateRequest) error
+	ValidateUpdateInstrumentRequest(req *dto.AssessmentInstrumentUpdateRequest) error
+	ValidateInstrumentQuery(req *dto.AssessmentInstrumentQuery) error
+	ValidateOpenRequest(req *dto.OpenAssessmentRequest) error
+	ValidateReassignRequest(req *dto.ReassignAssessmentRequest) error
+	ValidateCancelRequest(req *dto.CancelAssessmentRequest) error
+	ValidateListQuery(req *dto.AssessmentListQuery) error
+	ValidateSaveScoresRequest(req *dto.SaveAssessmentScoresRequest) error
+	ValidateCompleteRequest(req *dto.CompleteAssessmentRequest) error
+}
+
+type assessmentValidator struct{}
+
+func NewAssessmentValidator() Validator {
+	return &assessmentValidator{}
+}
+
+func (v *assessmentValidator) ValidateCreateInstrumentRequest(req *dto.AssessmentInstrumentCreateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	seen := make(map[int]bool, len(req.Items))
+	for _, item := range req.Items {
+		if seen[item.ItemNumber] {
+			return errors.ErrDuplicateInstrumentItem
+		}
+		seen[item.ItemNumber] = true
+	}
+
+	return nil
+}
+
+func (v *assessmentValidator) ValidateUpdateInstrumentRequest(req *dto.AssessmentInstrumentUpdateRequest) error {
+	return validator.ValidateStruct(req)
+}
+
+func (v *assessmentValidator) ValidateInstrumentQuery(req *dto.AssessmentInstrumentQuery) error {
+	return validator.ValidateStruct(req)
+}
+
+func (v *assessmentValidator) ValidateOpenRequest(req *dto.OpenAssessmentRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return validateScheduledDate(req.ScheduledDate)
+}
+
+func (v *assessmentValidator) ValidateReassignRequest(req *dto.ReassignAssessmentRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return validateScheduledDate(req.ScheduledDate)
+}
+
+func (v *assessmentValidator) ValidateCancelRequest(req *dto.CancelAssessmentRequest) error {
+	return validator.ValidateStruct(req)
+}
+
+func (v *assessmentValidator) ValidateListQuery(req *dto.AssessmentListQuery) error {
+	return validator.ValidateStruct(req)
+}
+
+func (v *assessmentValidator) ValidateSaveScoresRequest(req *dto.SaveAssessmentScoresRequest) error {
+	return validator.ValidateStruct(req)
+}
+
+func (v *assessmentValidator) ValidateCompleteRequest(req *dto.CompleteAssessmentRequest) error {
+	return validator.ValidateStruct(req)
+}
+
+func validateScheduledDate(date helpers.DateOnly) error {
+	if date.ToTime().Before(time.Now().Truncate(24 * time.Hour)) {
+		return errors.ErrScheduleInPast
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type confirmMfaEnrollmentUseCase struct {
+	deps *Dependencies
+}
+
+func NewConfirmMfaEnrollmentUseCase(deps *Dependencies) ConfirmMfaEnrollmentUseCase {
+	return &confirmMfaEnrollmentUseCase{deps: deps}
+}
+
+// Execute enables MFA once the caller proves their app produces codes for the
+// pending secret. The recovery codes are only shown in this response.
+func (uc *confirmMfaEnrollmentUseCase) Execute(ctx context.Context, req *dto.MfaCodeRequest) (*dto.MfaRecoveryCodesResponse, error) {
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	if err := uc.deps.Validator.ValidateMfaCodeRequest(req); err != nil {
+		return nil, err
+	}
+	if req.Code == "" {
+		return nil, errors.ErrMfaCodeRequired
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	enrollment, err := uc.deps.MfaRepo.LockByUserId(ctx, tx, userId)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+	if enrollment == nil {
+		tx.Rollback()
+		return nil, errors.ErrMfaEnrollmentNotFound
+	}
+	if enrollment.IsEnabled() {
+		tx.Rollback()
+		return nil, errors.ErrMfaAlreadyEnabled
+	}
+
+	if err := verifyMfaCode(ctx, uc.deps, tx, enrollment, req.Code, ""); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	recoveryCodes, err := enableMfa(ctx, uc.deps, tx, enrollment)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Str("userId", userId).Msg("MFA enabled")
+	return &dto.MfaRecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
@@ -11,11 +11,17 @@ type Dependencies struct {
 	ParentRepo       repositories.ParentRepository
 	VerifyTokenRepo  repositories.VerificationTokenRepository
 	RefreshTokenRepo repositories.RefreshTokenRepository
+	MfaRepo          repositories.MfaRepository
 	EmailService     services.EmailService
 	RateLimiter      services.RateLimiterService
 	TokenService     services.TokenService
-	Mapper           Mapper
-	Validator        Validator
+	TokenDenylist    services.TokenDenylistService
+	MfaChallenge     services.MfaChallengeService
+	// RequireAdminMfa makes admins complete MFA at login, enrolling first
+	// if they have not yet.
+	RequireAdminMfa bool
+	Mapper          Mapper
+	Validator       Validator
 }
 
 func NewDependencies(
@@ -24,9 +30,13 @@ func NewDependencies(
 	parentRepo repositories.ParentRepository,
 	verifyTokenRepo repositories.VerificationTokenRepository,
 	refreshTokenRepo repositories.RefreshTokenRepository,
+	mfaRepo repositories.MfaRepository,
 	emailService services.EmailService,
 	rateLimiter services.RateLimiterService,
 	tokenService services.TokenService,
+	tokenDenylist services.TokenDenylistService,
+	mfaChallenge services.MfaChallengeService,
+	requireAdminMfa bool,
 ) *Dependencies {
 	return &Dependencies{
 		TxRepo:           txRepo,
@@ -34,9 +44,13 @@ func NewDependencies(
 		ParentRepo:       parentRepo,
 		VerifyTokenRepo:  verifyTokenRepo,
 		RefreshTokenRepo: refreshTokenRepo,
+		MfaRepo:          mfaRepo,
 		EmailService:     emailService,
 		RateLimiter:      rateLimiter,
 		TokenService:     tokenService,
+		TokenDenylist:    tokenDenylist,
+		MfaChallenge:     mfaChallenge,
+		RequireAdminMfa:  requireAdminMfa,
 		Mapper:           NewAuthMapper(),
 		Validator:        NewAuthValidator(),
 	}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type disableMfaUseCase struct {
+	deps *Dependencies
+}
+
+func NewDisableMfaUseCase(deps *Dependencies) DisableMfaUseCase {
+	return &disableMfaUseCase{deps: deps}
+}
+
+// Execute removes the caller's secret and recovery codes. A current code is
+// asked for, so a stolen session alone cannot turn MFA off.
+func (uc *disableMfaUseCase) Execute(ctx context.Context, req *dto.MfaCodeRequest) error {
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return errors.ErrUnauthorized
+	}
+
+	role, _ := helpers.GetUserRole(ctx)
+	if mfaRequired(uc.deps, role) {
+		return errors.ErrMfaRequired
+	}
+
+	if err := uc.deps.Validator.ValidateMfaCodeRequest(req); err != nil {
+		return err
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	enrollment, err := uc.deps.MfaRepo.LockByUserId(ctx, tx, userId)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+	if enrollment == nil || !enrollment.IsEnabled() {
+		tx.Rollback()
+		return errors.ErrMfaNotEnabled
+	}
+
+	if err := verifyMfaCode(ctx, uc.deps, tx, enrollment, req.Code, req.RecoveryCode); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := uc.deps.MfaRepo.Delete(ctx, tx, userId); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDeletionFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Str("userId", userId).Msg("MFA disabled")
+	return nil
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+)
+
+type findMfaStatusUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindMfaStatusUseCase(deps *Dependencies) FindMfaStatusUseCase {
+	return &findMfaStatusUseCase{deps: deps}
+}
+
+func (uc *findMfaStatusUseCase) Execute(ctx context.Context) (*dto.MfaStatusResponse, error) {
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+	role, _ := helpers.GetUserRole(ctx)
+
+	enrollment, err := uc.deps.MfaRepo.GetByUserId(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	var recoveryCodes int64
+	if enrollment != nil && enrollment.IsEnabled() {
+		if recoveryCodes, err = uc.deps.MfaRepo.CountRecoveryCodes(ctx, userId); err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+		}
+	}
+
+	return uc.deps.Mapper.MfaStatusResponse(enrollment, recoveryCodes, mfaRequired(uc.deps, role)), nil
+}
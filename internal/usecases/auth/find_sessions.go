@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+)
+
+type findSessionsUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindSessionsUseCase(deps *Dependencies) FindSessionsUseCase {
+	return &findSessionsUseCase{deps: deps}
+}
+
+// Execute lists the caller's signed-in devices, marking the one the request
+// came from.
+func (uc *findSessionsUseCase) Execute(ctx context.Context, currentToken string) ([]*dto.SessionResponse, error) {
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	tokens, err := uc.deps.RefreshTokenRepo.GetActiveByUserId(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	familyId := currentFamily(ctx, uc.deps, userId, currentToken)
+
+	responses := make([]*dto.SessionResponse, 0, len(tokens))
+	for _, token := range tokens {
+		responses = append(responses, uc.deps.Mapper.SessionResponse(token, familyId))
+	}
+
+	return responses, nil
+}
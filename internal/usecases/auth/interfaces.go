@@ -22,7 +22,7 @@ type RefreshTokenUseCase interface {
 }
 
 type LogoutUseCase interface {
-	Execute(ctx context.Context, refreshToken string) error
+	Execute(ctx context.Context, refreshToken string, accessToken string) error
 }
 
 type ResendVerificationAccountUseCase interface {
@@ -40,3 +40,43 @@ type ForgetPasswordUseCase interface {
 type ResendForgetPasswordUseCase interface {
 	Execute(ctx context.Context, req *dto.ResendTokenRequest) error
 }
+
+type FindSessionsUseCase interface {
+	Execute(ctx context.Context, currentToken string) ([]*dto.SessionResponse, error)
+}
+
+type RevokeSessionUseCase interface {
+	Execute(ctx context.Context, sessionId string) error
+}
+
+type RevokeOtherSessionsUseCase interface {
+	Execute(ctx context.Context, currentToken string) (*dto.RevokeSessionsResponse, error)
+}
+
+type RevokeUserSessionsUseCase interface {
+	Execute(ctx context.Context, userId string) (*dto.RevokeSessionsResponse, error)
+}
+
+type VerifyMfaLoginUseCase interface {
+	Execute(ctx context.Context, req *dto.MfaLoginRequest) (*dto.LoginResponse, error)
+}
+
+type FindMfaStatusUseCase interface {
+	Execute(ctx context.Context) (*dto.MfaStatusResponse, error)
+}
+
+type StartMfaEnrollmentUseCase interface {
+	Execute(ctx context.Context) (*dto.MfaEnrollmentResponse, error)
+}
+
+type ConfirmMfaEnrollmentUseCase interface {
+	Execute(ctx context.Context, req *dto.MfaCodeRequest) (*dto.MfaRecoveryCodesResponse, error)
+}
+
+type RegenerateRecoveryCodesUseCase interface {
+	Execute(ctx context.Context, req *dto.MfaCodeRequest) (*dto.MfaRecoveryCodesResponse, error)
+}
+
+type DisableMfaUseCase interface {
+	Execute(ctx context.Context, req *dto.MfaCodeRequest) error
+}
@@ -2,8 +2,10 @@ package auth
 
 import (
 	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
 	"backend-golang/internal/errors"
 	"context"
+	"fmt"
 
 	"github.com/rs/zerolog/log"
 	"golang.org/x/crypto/bcrypt"
@@ -48,25 +50,101 @@ func (uc *loginUseCase) Execute(ctx context.Context, req *dto.LoginRequest) (*dt
 
 	uc.deps.RateLimiter.ClearFailedAttempts(ctx, req.Identifier)
 
-	accessToken, err := uc.deps.TokenService.GenerateAccessToken(user.Id, user.Role)
+	enrollment, err := uc.deps.MfaRepo.GetByUserId(ctx, user.Id)
+	if err != nil {
+		log.Error().Err(err).Str("userId", user.Id).Msg("Failed to get MFA enrollment")
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	if (enrollment != nil && enrollment.IsEnabled()) || mfaRequired(uc.deps, user.Role) {
+		return uc.challenge(ctx, user, enrollment)
+	}
+
+	return issueSession(ctx, uc.deps, user, req.UserAgent, req.IpAddress)
+}
+
+// challenge stops the login after the password. A user who has to use MFA
+// but has not confirmed an enrollment is shown its secret to enrol with; the
+// MFA step confirms it. A pending secret is kept across logins, so logging in
+// again cannot replace an authenticator that is being set up. Only the
+// authenticated enrollment endpoint issues a new one.
+func (uc *loginUseCase) challenge(ctx context.Context, user *entities.User, enrollment *entities.MfaEnrollment) (*dto.LoginResponse, error) {
+	if enrollment == nil {
+		pending, _, err := uc.deps.Mapper.CreateMfaEnrollment(user.Id)
+		if err != nil {
+			log.Error().Err(err).Str("userId", user.Id).Msg("Failed to create MFA enrollment")
+			return nil, errors.ErrInternalServer
+		}
+
+		tx := uc.deps.TxRepo.Begin(ctx)
+		defer func() {
+			if r := recover(); r != nil {
+				tx.Rollback()
+			}
+		}()
+
+		if err := uc.deps.MfaRepo.Create(ctx, tx, pending); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+		}
+
+		if err := tx.Commit().Error; err != nil {
+			return nil, fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+		}
+
+		// A concurrent login may have enrolled first; its secret is the one kept.
+		if enrollment, err = uc.deps.MfaRepo.GetByUserId(ctx, user.Id); err != nil || enrollment == nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+		}
+	}
+
+	var enrollmentResponse *dto.MfaEnrollmentResponse
+	if !enrollment.IsEnabled() {
+		secret, err := uc.deps.Mapper.MfaSecret(enrollment)
+		if err != nil {
+			log.Error().Err(err).Str("userId", user.Id).Msg("Failed to decrypt MFA secret")
+			return nil, errors.ErrInternalServer
+		}
+
+		enrollmentResponse = uc.deps.Mapper.MfaEnrollmentResponse(user, secret)
+	}
+
+	token, expiresAt, err := uc.deps.MfaChallenge.Create(ctx, user.Id)
+	if err != nil {
+		log.Error().Err(err).Str("userId", user.Id).Msg("Failed to create MFA challenge")
+		return nil, errors.ErrGenerateToken
+	}
+
+	log.Info().
+		Str("userId", user.Id).
+		Bool("enrolling", enrollmentResponse != nil).
+		Msg("Password accepted, MFA required")
+
+	return uc.deps.Mapper.MfaChallengeResponse(user, token, expiresAt, enrollmentResponse), nil
+}
+
+// issueSession signs the user in on this device: an access token and a new
+// refresh token family.
+func issueSession(ctx context.Context, deps *Dependencies, user *entities.User, userAgent string, ipAddress string) (*dto.LoginResponse, error) {
+	accessToken, err := deps.TokenService.GenerateAccessToken(user.Id, user.Role)
 	if err != nil {
 		log.Error().Err(err).Str("userId", user.Id).Msg("Failed to generate access token")
 		return nil, errors.ErrGenerateToken
 	}
 
-	refreshToken, err := uc.deps.Mapper.CreateRefreshToken(user.Id)
+	refreshToken, err := deps.Mapper.CreateRefreshToken(user.Id, userAgent, ipAddress)
 	if err != nil {
 		log.Error().Err(err).Str("userId", user.Id).Msg("Failed to create refresh token")
 	}
 
 	if refreshToken != nil {
-		if err := uc.deps.RefreshTokenRepo.Create(ctx, refreshToken); err != nil {
+		if err := deps.RefreshTokenRepo.Create(ctx, refreshToken); err != nil {
 			log.Error().Err(err).Str("userId", user.Id).Msg("Failed to save refresh token")
 			return nil, errors.ErrSaveRefreshToken
 		}
 	}
 
-	response := uc.deps.Mapper.LoginResponse(user, refreshToken)
+	response := deps.Mapper.LoginResponse(user, refreshToken)
 	response.AccessToken = accessToken
 
 	log.Info().
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
 	"context"
 
 	"github.com/rs/zerolog/log"
@@ -15,7 +16,9 @@ func NewLogoutUseCase(deps *Dependencies) LogoutUseCase {
 	return &logoutUseCase{deps: deps}
 }
 
-func (uc *logoutUseCase) Execute(ctx context.Context, refreshToken string) error {
+// Execute ends the session of the refresh token and denies the access token
+// sent along with it, if any, so it cannot be used for the rest of its life.
+func (uc *logoutUseCase) Execute(ctx context.Context, refreshToken string, accessToken string) error {
 	if refreshToken == "" {
 		log.Warn().Msg("Logout attempt with empty refresh token")
 		return errors.ErrInvalidRefreshToken
@@ -26,6 +29,15 @@ func (uc *logoutUseCase) Execute(ctx context.Context, refreshToken string) error
 		return errors.ErrInvalidRefreshToken
 	}
 
+	if accessToken != "" {
+		if claims, err := helpers.ParseAccessToken(accessToken); err == nil {
+			if err := uc.deps.TokenDenylist.RevokeToken(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+				log.Error().Err(err).Str("userId", claims.Subject).Msg("Failed to deny access token during logout")
+				return errors.ErrInternalServer
+			}
+		}
+	}
+
 	log.Info().Str("token", refreshToken[:10]+"...").Msg("User logged out successfully")
 	return nil
 }
@@ -5,25 +5,55 @@ import (
 	"backend-golang/internal/constants"
 	"backend-golang/internal/domain/entities"
 	"backend-golang/internal/helpers"
+	"backend-golang/internal/infrastructure/config"
+	"fmt"
 	"time"
+
+	"github.com/rs/zerolog/log"
 )
 
 type Mapper interface {
 	RegisterRequestToUser(req *dto.RegisterRequest) (*entities.User, error)
 
 	CreateVerificationToken(userId string) (*entities.VerificationToken, error)
-	CreateRefreshToken(userId string) (*entities.RefreshToken, error)
+	CreateRefreshToken(userId string, userAgent string, ipAddress string) (*entities.RefreshToken, error)
+	RotateRefreshToken(current *entities.RefreshToken, userAgent string, ipAddress string) (*entities.RefreshToken, error)
 
 	ResetPasswordRequestToUser(req *dto.ResetPasswordRequest) (*entities.User, error)
 
 	LoginResponse(user *entities.User, refreshToken *entities.RefreshToken) *dto.LoginResponse
 	RefreshTokenToResponse(token *entities.RefreshToken) *dto.RefreshTokenResponse
+	SessionResponse(token *entities.RefreshToken, currentFamilyId string) *dto.SessionResponse
+
+	CreateMfaEnrollment(userId string) (*entities.MfaEnrollment, string, error)
+	MfaSecret(enrollment *entities.MfaEnrollment) (string, error)
+	CreateRecoveryCodes(userId string) ([]entities.MfaRecoveryCode, []string, error)
+	MfaEnrollmentResponse(user *entities.User, secret string) *dto.MfaEnrollmentResponse
+	MfaChallengeResponse(user *entities.User, token string, expiresAt time.Time, enrollment *dto.MfaEnrollmentResponse) *dto.LoginResponse
+	MfaStatusResponse(enrollment *entities.MfaEnrollment, recoveryCodes int64, required bool) *dto.MfaStatusResponse
 }
 
-type authMapper struct{}
+// maxUserAgentLength matches the refresh_tokens.user_agent column.
+const maxUserAgentLength = 255
+
+// recoveryCodeCount is how many recovery codes a user holds at a time.
+const recoveryCodeCount = 10
+
+type authMapper struct {
+	encryptionKey string
+	mfaIssuer     string
+}
 
 func NewAuthMapper() Mapper {
-	return &authMapper{}
+	key := config.GetEnv("ENCRYPTION_KEY", "")
+	if key == "" {
+		log.Fatal().Err(fmt.Errorf("missing encrypted key"))
+	}
+
+	return &authMapper{
+		encryptionKey: key,
+		mfaIssuer:     config.GetEnv("MFA_ISSUER", "Klinik Puspa"),
+	}
 }
 
 func (m *authMapper) RegisterRequestToUser(req *dto.RegisterRequest) (*entities.User, error) {
@@ -66,16 +96,51 @@ func (m *authMapper) CreateVerificationToken(userId string) (*entities.Verificat
 	return verificationCode, nil
 }
 
-func (m *authMapper) CreateRefreshToken(userId string) (*entities.RefreshToken, error) {
+// CreateRefreshToken starts a new token family, that is a new session.
+func (m *authMapper) CreateRefreshToken(userId string, userAgent string, ipAddress string) (*entities.RefreshToken, error) {
 	tokenString, expiresAt, _ := helpers.GenerateRefreshToken()
 
+	now := time.Now()
 	refreshToken := &entities.RefreshToken{
-		UserId:    userId,
-		Token:     tokenString,
-		ExpiresAt: expiresAt,
-		Revoked:   false,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		UserId:     userId,
+		Token:      tokenString,
+		FamilyId:   helpers.GenerateULID(),
+		UserAgent:  truncate(userAgent, maxUserAgentLength),
+		IpAddress:  ipAddress,
+		SignedInAt: now,
+		LastUsedAt: now,
+		ExpiresAt:  expiresAt,
+		Revoked:    false,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	return refreshToken, nil
+}
+
+// RotateRefreshToken issues the successor of current in the same family,
+// keeping when the session signed in and noting where it was last used from.
+// The successor inherits the expiry, so a session ends a fixed time after
+// login however often it is refreshed.
+func (m *authMapper) RotateRefreshToken(current *entities.RefreshToken, userAgent string, ipAddress string) (*entities.RefreshToken, error) {
+	tokenString, _, err := helpers.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	refreshToken := &entities.RefreshToken{
+		UserId:     current.UserId,
+		Token:      tokenString,
+		FamilyId:   current.FamilyId,
+		UserAgent:  truncate(userAgent, maxUserAgentLength),
+		IpAddress:  ipAddress,
+		SignedInAt: current.SignedInAt,
+		LastUsedAt: now,
+		ExpiresAt:  current.ExpiresAt,
+		Revoked:    false,
+		CreatedAt:  now,
+		UpdatedAt:  now,
 	}
 
 	return refreshToken, nil
@@ -115,3 +180,115 @@ func (m *authMapper) RefreshTokenToResponse(token *entities.RefreshToken) *dto.R
 		ExpiresAt:    token.ExpiresAt.Format("2006-01-02 15:04:05"),
 	}
 }
+
+func (m *authMapper) SessionResponse(token *entities.RefreshToken, currentFamilyId string) *dto.SessionResponse {
+	return &dto.SessionResponse{
+		SessionId:  token.FamilyId,
+		UserAgent:  token.UserAgent,
+		IpAddress:  token.IpAddress,
+		Current:    token.FamilyId == currentFamilyId,
+		SignedInAt: token.SignedInAt.Format("2006-01-02 15:04:05"),
+		LastUsedAt: token.LastUsedAt.Format("2006-01-02 15:04:05"),
+		ExpiresAt:  token.ExpiresAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// CreateMfaEnrollment starts a pending enrollment with a new secret. The
+// secret is returned in the clear once, for the user to add to their app.
+func (m *authMapper) CreateMfaEnrollment(userId string) (*entities.MfaEnrollment, string, error) {
+	secret, err := helpers.GenerateTOTPSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	encrypted, err := helpers.EncryptData([]byte(secret), m.encryptionKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	enrollment := &entities.MfaEnrollment{
+		UserId:    userId,
+		Secret:    encrypted,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return enrollment, secret, nil
+}
+
+func (m *authMapper) MfaSecret(enrollment *entities.MfaEnrollment) (string, error) {
+	secret, err := helpers.DecryptData(enrollment.Secret, m.encryptionKey)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+// CreateRecoveryCodes returns the codes to store, hashed, and the same codes
+// in the clear to show the user once.
+func (m *authMapper) CreateRecoveryCodes(userId string) ([]entities.MfaRecoveryCode, []string, error) {
+	plain, err := helpers.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codes := make([]entities.MfaRecoveryCode, 0, len(plain))
+	for _, code := range plain {
+		codes = append(codes, entities.MfaRecoveryCode{
+			UserId:   userId,
+			CodeHash: helpers.HashRecoveryCode(code),
+		})
+	}
+
+	return codes, plain, nil
+}
+
+func (m *authMapper) MfaEnrollmentResponse(user *entities.User, secret string) *dto.MfaEnrollmentResponse {
+	return &dto.MfaEnrollmentResponse{
+		Secret:     secret,
+		OtpauthUri: helpers.TOTPProvisioningURI(m.mfaIssuer, user.Email, secret),
+	}
+}
+
+func (m *authMapper) MfaChallengeResponse(user *entities.User, token string, expiresAt time.Time, enrollment *dto.MfaEnrollmentResponse) *dto.LoginResponse {
+	return &dto.LoginResponse{
+		Id:          user.Id,
+		Username:    user.Username,
+		Email:       user.Email,
+		Role:        user.Role,
+		CreatedAt:   user.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   user.UpdatedAt.Format("2006-01-02 15:04:05"),
+		MfaRequired: true,
+		MfaChallenge: &dto.MfaChallengeResponse{
+			ChallengeToken: token,
+			ExpiresAt:      expiresAt.Format("2006-01-02 15:04:05"),
+			Enrollment:     enrollment,
+		},
+	}
+}
+
+func (m *authMapper) MfaStatusResponse(enrollment *entities.MfaEnrollment, recoveryCodes int64, required bool) *dto.MfaStatusResponse {
+	response := &dto.MfaStatusResponse{
+		Required:               required,
+		RecoveryCodesRemaining: recoveryCodes,
+	}
+
+	if enrollment != nil {
+		response.Enabled = enrollment.IsEnabled()
+		response.Pending = !enrollment.IsEnabled()
+		if enrollment.EnabledAt != nil {
+			enabledAt := enrollment.EnabledAt.Format("2006-01-02 15:04:05")
+			response.EnabledAt = &enabledAt
+		}
+	}
+
+	return response
+}
+
+func truncate(value string, length int) string {
+	if len(value) <= length {
+		return value
+	}
+	return value[:length]
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// mfaRequired reports whether policy forces the role through MFA at login.
+func mfaRequired(deps *Dependencies, role string) bool {
+	return deps.RequireAdminMfa && role == string(constants.RoleAdmin)
+}
+
+// verifyMfaCode checks a code from the authenticator app, or a recovery code
+// once MFA is enabled, against an enrollment locked in tx. An app code is
+// accepted once, so one read over someone's shoulder cannot be replayed.
+// Wrong codes count towards a lockout like wrong passwords do.
+func verifyMfaCode(ctx context.Context, deps *Dependencies, tx *gorm.DB, enrollment *entities.MfaEnrollment, code string, recoveryCode string) error {
+	attemptKey := "mfa:" + enrollment.UserId
+	if err := deps.RateLimiter.CheckLoginRateLimit(ctx, attemptKey); err != nil {
+		return errors.ErrTooManyMfaAttempts
+	}
+
+	valid, err := matchMfaCode(ctx, deps, tx, enrollment, code, recoveryCode)
+	if err != nil {
+		return errors.ErrInternalServer
+	}
+
+	if !valid {
+		deps.RateLimiter.IncrementFailedAttempts(ctx, attemptKey)
+		return errors.ErrInvalidMfaCode
+	}
+
+	deps.RateLimiter.ClearFailedAttempts(ctx, attemptKey)
+	return nil
+}
+
+func matchMfaCode(ctx context.Context, deps *Dependencies, tx *gorm.DB, enrollment *entities.MfaEnrollment, code string, recoveryCode string) (bool, error) {
+	if code != "" {
+		secret, err := deps.Mapper.MfaSecret(enrollment)
+		if err != nil {
+			return false, err
+		}
+
+		step, ok := helpers.ValidateTOTP(secret, code, time.Now())
+		if !ok || step <= enrollment.LastUsedStep {
+			return false, nil
+		}
+
+		enrollment.LastUsedStep = step
+		return true, deps.MfaRepo.Update(ctx, tx, enrollment)
+	}
+
+	if recoveryCode == "" || !enrollment.IsEnabled() {
+		return false, nil
+	}
+
+	return deps.MfaRepo.UseRecoveryCode(ctx, tx, enrollment.UserId, helpers.HashRecoveryCode(recoveryCode))
+}
+
+// enableMfa turns a confirmed pending enrollment on and hands out the first
+// set of recovery codes.
+func enableMfa(ctx context.Context, deps *Dependencies, tx *gorm.DB, enrollment *entities.MfaEnrollment) ([]string, error) {
+	now := time.Now()
+	enrollment.EnabledAt = &now
+	if err := deps.MfaRepo.Update(ctx, tx, enrollment); err != nil {
+		return nil, err
+	}
+
+	return replaceRecoveryCodes(ctx, deps, tx, enrollment.UserId)
+}
+
+func replaceRecoveryCodes(ctx context.Context, deps *Dependencies, tx *gorm.DB, userId string) ([]string, error) {
+	codes, plain, err := deps.Mapper.CreateRecoveryCodes(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deps.MfaRepo.ReplaceRecoveryCodes(ctx, tx, userId, codes); err != nil {
+		return nil, err
+	}
+
+	return plain, nil
+}
@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/helpers"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const testMfaSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// fakeMfaRepo keeps the last enrollment update and the unused recovery code
+// hashes; other methods are not expected to be called.
+type fakeMfaRepo struct {
+	repositories.MfaRepository
+	updated *entities.MfaEnrollment
+	unused  map[string]bool
+}
+
+func (r *fakeMfaRepo) Update(ctx context.Context, tx *gorm.DB, enrollment *entities.MfaEnrollment) error {
+	copied := *enrollment
+	r.updated = &copied
+	return nil
+}
+
+func (r *fakeMfaRepo) UseRecoveryCode(ctx context.Context, tx *gorm.DB, userId string, codeHash string) (bool, error) {
+	if !r.unused[codeHash] {
+		return false, nil
+	}
+	delete(r.unused, codeHash)
+	return true, nil
+}
+
+type fakeMfaMapper struct {
+	Mapper
+}
+
+func (fakeMfaMapper) MfaSecret(enrollment *entities.MfaEnrollment) (string, error) {
+	return string(enrollment.Secret), nil
+}
+
+// currentTOTP is the code an authenticator app shows right now, with its step.
+func currentTOTP(t *testing.T, secret string) (string, int64) {
+	t.Helper()
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		t.Fatalf("decode secret: %v", err)
+	}
+
+	step := time.Now().Unix() / 30
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%06d", value%1000000), step
+}
+
+func TestMatchMfaCodeRefusesReplayedStep(t *testing.T) {
+	code, step := currentTOTP(t, testMfaSecret)
+
+	tests := []struct {
+		name         string
+		lastUsedStep int64
+		want         bool
+	}{
+		{name: "no code used yet", lastUsedStep: 0, want: true},
+		{name: "earlier code used", lastUsedStep: step - 1, want: true},
+		{name: "same code used", lastUsedStep: step},
+		{name: "later code used", lastUsedStep: step + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeMfaRepo{}
+			deps := &Dependencies{MfaRepo: repo, Mapper: fakeMfaMapper{}}
+			enrollment := &entities.MfaEnrollment{
+				UserId:       "user-1",
+				Secret:       []byte(testMfaSecret),
+				LastUsedStep: tt.lastUsedStep,
+			}
+
+			got, err := matchMfaCode(context.Background(), deps, nil, enrollment, code, "")
+			if err != nil {
+				t.Fatalf("matchMfaCode: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("matchMfaCode = %v, want %v", got, tt.want)
+			}
+
+			if !tt.want {
+				if repo.updated != nil {
+					t.Errorf("a refused code moved LastUsedStep to %d", repo.updated.LastUsedStep)
+				}
+				return
+			}
+			// Allow for the clock crossing into the next period mid-test.
+			if repo.updated == nil || repo.updated.LastUsedStep < step {
+				t.Fatalf("LastUsedStep not stored after an accepted code: %+v", repo.updated)
+			}
+
+			again, err := matchMfaCode(context.Background(), deps, nil, repo.updated, code, "")
+			if err != nil {
+				t.Fatalf("matchMfaCode replay: %v", err)
+			}
+			if again {
+				t.Error("the same code was accepted twice")
+			}
+		})
+	}
+}
+
+func TestMatchMfaCodeUsesRecoveryCodeOnce(t *testing.T) {
+	const recoveryCode = "abcde-fghij"
+	enabledAt := time.Now()
+
+	tests := []struct {
+		name      string
+		enabledAt *time.Time
+		typed     []string
+		want      []bool
+	}{
+		{
+			name:      "second use refused",
+			enabledAt: &enabledAt,
+			typed:     []string{recoveryCode, recoveryCode},
+			want:      []bool{true, false},
+		},
+		{
+			name:      "retyped differently still counts as used",
+			enabledAt: &enabledAt,
+			typed:     []string{"ABCDE FGHIJ", recoveryCode},
+			want:      []bool{true, false},
+		},
+		{
+			name:      "unknown code",
+			enabledAt: &enabledAt,
+			typed:     []string{"zzzzz-zzzzz", recoveryCode},
+			want:      []bool{false, true},
+		},
+		{
+			name:  "pending enrollment",
+			typed: []string{recoveryCode},
+			want:  []bool{false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeMfaRepo{unused: map[string]bool{helpers.HashRecoveryCode(recoveryCode): true}}
+			deps := &Dependencies{MfaRepo: repo, Mapper: fakeMfaMapper{}}
+			enrollment := &entities.MfaEnrollment{UserId: "user-1", EnabledAt: tt.enabledAt}
+
+			for i, typed := range tt.typed {
+				got, err := matchMfaCode(context.Background(), deps, nil, enrollment, "", typed)
+				if err != nil {
+					t.Fatalf("matchMfaCode(%q): %v", typed, err)
+				}
+				if got != tt.want[i] {
+					t.Fatalf("attempt %d with %q = %v, want %v", i+1, typed, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"backend-golang/internal/adapters/http/dto"
 	"backend-golang/internal/errors"
 	"context"
+	"fmt"
 
 	"github.com/rs/zerolog/log"
 )
@@ -16,42 +17,97 @@ func NewRefreshTokenUseCase(deps *Dependencies) RefreshTokenUseCase {
 	return &refreshTokenUseCase{deps: deps}
 }
 
+// Execute exchanges a refresh token for a new access token and a new refresh
+// token of the same family. Presenting a token that was already exchanged
+// revokes the whole family, since either the client or an attacker holds a
+// stolen copy, and denies the user's access tokens already handed out.
 func (uc *refreshTokenUseCase) Execute(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error) {
 	if req.RefreshToken == "" {
 		log.Warn().Msg("Refresh token cannot be nil")
 		return nil, errors.ErrInvalidRefreshToken
 	}
 
-	refreshToken, err := uc.deps.RefreshTokenRepo.GetByToken(ctx, req.RefreshToken)
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	refreshToken, err := uc.deps.RefreshTokenRepo.LockByToken(ctx, tx, req.RefreshToken)
 	if err != nil {
-		log.Warn().Str("token", req.RefreshToken[:10]+"...").Msg("Invalid refresh token provided")
+		tx.Rollback()
+		log.Warn().Err(err).Msg("Invalid refresh token provided")
 		return nil, errors.ErrInvalidRefreshToken
 	}
 
+	if refreshToken.IsRotated() {
+		if err := uc.deps.RefreshTokenRepo.RevokeFamily(ctx, tx, refreshToken.UserId, refreshToken.FamilyId); err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Str("userId", refreshToken.UserId).Msg("Failed to revoke refresh token family")
+			return nil, errors.ErrInternalServer
+		}
+
+		if err := tx.Commit().Error; err != nil {
+			return nil, fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+		}
+
+		if err := uc.deps.TokenDenylist.RevokeUser(ctx, refreshToken.UserId); err != nil {
+			log.Error().Err(err).Str("userId", refreshToken.UserId).Msg("Failed to deny access tokens after refresh token reuse")
+			return nil, errors.ErrInternalServer
+		}
+
+		log.Warn().
+			Str("userId", refreshToken.UserId).
+			Str("familyId", refreshToken.FamilyId).
+			Msg("Rotated refresh token reused, token family revoked")
+		return nil, errors.ErrRefreshTokenReused
+	}
+
 	if err := refreshToken.IsValid(); err != nil {
+		tx.Rollback()
 		return nil, errors.ErrInvalidRefreshToken
 	}
 
 	user, err := uc.deps.UserRepo.GetById(ctx, refreshToken.UserId)
 	if err != nil {
+		tx.Rollback()
 		log.Error().Err(err).Str("user_id", refreshToken.UserId).Msg("User associated with refresh token not found")
 		return nil, errors.ErrUserNotFound
 	}
 
 	if !user.IsActive {
+		tx.Rollback()
 		log.Warn().Str("userId", user.Id).Msg("Refresh token request for inactive user")
 		return nil, errors.ErrUserInactive
 	}
 
+	nextToken, err := uc.deps.Mapper.RotateRefreshToken(refreshToken, req.UserAgent, req.IpAddress)
+	if err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Str("userId", user.Id).Msg("Failed to create refresh token")
+		return nil, errors.ErrGenerateToken
+	}
+
+	if err := uc.deps.RefreshTokenRepo.Rotate(ctx, tx, refreshToken, nextToken); err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Str("userId", user.Id).Msg("Failed to rotate refresh token")
+		return nil, errors.ErrSaveRefreshToken
+	}
+
 	newAccessToken, err := uc.deps.TokenService.GenerateAccessToken(user.Id, user.Role)
 	if err != nil {
+		tx.Rollback()
 		log.Error().Err(err).Str("userId", user.Id).Msg("Failed to generate access token")
 		return nil, errors.ErrGenerateToken
 	}
 
-	response := uc.deps.Mapper.RefreshTokenToResponse(refreshToken)
+	if err := tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	response := uc.deps.Mapper.RefreshTokenToResponse(nextToken)
 	response.AccessToken = newAccessToken
-	response.ExpiresAt = refreshToken.ExpiresAt.Format("2006-01-02 15:04:05")
 
 	log.Info().Str("user_id", refreshToken.UserId).Msg("Access token refreshed successfully")
 	return response, nil
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type regenerateRecoveryCodesUseCase struct {
+	deps *Dependencies
+}
+
+func NewRegenerateRecoveryCodesUseCase(deps *Dependencies) RegenerateRecoveryCodesUseCase {
+	return &regenerateRecoveryCodesUseCase{deps: deps}
+}
+
+// Execute replaces every recovery code, used or not, with a new set.
+func (uc *regenerateRecoveryCodesUseCase) Execute(ctx context.Context, req *dto.MfaCodeRequest) (*dto.MfaRecoveryCodesResponse, error) {
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	if err := uc.deps.Validator.ValidateMfaCodeRequest(req); err != nil {
+		return nil, err
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	enrollment, err := uc.deps.MfaRepo.LockByUserId(ctx, tx, userId)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+	if enrollment == nil || !enrollment.IsEnabled() {
+		tx.Rollback()
+		return nil, errors.ErrMfaNotEnabled
+	}
+
+	if err := verifyMfaCode(ctx, uc.deps, tx, enrollment, req.Code, req.RecoveryCode); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	recoveryCodes, err := replaceRecoveryCodes(ctx, uc.deps, tx, userId)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Str("userId", userId).Msg("MFA recovery codes regenerated")
+	return &dto.MfaRecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
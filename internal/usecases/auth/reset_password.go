@@ -55,6 +55,16 @@ func (uc *resetPasswordUseCase) Execute(ctx context.Context, req *dto.ResetPassw
 		return errors.ErrInternalServer
 	}
 
+	if _, err := uc.deps.RefreshTokenRepo.RevokeAllByUser(ctx, verificationToken.UserId, ""); err != nil {
+		log.Error().Err(err).Str("userId", verificationToken.UserId).Msg("Failed to revoke sessions after password reset")
+		return errors.ErrInternalServer
+	}
+
+	if err := uc.deps.TokenDenylist.RevokeUser(ctx, verificationToken.UserId); err != nil {
+		log.Error().Err(err).Str("userId", verificationToken.UserId).Msg("Failed to deny access tokens after password reset")
+		return errors.ErrInternalServer
+	}
+
 	log.Info().
 		Str("userId", verificationToken.UserId).
 		Msg("Update password successfully")
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type revokeOtherSessionsUseCase struct {
+	deps *Dependencies
+}
+
+func NewRevokeOtherSessionsUseCase(deps *Dependencies) RevokeOtherSessionsUseCase {
+	return &revokeOtherSessionsUseCase{deps: deps}
+}
+
+// Execute signs the caller out everywhere except the session the request came
+// from, which must be known so it is not revoked by accident.
+func (uc *revokeOtherSessionsUseCase) Execute(ctx context.Context, currentToken string) (*dto.RevokeSessionsResponse, error) {
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	familyId := currentFamily(ctx, uc.deps, userId, currentToken)
+	if familyId == "" {
+		return nil, errors.ErrInvalidRefreshToken
+	}
+
+	revoked, err := uc.deps.RefreshTokenRepo.RevokeAllByUser(ctx, userId, familyId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	log.Info().Str("userId", userId).Int64("revoked", revoked).Msg("Other sessions revoked")
+	return &dto.RevokeSessionsResponse{Revoked: revoked}, nil
+}
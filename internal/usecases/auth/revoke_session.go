@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
+
+type revokeSessionUseCase struct {
+	deps *Dependencies
+}
+
+func NewRevokeSessionUseCase(deps *Dependencies) RevokeSessionUseCase {
+	return &revokeSessionUseCase{deps: deps}
+}
+
+// Execute signs the caller out of one of their sessions. Access tokens
+// already issued to it stay valid until they expire; a user-wide revocation
+// is what cuts those off.
+func (uc *revokeSessionUseCase) Execute(ctx context.Context, sessionId string) error {
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return errors.ErrUnauthorized
+	}
+
+	if err := uc.deps.RefreshTokenRepo.RevokeSession(ctx, userId, sessionId); err != nil {
+		log.Warn().Err(err).Str("userId", userId).Str("sessionId", sessionId).Msg("Failed to revoke session")
+		return errors.ErrSessionNotFound
+	}
+
+	log.Info().Str("userId", userId).Str("sessionId", sessionId).Msg("Session revoked")
+	return nil
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type revokeUserSessionsUseCase struct {
+	deps *Dependencies
+}
+
+func NewRevokeUserSessionsUseCase(deps *Dependencies) RevokeUserSessionsUseCase {
+	return &revokeUserSessionsUseCase{deps: deps}
+}
+
+// Execute lets an admin sign a user out of every device, for instance when an
+// account is compromised or deactivated. Access tokens already issued are
+// denied as well.
+func (uc *revokeUserSessionsUseCase) Execute(ctx context.Context, userId string) (*dto.RevokeSessionsResponse, error) {
+	adminId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	if _, err := uc.deps.UserRepo.GetById(ctx, userId); err != nil {
+		return nil, errors.ErrUserNotFound
+	}
+
+	revoked, err := uc.deps.RefreshTokenRepo.RevokeAllByUser(ctx, userId, "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := uc.deps.TokenDenylist.RevokeUser(ctx, userId); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	log.Info().Str("adminId", adminId).Str("userId", userId).Int64("revoked", revoked).Msg("All sessions of user revoked")
+	return &dto.RevokeSessionsResponse{Revoked: revoked}, nil
+}
@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"context"
+)
+
+// currentFamily resolves the session the request was made from, through the
+// refresh token cookie. It is empty when the cookie is missing or belongs to
+// someone else.
+func currentFamily(ctx context.Context, deps *Dependencies, userId string, currentToken string) string {
+	if currentToken == "" {
+		return ""
+	}
+
+	token, err := deps.RefreshTokenRepo.GetByToken(ctx, currentToken)
+	if err != nil || token.UserId != userId {
+		return ""
+	}
+
+	return token.FamilyId
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type startMfaEnrollmentUseCase struct {
+	deps *Dependencies
+}
+
+func NewStartMfaEnrollmentUseCase(deps *Dependencies) StartMfaEnrollmentUseCase {
+	return &startMfaEnrollmentUseCase{deps: deps}
+}
+
+// Execute issues a new secret for the caller. Starting again before
+// confirming replaces the previous secret.
+func (uc *startMfaEnrollmentUseCase) Execute(ctx context.Context) (*dto.MfaEnrollmentResponse, error) {
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	user, err := uc.deps.UserRepo.GetById(ctx, userId)
+	if err != nil {
+		return nil, errors.ErrUserNotFound
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	existing, err := uc.deps.MfaRepo.LockByUserId(ctx, tx, userId)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+	if existing != nil && existing.IsEnabled() {
+		tx.Rollback()
+		return nil, errors.ErrMfaAlreadyEnabled
+	}
+
+	enrollment, secret, err := uc.deps.Mapper.CreateMfaEnrollment(userId)
+	if err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Str("userId", userId).Msg("Failed to create MFA enrollment")
+		return nil, errors.ErrInternalServer
+	}
+
+	if err := uc.deps.MfaRepo.Save(ctx, tx, enrollment); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return uc.deps.Mapper.MfaEnrollmentResponse(user, secret), nil
+}
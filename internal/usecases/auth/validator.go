@@ -13,6 +13,8 @@ type Validator interface {
 	VerificationAccountRequest(req *dto.VerifyTokenRequest) error
 	ValidateForgetPasswordRequest(req *dto.ForgetPasswordRequest) error
 	ValidateLoginRequest(req *dto.LoginRequest) error
+	ValidateMfaLoginRequest(req *dto.MfaLoginRequest) error
+	ValidateMfaCodeRequest(req *dto.MfaCodeRequest) error
 }
 
 type authValidator struct{}
@@ -80,3 +82,27 @@ func (v *authValidator) ValidateLoginRequest(req *dto.LoginRequest) error {
 
 	return nil
 }
+
+func (v *authValidator) ValidateMfaLoginRequest(req *dto.MfaLoginRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	if req.Code == "" && req.RecoveryCode == "" {
+		return errors.ErrMfaCodeRequired
+	}
+
+	return nil
+}
+
+func (v *authValidator) ValidateMfaCodeRequest(req *dto.MfaCodeRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	if req.Code == "" && req.RecoveryCode == "" {
+		return errors.ErrMfaCodeRequired
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/services"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type verifyMfaLoginUseCase struct {
+	deps *Dependencies
+}
+
+func NewVerifyMfaLoginUseCase(deps *Dependencies) VerifyMfaLoginUseCase {
+	return &verifyMfaLoginUseCase{deps: deps}
+}
+
+// Execute finishes a login stopped at the MFA step. The challenge is taken
+// before the code is checked, so it can be used by one request only; a wrong
+// code hands it back until its attempts run out. For a user enrolling at
+// login the code also confirms the new secret, and the response carries the
+// recovery codes, which are not shown again.
+func (uc *verifyMfaLoginUseCase) Execute(ctx context.Context, req *dto.MfaLoginRequest) (*dto.LoginResponse, error) {
+	if err := uc.deps.Validator.ValidateMfaLoginRequest(req); err != nil {
+		return nil, err
+	}
+
+	challenge, err := uc.deps.MfaChallenge.Take(ctx, req.ChallengeToken)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to take MFA challenge")
+		return nil, errors.ErrInternalServer
+	}
+	if challenge == nil {
+		return nil, errors.ErrMfaChallengeInvalid
+	}
+	userId := challenge.UserId
+
+	user, err := uc.deps.UserRepo.GetById(ctx, userId)
+	if err != nil {
+		log.Warn().Err(err).Str("userId", userId).Msg("User of MFA challenge not found")
+		return nil, errors.ErrMfaChallengeInvalid
+	}
+
+	if !user.IsActive {
+		return nil, errors.ErrUserInactive
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	enrollment, err := uc.deps.MfaRepo.LockByUserId(ctx, tx, userId)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+	if enrollment == nil {
+		tx.Rollback()
+		return nil, errors.ErrMfaChallengeInvalid
+	}
+
+	enrolling := !enrollment.IsEnabled()
+	if enrolling && req.Code == "" {
+		tx.Rollback()
+		uc.retry(ctx, challenge)
+		return nil, errors.ErrMfaCodeRequired
+	}
+
+	if err := verifyMfaCode(ctx, uc.deps, tx, enrollment, req.Code, req.RecoveryCode); err != nil {
+		tx.Rollback()
+		log.Warn().Err(err).Str("userId", userId).Msg("MFA verification failed")
+		if err == errors.ErrInvalidMfaCode {
+			uc.retry(ctx, challenge)
+		}
+		return nil, err
+	}
+
+	var recoveryCodes []string
+	if enrolling {
+		if recoveryCodes, err = enableMfa(ctx, uc.deps, tx, enrollment); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	if enrolling {
+		log.Info().Str("userId", userId).Msg("MFA enabled at login")
+	}
+
+	response, err := issueSession(ctx, uc.deps, user, req.UserAgent, req.IpAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	response.RecoveryCodes = recoveryCodes
+	return response, nil
+}
+
+func (uc *verifyMfaLoginUseCase) retry(ctx context.Context, challenge *services.MfaChallenge) {
+	if err := uc.deps.MfaChallenge.Retry(ctx, challenge); err != nil {
+		log.Error().Err(err).Str("userId", challenge.UserId).Msg("Failed to restore MFA challenge")
+	}
+}
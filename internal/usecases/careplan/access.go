@@ -0,0 +1,34 @@
+package careplan
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"backend-golang/internal/usecases/access"
+	"context"
+)
+
+func accessibleChild(ctx context.Context, deps *Dependencies, childId string) (*entities.Children, *entities.Therapist, error) {
+	return access.Child(ctx, access.ChildRepositories{
+		ChildRepo:     deps.ChildRepo,
+		TherapistRepo: deps.TherapistRepo,
+		ParentRepo:    deps.ParentRepo,
+		PlanRepo:      deps.PlanRepo,
+	}, childId)
+}
+
+// goalEditor checks that staff may manage goals in the section: admins any,
+// therapists only their own.
+func goalEditor(ctx context.Context, therapist *entities.Therapist, therapySection string) error {
+	role, _ := helpers.GetUserRole(ctx)
+	if role == string(constants.RoleUser) {
+		return errors.ErrForbidden
+	}
+
+	if therapist != nil && therapist.TherapistSection != therapySection {
+		return errors.ErrGoalSectionNotAllowed
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package careplan
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type createGoalUseCase struct {
+	deps *Dependencies
+}
+
+func NewCreateGoalUseCase(deps *Dependencies) CreateGoalUseCase {
+	return &createGoalUseCase{deps: deps}
+}
+
+// Execute adds a goal to the child's care plan, opening the plan if the child
+// has not been assessed yet.
+func (uc *createGoalUseCase) Execute(ctx context.Context, childId string, req *dto.CarePlanGoalCreateRequest) error {
+	if err := uc.deps.Validator.ValidateCreateGoalRequest(req); err != nil {
+		return err
+	}
+
+	child, therapist, err := accessibleChild(ctx, uc.deps, childId)
+	if err != nil {
+		return err
+	}
+
+	if err := goalEditor(ctx, therapist, req.TherapySection); err != nil {
+		return err
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	carePlan, err := uc.deps.CarePlanRepo.GetOrCreateByChildId(ctx, tx, child.Id)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	goal := uc.deps.Mapper.CreateRequestToGoal(req, carePlan.Id)
+	if err := uc.deps.CarePlanRepo.AddGoal(ctx, tx, goal); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Int("goalId", goal.Id).Str("childId", child.Id).Str("therapySection", goal.TherapySection).Msg("Care plan goal created")
+	return nil
+}
@@ -0,0 +1,34 @@
+package careplan
+
+import "backend-golang/internal/domain/repositories"
+
+type Dependencies struct {
+	TxRepo        repositories.TransactionRepository
+	ChildRepo     repositories.ChildRepository
+	TherapistRepo repositories.TherapistRepository
+	ParentRepo    repositories.ParentRepository
+	CarePlanRepo  repositories.CarePlanRepository
+	PlanRepo      repositories.InterventionPlanRepository
+	Validator     Validator
+	Mapper        Mapper
+}
+
+func NewDependencies(
+	txRepo repositories.TransactionRepository,
+	childRepo repositories.ChildRepository,
+	therapistRepo repositories.TherapistRepository,
+	parentRepo repositories.ParentRepository,
+	carePlanRepo repositories.CarePlanRepository,
+	planRepo repositories.InterventionPlanRepository,
+) *Dependencies {
+	return &Dependencies{
+		TxRepo:        txRepo,
+		ChildRepo:     childRepo,
+		TherapistRepo: therapistRepo,
+		ParentRepo:    parentRepo,
+		CarePlanRepo:  carePlanRepo,
+		PlanRepo:      planRepo,
+		Validator:     NewCarePlanValidator(),
+		Mapper:        NewCarePlanMapper(),
+	}
+}
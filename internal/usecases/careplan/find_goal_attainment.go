@@ -0,0 +1,31 @@
+package careplan
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+type findGoalAttainmentUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindGoalAttainmentUseCase(deps *Dependencies) FindGoalAttainmentUseCase {
+	return &findGoalAttainmentUseCase{deps: deps}
+}
+
+func (uc *findGoalAttainmentUseCase) Execute(ctx context.Context, childId string) (*dto.GoalAttainmentResponse, error) {
+	child, _, err := accessibleChild(ctx, uc.deps, childId)
+	if err != nil {
+		return nil, err
+	}
+
+	goals, err := uc.deps.CarePlanRepo.GetGoalsByChildId(ctx, child.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	return uc.deps.Mapper.AttainmentResponse(child, goals, time.Now()), nil
+}
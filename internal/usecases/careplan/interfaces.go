@@ -0,0 +1,18 @@
+package careplan
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type CreateGoalUseCase interface {
+	Execute(ctx context.Context, childId string, req *dto.CarePlanGoalCreateRequest) error
+}
+
+type UpdateGoalUseCase interface {
+	Execute(ctx context.Context, goalId int, req *dto.CarePlanGoalUpdateRequest) error
+}
+
+type FindGoalAttainmentUseCase interface {
+	Execute(ctx context.Context, childId string) (*dto.GoalAttainmentResponse, error)
+}
@@ -0,0 +1,112 @@
+package careplan
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"math"
+	"time"
+)
+
+type Mapper interface {
+	CreateRequestToGoal(req *dto.CarePlanGoalCreateRequest, carePlanId int) *entities.CarePlanGoal
+	GoalResponse(goal *entities.CarePlanGoal, today time.Time) *dto.CarePlanGoalResponse
+	AttainmentResponse(child *entities.Children, goals []*entities.CarePlanGoal, today time.Time) *dto.GoalAttainmentResponse
+}
+
+type carePlanMapper struct{}
+
+func NewCarePlanMapper() Mapper {
+	return &carePlanMapper{}
+}
+
+func (m *carePlanMapper) CreateRequestToGoal(req *dto.CarePlanGoalCreateRequest, carePlanId int) *entities.CarePlanGoal {
+	return &entities.CarePlanGoal{
+		CarePlanId:          carePlanId,
+		TherapySection:      req.TherapySection,
+		Description:         req.Description,
+		Baseline:            *req.Baseline,
+		Target:              *req.Target,
+		MeasurementCriteria: req.MeasurementCriteria,
+		ReviewDate:          req.ReviewDate,
+		Status:              string(constants.GoalStatusActive),
+	}
+}
+
+func (m *carePlanMapper) GoalResponse(goal *entities.CarePlanGoal, today time.Time) *dto.CarePlanGoalResponse {
+	response := &dto.CarePlanGoalResponse{
+		GoalId:              goal.Id,
+		TherapySection:      goal.TherapySection,
+		Description:         goal.Description,
+		Baseline:            goal.Baseline,
+		Target:              goal.Target,
+		MeasurementCriteria: goal.MeasurementCriteria,
+		ReviewDate:          goal.ReviewDate,
+		ReviewDue:           goal.IsReviewDue(today),
+		Status:              goal.Status,
+		Attainment:          roundPercent(goal.CurrentAttainment()),
+	}
+
+	if goal.Latest != nil {
+		response.LatestValue = &goal.Latest.Value
+		response.LatestMeasuredOn = &goal.Latest.MeasuredOn
+	}
+
+	return response
+}
+
+// AttainmentResponse groups goals by section in the order they come in. The
+// overall figure averages every goal that is not discontinued, so a section
+// with more goals weighs more.
+func (m *carePlanMapper) AttainmentResponse(child *entities.Children, goals []*entities.CarePlanGoal, today time.Time) *dto.GoalAttainmentResponse {
+	response := &dto.GoalAttainmentResponse{
+		ChildId:   child.Id,
+		ChildName: child.ChildName,
+		Sections:  make([]*dto.SectionAttainmentResponse, 0),
+	}
+
+	sections := make(map[string]*dto.SectionAttainmentResponse)
+	sectionSums := make(map[string]float64)
+	sectionCounts := make(map[string]int)
+	var total float64
+	var counted int
+
+	for _, goal := range goals {
+		section, ok := sections[goal.TherapySection]
+		if !ok {
+			section = &dto.SectionAttainmentResponse{
+				TherapySection: goal.TherapySection,
+				Goals:          make([]*dto.CarePlanGoalResponse, 0),
+			}
+			sections[goal.TherapySection] = section
+			response.Sections = append(response.Sections, section)
+		}
+		section.Goals = append(section.Goals, m.GoalResponse(goal, today))
+
+		if goal.Status == string(constants.GoalStatusDiscontinued) {
+			continue
+		}
+
+		attainment := goal.CurrentAttainment()
+		sectionSums[goal.TherapySection] += attainment
+		sectionCounts[goal.TherapySection]++
+		total += attainment
+		counted++
+	}
+
+	for name, section := range sections {
+		if sectionCounts[name] > 0 {
+			section.Attainment = roundPercent(sectionSums[name] / float64(sectionCounts[name]))
+		}
+	}
+
+	if counted > 0 {
+		response.Attainment = roundPercent(total / float64(counted))
+	}
+
+	return response
+}
+
+func roundPercent(value float64) float64 {
+	return math.Round(value*10) / 10
+}
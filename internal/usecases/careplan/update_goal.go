@@ -0,0 +1,67 @@
+package careplan
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	internalError "backend-golang/internal/errors"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type updateGoalUseCase struct {
+	deps *Dependencies
+}
+
+func NewUpdateGoalUseCase(deps *Dependencies) UpdateGoalUseCase {
+	return &updateGoalUseCase{deps: deps}
+}
+
+// Execute revises a goal, e.g. a new target after review, or closes it as
+// achieved or discontinued. Measurements already taken are kept.
+func (uc *updateGoalUseCase) Execute(ctx context.Context, goalId int, req *dto.CarePlanGoalUpdateRequest) error {
+	if err := uc.deps.Validator.ValidateUpdateGoalRequest(req); err != nil {
+		return err
+	}
+
+	goal, err := uc.deps.CarePlanRepo.GetGoalById(ctx, goalId)
+	if err != nil {
+		return internalError.ErrCarePlanGoalNotFound
+	}
+
+	_, therapist, err := accessibleChild(ctx, uc.deps, goal.ChildId)
+	if err != nil {
+		if errors.Is(err, internalError.ErrChildNotFound) {
+			return internalError.ErrCarePlanGoalNotFound
+		}
+		return err
+	}
+
+	if err := goalEditor(ctx, therapist, goal.TherapySection); err != nil {
+		return err
+	}
+
+	goal.Description = req.Description
+	goal.Baseline = *req.Baseline
+	goal.Target = *req.Target
+	goal.MeasurementCriteria = req.MeasurementCriteria
+	goal.ReviewDate = req.ReviewDate
+	goal.Status = req.Status
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.CarePlanRepo.UpdateGoal(ctx, tx, goal); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", internalError.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", internalError.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
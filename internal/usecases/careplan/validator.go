@@ -0,0 +1,47 @@
+package careplan
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/validator"
+	"time"
+)
+
+type Validator interface {
+	ValidateCreateGoalRequest(req *dto.CarePlanGoalCreateRequest) error
+	ValidateUpdateGoalRequest(req *dto.CarePlanGoalUpdateRequest) error
+}
+
+type carePlanValidator struct{}
+
+func NewCarePlanValidator() Validator {
+	return &carePlanValidator{}
+}
+
+func (v *carePlanValidator) ValidateCreateGoalRequest(req *dto.CarePlanGoalCreateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	if *req.Target == *req.Baseline {
+		return errors.ErrInvalidGoalTarget
+	}
+
+	if req.ReviewDate.ToTime().Before(time.Now().Truncate(24 * time.Hour)) {
+		return errors.ErrReviewDateInPast
+	}
+
+	return nil
+}
+
+func (v *carePlanValidator) ValidateUpdateGoalRequest(req *dto.CarePlanGoalUpdateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	if *req.Target == *req.Baseline {
+		return errors.ErrInvalidGoalTarget
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package child
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/usecases/access"
+	"context"
+)
+
+func accessibleChild(ctx context.Context, deps *Dependencies, childId string) (*entities.Children, error) {
+	child, _, err := access.Child(ctx, access.ChildRepositories{
+		ChildRepo:     deps.ChildRepo,
+		TherapistRepo: deps.TherapistRepo,
+		ParentRepo:    deps.ParentRepo,
+		PlanRepo:      deps.PlanRepo,
+	}, childId)
+
+	return child, err
+}
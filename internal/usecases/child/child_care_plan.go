@@ -0,0 +1,30 @@
+package child
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findChildCarePlanUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindChildCarePlanUseCase(deps *Dependencies) FindChildCarePlanUseCase {
+	return &findChildCarePlanUseCase{deps: deps}
+}
+
+func (uc *findChildCarePlanUseCase) Execute(ctx context.Context, childId string) (*dto.CarePlanResponse, error) {
+	child, err := accessibleChild(ctx, uc.deps, childId)
+	if err != nil {
+		return nil, err
+	}
+
+	carePlan, err := uc.deps.CarePlanRepo.GetByChildId(ctx, child.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	return uc.deps.Mapper.CarePlanResponse(child, carePlan), nil
+}
@@ -0,0 +1,110 @@
+package child
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findChildProgressUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindChildProgressUseCase(deps *Dependencies) FindChildProgressUseCase {
+	return &findChildProgressUseCase{deps: deps}
+}
+
+// Execute summarises a child's completed observations: per-domain score
+// trends, age category changes between sessions and the questions answered
+// differently in the last session compared with the one before.
+func (uc *findChildProgressUseCase) Execute(ctx context.Context, childId string) (*dto.ChildProgressResponse, error) {
+	child, err := accessibleChild(ctx, uc.deps, childId)
+	if err != nil {
+		return nil, err
+	}
+
+	observations, err := uc.deps.ObservationRepo.GetByChildId(ctx, child.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	sessions := make([]*entities.Observation, 0, len(observations))
+	for _, observation := range observations {
+		if observation.Status != string(constants.ObservationStatusCompleted) {
+			continue
+		}
+
+		scores, err := uc.deps.DomainScoreRepo.GetByObservationId(ctx, observation.Id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+		}
+		observation.DomainScores = scores
+		sessions = append(sessions, observation)
+	}
+
+	domains, err := uc.deps.DomainRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	response := &dto.ChildProgressResponse{
+		ChildId:                child.Id,
+		ChildName:              child.ChildName,
+		ObservationCount:       len(observations),
+		Sessions:               make([]*dto.ProgressSessionResponse, 0, len(sessions)),
+		DomainTrends:           make([]*dto.DomainTrendResponse, 0),
+		AgeCategoryTransitions: make([]*dto.AgeCategoryTransitionResponse, 0),
+	}
+
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, uc.deps.Mapper.ProgressSessionResponse(session))
+	}
+
+	for _, trend := range entities.BuildDomainTrends(sessions, domains) {
+		response.DomainTrends = append(response.DomainTrends, uc.deps.Mapper.DomainTrendResponse(trend))
+	}
+
+	for _, transition := range entities.AgeCategoryTransitions(sessions) {
+		response.AgeCategoryTransitions = append(response.AgeCategoryTransitions, uc.deps.Mapper.AgeCategoryTransitionResponse(transition))
+	}
+
+	if n := len(sessions); n > 1 {
+		previous, current := sessions[n-2], sessions[n-1]
+
+		previousSheet, err := uc.answerSheet(ctx, previous, domains)
+		if err != nil {
+			return nil, err
+		}
+
+		currentSheet, err := uc.answerSheet(ctx, current, domains)
+		if err != nil {
+			return nil, err
+		}
+
+		changes := entities.QuestionChanges(previousSheet, currentSheet)
+		response.LatestChanges = uc.deps.Mapper.ProgressChangesResponse(previous, current, changes)
+	}
+
+	return response, nil
+}
+
+func (uc *findChildProgressUseCase) answerSheet(ctx context.Context, observation *entities.Observation, domains []*entities.ObservationDomain) ([]entities.AnswerSheetDomain, error) {
+	if observation.QuestionnaireVersionId == nil {
+		return nil, errors.ErrQuestionnaireVersionNotFound
+	}
+
+	answers, err := uc.deps.ObservationAnswerRepo.GetByObservationId(ctx, observation.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	questions, err := uc.deps.QuestionRepo.GetByVersionId(ctx, *observation.QuestionnaireVersionId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	return entities.BuildAnswerSheet(answers, questions, domains, observation.DomainScores), nil
+}
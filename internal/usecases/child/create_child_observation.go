@@ -0,0 +1,69 @@
+package child
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type createChildObservationUseCase struct {
+	deps *Dependencies
+}
+
+func NewCreateChildObservationUseCase(deps *Dependencies) CreateChildObservationUseCase {
+	return &createChildObservationUseCase{deps: deps}
+}
+
+// Execute opens a follow-up observation for a child whose earlier
+// observations have all reached an outcome.
+func (uc *createChildObservationUseCase) Execute(ctx context.Context, childId string) error {
+	child, err := uc.deps.ChildRepo.GetById(ctx, childId)
+	if err != nil || child == nil {
+		return errors.ErrChildNotFound
+	}
+
+	ageCategories, err := uc.deps.AgeCategoryRepo.GetActive(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	observation := entities.NewPendingObservation(child.Id, child.ChildBirthDate, ageCategories, time.Now())
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.ChildRepo.LockById(ctx, tx, child.Id); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	open, err := uc.deps.ObservationRepo.ExistOpenByChildId(ctx, tx, child.Id)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+	if open {
+		tx.Rollback()
+		return errors.ErrChildHasOpenObservation
+	}
+
+	if err := uc.deps.ObservationRepo.Create(ctx, tx, observation); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Str("childId", child.Id).Str("ageCategory", observation.AgeCategory).Msg("Follow-up observation created")
+	return nil
+}
@@ -3,16 +3,49 @@ package child
 import "backend-golang/internal/domain/repositories"
 
 type Dependencies struct {
-	ChildRepo repositories.ChildRepository
+	TxRepo                repositories.TransactionRepository
+	ChildRepo             repositories.ChildRepository
+	ObservationRepo       repositories.ObservationRepository
+	ObservationAnswerRepo repositories.ObservationAnswerRepository
+	QuestionRepo          repositories.ObservationQuestionRepository
+	DomainRepo            repositories.ObservationDomainRepository
+	DomainScoreRepo       repositories.ObservationDomainScoreRepository
+	AgeCategoryRepo       repositories.AgeCategoryRepository
+	TherapistRepo         repositories.TherapistRepository
+	ParentRepo            repositories.ParentRepository
+	CarePlanRepo          repositories.CarePlanRepository
+	PlanRepo              repositories.InterventionPlanRepository
 	//Validator       Validator
 	Mapper Mapper
 }
 
 func NewDependencies(
-	observationRepo repositories.ChildRepository,
+	txRepo repositories.TransactionRepository,
+	childRepo repositories.ChildRepository,
+	observationRepo repositories.ObservationRepository,
+	observationAnswerRepo repositories.ObservationAnswerRepository,
+	questionRepo repositories.ObservationQuestionRepository,
+	domainRepo repositories.ObservationDomainRepository,
+	domainScoreRepo repositories.ObservationDomainScoreRepository,
+	ageCategoryRepo repositories.AgeCategoryRepository,
+	therapistRepo repositories.TherapistRepository,
+	parentRepo repositories.ParentRepository,
+	carePlanRepo repositories.CarePlanRepository,
+	planRepo repositories.InterventionPlanRepository,
 ) *Dependencies {
 	return &Dependencies{
-		ChildRepo: observationRepo,
-		Mapper:    NewChildMapper(),
+		TxRepo:                txRepo,
+		ChildRepo:             childRepo,
+		ObservationRepo:       observationRepo,
+		ObservationAnswerRepo: observationAnswerRepo,
+		QuestionRepo:          questionRepo,
+		DomainRepo:            domainRepo,
+		DomainScoreRepo:       domainScoreRepo,
+		AgeCategoryRepo:       ageCategoryRepo,
+		TherapistRepo:         therapistRepo,
+		ParentRepo:            parentRepo,
+		CarePlanRepo:          carePlanRepo,
+		PlanRepo:              planRepo,
+		Mapper:                NewChildMapper(),
 	}
 }
@@ -3,7 +3,7 @@ package child
 import (
 	"backend-golang/internal/adapters/http/dto"
 	"backend-golang/internal/domain/entities"
-	"backend-golang/internal/errors"
+	"backend-golang/internal/usecases/pagination"
 	"context"
 	"fmt"
 )
@@ -16,26 +16,31 @@ func NewFindChildUseCase(deps *Dependencies) FindChildUseCase {
 	return &findChildUseCase{deps: deps}
 }
 
-func (uc *findChildUseCase) Execute(ctx context.Context) ([]*dto.ChildResponse, error) {
-	childs, err := uc.deps.ChildRepo.GetAll(ctx)
+func (uc *findChildUseCase) Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.ChildResponse, *dto.PaginationMeta, error) {
+	query, err := pagination.ToListQuery(req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+		return nil, nil, err
+	}
+
+	childs, pageInfo, err := uc.deps.ChildRepo.GetAll(ctx, query)
+	if err != nil {
+		return nil, nil, pagination.RetrievalError(err)
 	}
 
 	if childs == nil {
-		return []*dto.ChildResponse{}, nil
+		return []*dto.ChildResponse{}, pagination.ToMeta(pageInfo), nil
 	}
 
 	responses := make([]*dto.ChildResponse, 0, len(childs))
 	for _, child := range childs {
 		var parentDetail *entities.ParentDetail
-		if child.Parent != nil && len(child.Parent.ParentDetail) > 0 {
-			parentDetail = &child.Parent.ParentDetail[0]
+		if child.Parent != nil {
+			parentDetail = child.Parent.PrimaryContact()
 		}
 
 		response, err := uc.deps.Mapper.ChildResponse(parentDetail, child)
 		if err != nil {
-			return nil, fmt.Errorf("failed to map child %d: %w", child.Id, err)
+			return nil, nil, fmt.Errorf("failed to map child %s: %w", child.Id, err)
 		}
 
 		if response != nil {
@@ -43,5 +48,5 @@ func (uc *findChildUseCase) Execute(ctx context.Context) ([]*dto.ChildResponse,
 		}
 	}
 
-	return responses, nil
+	return responses, pagination.ToMeta(pageInfo), nil
 }
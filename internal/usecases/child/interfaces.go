@@ -6,5 +6,17 @@ import (
 )
 
 type FindChildUseCase interface {
-	Execute(ctx context.Context) ([]*dto.ChildResponse, error)
+	Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.ChildResponse, *dto.PaginationMeta, error)
+}
+
+type CreateChildObservationUseCase interface {
+	Execute(ctx context.Context, childId string) error
+}
+
+type FindChildProgressUseCase interface {
+	Execute(ctx context.Context, childId string) (*dto.ChildProgressResponse, error)
+}
+
+type FindChildCarePlanUseCase interface {
+	Execute(ctx context.Context, childId string) (*dto.CarePlanResponse, error)
 }
@@ -12,6 +12,11 @@ import (
 
 type Mapper interface {
 	ChildResponse(parentDetail *entities.ParentDetail, child *entities.Children) (*dto.ChildResponse, error)
+	ProgressSessionResponse(observation *entities.Observation) *dto.ProgressSessionResponse
+	DomainTrendResponse(trend entities.DomainTrend) *dto.DomainTrendResponse
+	AgeCategoryTransitionResponse(transition entities.AgeCategoryTransition) *dto.AgeCategoryTransitionResponse
+	ProgressChangesResponse(previous *entities.Observation, current *entities.Observation, changes []entities.QuestionChange) *dto.ProgressChangesResponse
+	CarePlanResponse(child *entities.Children, carePlan *entities.CarePlan) *dto.CarePlanResponse
 }
 
 type childMapper struct {
@@ -55,3 +60,106 @@ func (m *childMapper) ChildResponse(parentDetail *entities.ParentDetail, child *
 		UpdatedAt:      child.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}, nil
 }
+
+func (m *childMapper) ProgressSessionResponse(observation *entities.Observation) *dto.ProgressSessionResponse {
+	response := &dto.ProgressSessionResponse{
+		ObservationId:  observation.Id,
+		ScheduledDate:  observation.ScheduledDate,
+		AgeCategory:    observation.AgeCategory,
+		TotalScore:     observation.TotalScore,
+		RiskLevel:      observation.RiskLevel,
+		TherapySection: observation.TherapySection,
+	}
+
+	if observation.Therapist != nil {
+		response.TherapistName = observation.Therapist.TherapistName
+	}
+
+	return response
+}
+
+func (m *childMapper) DomainTrendResponse(trend entities.DomainTrend) *dto.DomainTrendResponse {
+	points := make([]*dto.DomainTrendPointResponse, 0, len(trend.Points))
+	for _, point := range trend.Points {
+		points = append(points, &dto.DomainTrendPointResponse{
+			ObservationId: point.ObservationId,
+			ScheduledDate: point.ScheduledDate,
+			AgeCategory:   point.AgeCategory,
+			Score:         point.Score,
+			MaxScore:      point.MaxScore,
+			RiskLevel:     point.RiskLevel,
+		})
+	}
+
+	response := &dto.DomainTrendResponse{
+		Domain:     trend.Code,
+		DomainName: trend.Name,
+		Points:     points,
+	}
+
+	if n := len(trend.Points); n > 1 {
+		delta := trend.Points[n-1].Score - trend.Points[n-2].Score
+		response.LatestDelta = &delta
+	}
+
+	return response
+}
+
+func (m *childMapper) AgeCategoryTransitionResponse(transition entities.AgeCategoryTransition) *dto.AgeCategoryTransitionResponse {
+	return &dto.AgeCategoryTransitionResponse{
+		From:              transition.From,
+		To:                transition.To,
+		FromObservationId: transition.FromObservationId,
+		ToObservationId:   transition.ToObservationId,
+		ObservedOn:        transition.ObservedOn,
+	}
+}
+
+func (m *childMapper) ProgressChangesResponse(previous *entities.Observation, current *entities.Observation, changes []entities.QuestionChange) *dto.ProgressChangesResponse {
+	questions := make([]*dto.QuestionChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		questions = append(questions, &dto.QuestionChangeResponse{
+			QuestionCode:        change.QuestionCode,
+			QuestionText:        change.QuestionText,
+			Domain:              change.Domain,
+			PreviousAnswer:      change.PreviousAnswer,
+			CurrentAnswer:       change.CurrentAnswer,
+			PreviousScoreEarned: change.PreviousScoreEarned,
+			CurrentScoreEarned:  change.CurrentScoreEarned,
+		})
+	}
+
+	return &dto.ProgressChangesResponse{
+		FromObservationId: previous.Id,
+		ToObservationId:   current.Id,
+		TotalScoreDelta:   current.TotalScore - previous.TotalScore,
+		Questions:         questions,
+	}
+}
+
+// CarePlanResponse accepts a nil care plan for children not assessed yet.
+func (m *childMapper) CarePlanResponse(child *entities.Children, carePlan *entities.CarePlan) *dto.CarePlanResponse {
+	response := &dto.CarePlanResponse{
+		ChildId:   child.Id,
+		ChildName: child.ChildName,
+		Entries:   make([]*dto.CarePlanEntryResponse, 0),
+	}
+
+	if carePlan == nil {
+		return response
+	}
+
+	for _, entry := range carePlan.Entries {
+		response.Entries = append(response.Entries, &dto.CarePlanEntryResponse{
+			EntryId:         entry.Id,
+			TherapySection:  entry.TherapySection,
+			AssessmentId:    entry.AssessmentId,
+			Summary:         entry.Summary,
+			Recommendation:  entry.Recommendation,
+			SessionsPerWeek: entry.SessionsPerWeek,
+			CreatedAt:       entry.CreatedAt,
+		})
+	}
+
+	return response
+}
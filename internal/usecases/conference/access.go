@@ -0,0 +1,43 @@
+package conference
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/usecases/access"
+	"context"
+)
+
+// accessibleConference reports conferences a therapist does not take part in
+// as missing.
+func accessibleConference(ctx context.Context, deps *Dependencies, conferenceId int) (*entities.CaseConference, *entities.Therapist, error) {
+	if conferenceId == 0 {
+		return nil, nil, errors.ErrConferenceNotFound
+	}
+
+	therapist, err := access.CurrentTherapist(ctx, deps.TherapistRepo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conference, err := deps.ConferenceRepo.GetById(ctx, conferenceId)
+	if err != nil || conference == nil {
+		return nil, nil, errors.ErrConferenceNotFound
+	}
+
+	if therapist != nil && !conference.HasParticipant(therapist.Id) {
+		return nil, nil, errors.ErrConferenceNotFound
+	}
+
+	return conference, therapist, nil
+}
+
+func activeParticipants(ctx context.Context, deps *Dependencies, therapistIds []string) error {
+	for _, therapistId := range therapistIds {
+		therapist, err := deps.TherapistRepo.GetById(ctx, therapistId)
+		if err != nil || therapist.User == nil || !therapist.User.IsActive {
+			return errors.ErrTherapistNotFound
+		}
+	}
+
+	return nil
+}
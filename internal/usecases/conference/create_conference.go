@@ -0,0 +1,53 @@
+package conference
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type createConferenceUseCase struct {
+	deps *Dependencies
+}
+
+func NewCreateConferenceUseCase(deps *Dependencies) CreateConferenceUseCase {
+	return &createConferenceUseCase{deps: deps}
+}
+
+func (uc *createConferenceUseCase) Execute(ctx context.Context, req *dto.CaseConferenceCreateRequest) error {
+	if err := uc.deps.Validator.ValidateCreateRequest(req); err != nil {
+		return err
+	}
+
+	if _, err := uc.deps.ChildRepo.GetById(ctx, req.ChildId); err != nil {
+		return errors.ErrChildNotFound
+	}
+
+	if err := activeParticipants(ctx, uc.deps, req.ParticipantIds); err != nil {
+		return err
+	}
+
+	conference := uc.deps.Mapper.CreateRequestToConference(req)
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.ConferenceRepo.Create(ctx, tx, conference); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Int("conferenceId", conference.Id).Str("childId", conference.ChildId).Msg("Case conference scheduled")
+	return nil
+}
@@ -0,0 +1,28 @@
+package conference
+
+import "backend-golang/internal/domain/repositories"
+
+type Dependencies struct {
+	TxRepo         repositories.TransactionRepository
+	ChildRepo      repositories.ChildRepository
+	TherapistRepo  repositories.TherapistRepository
+	ConferenceRepo repositories.CaseConferenceRepository
+	Validator      Validator
+	Mapper         Mapper
+}
+
+func NewDependencies(
+	txRepo repositories.TransactionRepository,
+	childRepo repositories.ChildRepository,
+	therapistRepo repositories.TherapistRepository,
+	conferenceRepo repositories.CaseConferenceRepository,
+) *Dependencies {
+	return &Dependencies{
+		TxRepo:         txRepo,
+		ChildRepo:      childRepo,
+		TherapistRepo:  therapistRepo,
+		ConferenceRepo: conferenceRepo,
+		Validator:      NewConferenceValidator(),
+		Mapper:         NewConferenceMapper(),
+	}
+}
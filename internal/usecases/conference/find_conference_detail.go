@@ -0,0 +1,23 @@
+package conference
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type findConferenceDetailUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindConferenceDetailUseCase(deps *Dependencies) FindConferenceDetailUseCase {
+	return &findConferenceDetailUseCase{deps: deps}
+}
+
+func (uc *findConferenceDetailUseCase) Execute(ctx context.Context, conferenceId int) (*dto.CaseConferenceDetailResponse, error) {
+	conference, _, err := accessibleConference(ctx, uc.deps, conferenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.deps.Mapper.ConferenceDetailResponse(conference), nil
+}
@@ -0,0 +1,49 @@
+package conference
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/usecases/access"
+	"backend-golang/internal/usecases/pagination"
+	"context"
+)
+
+type findConferencesUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindConferencesUseCase(deps *Dependencies) FindConferencesUseCase {
+	return &findConferencesUseCase{deps: deps}
+}
+
+func (uc *findConferencesUseCase) Execute(ctx context.Context, req *dto.CaseConferenceListQuery) ([]*dto.CaseConferenceResponse, *dto.PaginationMeta, error) {
+	if err := uc.deps.Validator.ValidateListQuery(req); err != nil {
+		return nil, nil, err
+	}
+
+	query, err := pagination.ToListQuery(&req.ListQueryRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	therapist, err := access.CurrentTherapist(ctx, uc.deps.TherapistRepo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var therapistId string
+	if therapist != nil {
+		therapistId = therapist.Id
+	}
+
+	conferences, pageInfo, err := uc.deps.ConferenceRepo.GetAll(ctx, query, req.ChildId, req.Status, therapistId)
+	if err != nil {
+		return nil, nil, pagination.RetrievalError(err)
+	}
+
+	responses := make([]*dto.CaseConferenceResponse, 0, len(conferences))
+	for _, conference := range conferences {
+		responses = append(responses, uc.deps.Mapper.ConferenceResponse(conference))
+	}
+
+	return responses, pagination.ToMeta(pageInfo), nil
+}
@@ -0,0 +1,22 @@
+package conference
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type CreateConferenceUseCase interface {
+	Execute(ctx context.Context, req *dto.CaseConferenceCreateRequest) error
+}
+
+type FindConferencesUseCase interface {
+	Execute(ctx context.Context, req *dto.CaseConferenceListQuery) ([]*dto.CaseConferenceResponse, *dto.PaginationMeta, error)
+}
+
+type FindConferenceDetailUseCase interface {
+	Execute(ctx context.Context, conferenceId int) (*dto.CaseConferenceDetailResponse, error)
+}
+
+type UpdateConferenceUseCase interface {
+	Execute(ctx context.Context, conferenceId int, req *dto.CaseConferenceUpdateRequest) error
+}
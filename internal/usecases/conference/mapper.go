@@ -0,0 +1,101 @@
+package conference
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+)
+
+type Mapper interface {
+	CreateRequestToConference(req *dto.CaseConferenceCreateRequest) *entities.CaseConference
+	SectionsFromRequest(sections []dto.CaseConferenceSectionRequest) []entities.CaseConferenceSection
+	ConferenceResponse(conference *entities.CaseConference) *dto.CaseConferenceResponse
+	ConferenceDetailResponse(conference *entities.CaseConference) *dto.CaseConferenceDetailResponse
+}
+
+type conferenceMapper struct{}
+
+func NewConferenceMapper() Mapper {
+	return &conferenceMapper{}
+}
+
+func (m *conferenceMapper) CreateRequestToConference(req *dto.CaseConferenceCreateRequest) *entities.CaseConference {
+	conference := &entities.CaseConference{
+		ChildId:       req.ChildId,
+		ScheduledAt:   req.ScheduledAt,
+		Location:      req.Location,
+		Status:        string(constants.ConferenceStatusScheduled),
+		Agenda:        req.Agenda,
+		ParentConsent: string(constants.ConsentStatusPending),
+		Sections:      m.SectionsFromRequest(req.Sections),
+	}
+
+	for _, therapistId := range req.ParticipantIds {
+		conference.Participants = append(conference.Participants, entities.CaseConferenceParticipant{
+			TherapistId: therapistId,
+		})
+	}
+
+	return conference
+}
+
+func (m *conferenceMapper) SectionsFromRequest(sections []dto.CaseConferenceSectionRequest) []entities.CaseConferenceSection {
+	result := make([]entities.CaseConferenceSection, 0, len(sections))
+	for _, section := range sections {
+		result = append(result, entities.CaseConferenceSection{
+			TherapySection:  section.TherapySection,
+			SessionsPerWeek: section.SessionsPerWeek,
+		})
+	}
+
+	return result
+}
+
+func (m *conferenceMapper) ConferenceResponse(conference *entities.CaseConference) *dto.CaseConferenceResponse {
+	response := &dto.CaseConferenceResponse{
+		ConferenceId:  conference.Id,
+		ChildId:       conference.ChildId,
+		ScheduledAt:   conference.ScheduledAt,
+		Location:      conference.Location,
+		Status:        conference.Status,
+		ParentConsent: conference.ParentConsent,
+		Participants:  make([]*dto.CaseConferenceParticipantResponse, 0, len(conference.Participants)),
+		Sections:      make([]*dto.CaseConferenceSectionResponse, 0, len(conference.Sections)),
+	}
+
+	if conference.Children != nil {
+		response.ChildName = conference.Children.ChildName
+	}
+
+	for _, participant := range conference.Participants {
+		participantResponse := &dto.CaseConferenceParticipantResponse{
+			TherapistId: participant.TherapistId,
+		}
+		if participant.Therapist != nil {
+			participantResponse.TherapistName = participant.Therapist.TherapistName
+			participantResponse.TherapistSection = participant.Therapist.TherapistSection
+		}
+		response.Participants = append(response.Participants, participantResponse)
+	}
+
+	for _, section := range conference.Sections {
+		response.Sections = append(response.Sections, &dto.CaseConferenceSectionResponse{
+			TherapySection:  section.TherapySection,
+			SessionsPerWeek: section.SessionsPerWeek,
+		})
+	}
+
+	return response
+}
+
+func (m *conferenceMapper) ConferenceDetailResponse(conference *entities.CaseConference) *dto.CaseConferenceDetailResponse {
+	return &dto.CaseConferenceDetailResponse{
+		CaseConferenceResponse: *m.ConferenceResponse(conference),
+		Agenda:                 conference.Agenda,
+		Decisions:              conference.Decisions,
+		ConsentNote:            conference.ConsentNote,
+		ConsentRecordedAt:      conference.ConsentRecordedAt,
+		CreatedAt:              conference.CreatedAt,
+		UpdatedAt:              conference.UpdatedAt,
+	}
+}
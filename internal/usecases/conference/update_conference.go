@@ -0,0 +1,98 @@
+package conference
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+type updateConferenceUseCase struct {
+	deps *Dependencies
+}
+
+func NewUpdateConferenceUseCase(deps *Dependencies) UpdateConferenceUseCase {
+	return &updateConferenceUseCase{deps: deps}
+}
+
+// Execute replaces the minutes. Participating therapists may edit everything
+// but the participant list, which stays with admins.
+func (uc *updateConferenceUseCase) Execute(ctx context.Context, conferenceId int, req *dto.CaseConferenceUpdateRequest) error {
+	if err := uc.deps.Validator.ValidateUpdateRequest(req); err != nil {
+		return err
+	}
+
+	conference, therapist, err := accessibleConference(ctx, uc.deps, conferenceId)
+	if err != nil {
+		return err
+	}
+
+	if req.ParticipantIds != nil {
+		if therapist != nil {
+			return errors.ErrConferenceParticipantsAdminOnly
+		}
+
+		if err := activeParticipants(ctx, uc.deps, req.ParticipantIds); err != nil {
+			return err
+		}
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	locked, err := uc.deps.ConferenceRepo.LockById(ctx, tx, conference.Id)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	if locked.IsCancelled() {
+		tx.Rollback()
+		return errors.ErrConferenceCancelled
+	}
+
+	if locked.ParentConsent != req.ParentConsent {
+		locked.ConsentRecordedAt = nil
+		if req.ParentConsent != string(constants.ConsentStatusPending) {
+			now := time.Now()
+			locked.ConsentRecordedAt = &now
+		}
+	}
+
+	locked.ScheduledAt = req.ScheduledAt
+	locked.Location = req.Location
+	locked.Status = req.Status
+	locked.Agenda = req.Agenda
+	locked.Decisions = req.Decisions
+	locked.ParentConsent = req.ParentConsent
+	locked.ConsentNote = req.ConsentNote
+
+	if err := uc.deps.ConferenceRepo.Update(ctx, tx, locked); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := uc.deps.ConferenceRepo.ReplaceSections(ctx, tx, locked.Id, uc.deps.Mapper.SectionsFromRequest(req.Sections)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if req.ParticipantIds != nil {
+		if err := uc.deps.ConferenceRepo.ReplaceParticipants(ctx, tx, locked.Id, req.ParticipantIds); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
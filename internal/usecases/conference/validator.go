@@ -0,0 +1,91 @@
+package conference
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/validator"
+	"strings"
+	"time"
+)
+
+type Validator interface {
+	ValidateCreateRequest(req *dto.CaseConferenceCreateRequest) error
+	ValidateUpdateRequest(req *dto.CaseConferenceUpdateRequest) error
+	ValidateListQuery(req *dto.CaseConferenceListQuery) error
+}
+
+type conferenceValidator struct{}
+
+func NewConferenceValidator() Validator {
+	return &conferenceValidator{}
+}
+
+func (v *conferenceValidator) ValidateCreateRequest(req *dto.CaseConferenceCreateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	if req.ScheduledAt.Before(time.Now()) {
+		return errors.ErrScheduleInPast
+	}
+
+	if err := validateParticipants(req.ParticipantIds); err != nil {
+		return err
+	}
+
+	return validateSections(req.Sections)
+}
+
+func (v *conferenceValidator) ValidateUpdateRequest(req *dto.CaseConferenceUpdateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	if err := validateParticipants(req.ParticipantIds); err != nil {
+		return err
+	}
+
+	if err := validateSections(req.Sections); err != nil {
+		return err
+	}
+
+	held := req.Status == string(constants.ConferenceStatusHeld)
+	if held && strings.TrimSpace(req.Decisions) == "" {
+		return errors.ErrConferenceDecisionsRequired
+	}
+
+	if !held && req.ParentConsent != string(constants.ConsentStatusPending) {
+		return errors.ErrConsentRequiresHeld
+	}
+
+	return nil
+}
+
+func (v *conferenceValidator) ValidateListQuery(req *dto.CaseConferenceListQuery) error {
+	return validator.ValidateStruct(req)
+}
+
+func validateParticipants(therapistIds []string) error {
+	seen := make(map[string]bool, len(therapistIds))
+	for _, therapistId := range therapistIds {
+		if seen[therapistId] {
+			return errors.ErrDuplicateConferenceParticipant
+		}
+		seen[therapistId] = true
+	}
+
+	return nil
+}
+
+func validateSections(sections []dto.CaseConferenceSectionRequest) error {
+	seen := make(map[string]bool, len(sections))
+	for _, section := range sections {
+		if seen[section.TherapySection] {
+			return errors.ErrDuplicateConferenceSection
+		}
+		seen[section.TherapySection] = true
+	}
+
+	return nil
+}
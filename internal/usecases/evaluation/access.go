@@ -0,0 +1,29 @@
+package evaluation
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"backend-golang/internal/usecases/access"
+	"context"
+)
+
+// staffChild applies the shared child access rules to admins and therapists
+// only; parents do not see evaluations, so no parent lookup is wired in.
+func staffChild(ctx context.Context, deps *Dependencies, childId string) (*entities.Children, *entities.Therapist, error) {
+	role, ok := helpers.GetUserRole(ctx)
+	if !ok {
+		return nil, nil, errors.ErrUnauthorized
+	}
+
+	if role != string(constants.RoleAdmin) && role != string(constants.RoleTherapist) {
+		return nil, nil, errors.ErrForbidden
+	}
+
+	return access.Child(ctx, access.ChildRepositories{
+		ChildRepo:     deps.ChildRepo,
+		TherapistRepo: deps.TherapistRepo,
+		PlanRepo:      deps.PlanRepo,
+	}, childId)
+}
@@ -0,0 +1,178 @@
+package evaluation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type createEvaluationUseCase struct {
+	deps *Dependencies
+}
+
+func NewCreateEvaluationUseCase(deps *Dependencies) CreateEvaluationUseCase {
+	return &createEvaluationUseCase{deps: deps}
+}
+
+// Execute records the evaluation with a snapshot of every goal still in play,
+// stores the new readings as goal measurements and moves the programme to
+// the decided status. Any status other than Active stops the child's
+// intervention plans; they are reopened through the plan itself.
+func (uc *createEvaluationUseCase) Execute(ctx context.Context, childId string, req *dto.EvaluationCreateRequest) error {
+	if err := uc.deps.Validator.ValidateCreateEvaluationRequest(req); err != nil {
+		return err
+	}
+
+	child, therapist, err := staffChild(ctx, uc.deps, childId)
+	if err != nil {
+		return err
+	}
+
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return errors.ErrUnauthorized
+	}
+
+	goals, err := uc.deps.CarePlanRepo.GetGoalsByChildId(ctx, child.Id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	inputs, err := goalInputs(req.Goals, goals)
+	if err != nil {
+		return err
+	}
+
+	observation, err := latestObservation(ctx, uc.deps, child.Id)
+	if err != nil {
+		return err
+	}
+
+	evaluation := uc.deps.Mapper.CreateRequestToEvaluation(req, child.Id, userId, observation)
+	changed := make([]*entities.CarePlanGoal, 0)
+	for _, goal := range goals {
+		input, ok := inputs[goal.Id]
+		if !ok && goal.Status == string(constants.GoalStatusDiscontinued) {
+			continue
+		}
+
+		evaluation.Goals = append(evaluation.Goals, uc.deps.Mapper.GoalSnapshot(goal, input))
+
+		if ok && input.Status != "" && input.Status != goal.Status {
+			goal.Status = input.Status
+			changed = append(changed, goal)
+		}
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.ChildRepo.LockById(ctx, tx, child.Id); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	carePlan, err := uc.deps.CarePlanRepo.GetOrCreateByChildId(ctx, tx, child.Id)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := uc.deps.EvaluationRepo.Create(ctx, tx, evaluation); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	var therapistId *string
+	if therapist != nil {
+		therapistId = &therapist.Id
+	}
+
+	measurements := make([]entities.CarePlanGoalMeasurement, 0, len(req.Goals))
+	for _, input := range req.Goals {
+		if input.Value == nil {
+			continue
+		}
+		measurements = append(measurements, entities.CarePlanGoalMeasurement{
+			GoalId:       input.GoalId,
+			EvaluationId: &evaluation.Id,
+			TherapistId:  therapistId,
+			Value:        *input.Value,
+			Note:         input.Note,
+			MeasuredOn:   req.EvaluationDate,
+		})
+	}
+
+	if err := uc.deps.CarePlanRepo.AddMeasurements(ctx, tx, measurements); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	for _, goal := range changed {
+		if err := uc.deps.CarePlanRepo.UpdateGoal(ctx, tx, goal); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+		}
+	}
+
+	carePlan.ProgrammeStatus = evaluation.ProgrammeStatus
+	carePlan.NextEvaluationDate = evaluation.NextEvaluationDate
+	carePlan.LastEvaluatedOn = &evaluation.EvaluationDate
+	if err := uc.deps.CarePlanRepo.UpdateProgramme(ctx, tx, carePlan); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if evaluation.ProgrammeStatus != string(constants.ProgrammeStatusActive) {
+		if err := uc.deps.PlanRepo.DeactivateByChild(ctx, tx, child.Id); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().
+		Int("evaluationId", evaluation.Id).
+		Str("childId", child.Id).
+		Str("decision", evaluation.Decision).
+		Str("programmeStatus", evaluation.ProgrammeStatus).
+		Msg("Evaluation recorded")
+	return nil
+}
+
+// goalInputs indexes the request by goal. Every goal must belong to the
+// child, and only active goals take a new reading.
+func goalInputs(inputs []dto.EvaluationGoalInput, goals []*entities.CarePlanGoal) (map[int]*dto.EvaluationGoalInput, error) {
+	goalById := make(map[int]*entities.CarePlanGoal, len(goals))
+	for _, goal := range goals {
+		goalById[goal.Id] = goal
+	}
+
+	indexed := make(map[int]*dto.EvaluationGoalInput, len(inputs))
+	for i := range inputs {
+		input := &inputs[i]
+		goal, ok := goalById[input.GoalId]
+		if !ok {
+			return nil, errors.ErrCarePlanGoalNotFound
+		}
+		if input.Value != nil && !goal.IsActive() {
+			return nil, errors.ErrGoalNotActive
+		}
+		indexed[input.GoalId] = input
+	}
+
+	return indexed, nil
+}
@@ -0,0 +1,43 @@
+package evaluation
+
+import "backend-golang/internal/domain/repositories"
+
+type Dependencies struct {
+	TxRepo          repositories.TransactionRepository
+	ChildRepo       repositories.ChildRepository
+	TherapistRepo   repositories.TherapistRepository
+	ObservationRepo repositories.ObservationRepository
+	DomainRepo      repositories.ObservationDomainRepository
+	DomainScoreRepo repositories.ObservationDomainScoreRepository
+	CarePlanRepo    repositories.CarePlanRepository
+	PlanRepo        repositories.InterventionPlanRepository
+	EvaluationRepo  repositories.EvaluationRepository
+	Validator       Validator
+	Mapper          Mapper
+}
+
+func NewDependencies(
+	txRepo repositories.TransactionRepository,
+	childRepo repositories.ChildRepository,
+	therapistRepo repositories.TherapistRepository,
+	observationRepo repositories.ObservationRepository,
+	domainRepo repositories.ObservationDomainRepository,
+	domainScoreRepo repositories.ObservationDomainScoreRepository,
+	carePlanRepo repositories.CarePlanRepository,
+	planRepo repositories.InterventionPlanRepository,
+	evaluationRepo repositories.EvaluationRepository,
+) *Dependencies {
+	return &Dependencies{
+		TxRepo:          txRepo,
+		ChildRepo:       childRepo,
+		TherapistRepo:   therapistRepo,
+		ObservationRepo: observationRepo,
+		DomainRepo:      domainRepo,
+		DomainScoreRepo: domainScoreRepo,
+		CarePlanRepo:    carePlanRepo,
+		PlanRepo:        planRepo,
+		EvaluationRepo:  evaluationRepo,
+		Validator:       NewEvaluationValidator(),
+		Mapper:          NewEvaluationMapper(),
+	}
+}
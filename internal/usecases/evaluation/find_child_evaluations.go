@@ -0,0 +1,35 @@
+package evaluation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findChildEvaluationsUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindChildEvaluationsUseCase(deps *Dependencies) FindChildEvaluationsUseCase {
+	return &findChildEvaluationsUseCase{deps: deps}
+}
+
+func (uc *findChildEvaluationsUseCase) Execute(ctx context.Context, childId string) ([]*dto.EvaluationResponse, error) {
+	child, _, err := staffChild(ctx, uc.deps, childId)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluations, err := uc.deps.EvaluationRepo.GetByChildId(ctx, child.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	responses := make([]*dto.EvaluationResponse, 0, len(evaluations))
+	for _, evaluation := range evaluations {
+		responses = append(responses, uc.deps.Mapper.EvaluationResponse(evaluation))
+	}
+
+	return responses, nil
+}
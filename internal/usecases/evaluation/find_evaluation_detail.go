@@ -0,0 +1,49 @@
+package evaluation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	internalError "backend-golang/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+)
+
+type findEvaluationDetailUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindEvaluationDetailUseCase(deps *Dependencies) FindEvaluationDetailUseCase {
+	return &findEvaluationDetailUseCase{deps: deps}
+}
+
+// Execute shows the evaluation with the goal snapshot and the observation it
+// was based on. A child outside the caller's reach reads as a missing
+// evaluation.
+func (uc *findEvaluationDetailUseCase) Execute(ctx context.Context, evaluationId int) (*dto.EvaluationDetailResponse, error) {
+	evaluation, err := uc.deps.EvaluationRepo.GetById(ctx, evaluationId)
+	if err != nil {
+		log.Warn().Err(err).Int("evaluationId", evaluationId).Msg("Evaluation not found")
+		return nil, internalError.ErrEvaluationNotFound
+	}
+
+	if _, _, err := staffChild(ctx, uc.deps, evaluation.ChildId); err != nil {
+		if errors.Is(err, internalError.ErrChildNotFound) {
+			return nil, internalError.ErrEvaluationNotFound
+		}
+		return nil, err
+	}
+
+	var summary *dto.EvaluationObservationResponse
+	if evaluation.ObservationId != nil {
+		observation, err := uc.deps.ObservationRepo.GetById(ctx, *evaluation.ObservationId)
+		if err == nil {
+			summary, err = observationSummary(ctx, uc.deps, observation)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return uc.deps.Mapper.DetailResponse(evaluation, summary), nil
+}
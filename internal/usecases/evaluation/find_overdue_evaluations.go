@@ -0,0 +1,49 @@
+package evaluation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"backend-golang/internal/usecases/pagination"
+	"context"
+	"time"
+)
+
+type findOverdueEvaluationsUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindOverdueEvaluationsUseCase(deps *Dependencies) FindOverdueEvaluationsUseCase {
+	return &findOverdueEvaluationsUseCase{deps: deps}
+}
+
+// Execute lists children in an ongoing programme whose evaluation date has
+// passed, most overdue first by default.
+func (uc *findOverdueEvaluationsUseCase) Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.OverdueEvaluationResponse, *dto.PaginationMeta, error) {
+	role, ok := helpers.GetUserRole(ctx)
+	if !ok {
+		return nil, nil, errors.ErrUnauthorized
+	}
+	if role != string(constants.RoleAdmin) {
+		return nil, nil, errors.ErrForbidden
+	}
+
+	query, err := pagination.ToListQuery(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	today := time.Now()
+	carePlans, pageInfo, err := uc.deps.CarePlanRepo.GetOverdue(ctx, query, today)
+	if err != nil {
+		return nil, nil, pagination.RetrievalError(err)
+	}
+
+	responses := make([]*dto.OverdueEvaluationResponse, 0, len(carePlans))
+	for _, carePlan := range carePlans {
+		responses = append(responses, uc.deps.Mapper.OverdueResponse(carePlan, today))
+	}
+
+	return responses, pagination.ToMeta(pageInfo), nil
+}
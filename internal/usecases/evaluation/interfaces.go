@@ -0,0 +1,26 @@
+package evaluation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type PrepareEvaluationUseCase interface {
+	Execute(ctx context.Context, childId string) (*dto.EvaluationDraftResponse, error)
+}
+
+type CreateEvaluationUseCase interface {
+	Execute(ctx context.Context, childId string, req *dto.EvaluationCreateRequest) error
+}
+
+type FindChildEvaluationsUseCase interface {
+	Execute(ctx context.Context, childId string) ([]*dto.EvaluationResponse, error)
+}
+
+type FindEvaluationDetailUseCase interface {
+	Execute(ctx context.Context, evaluationId int) (*dto.EvaluationDetailResponse, error)
+}
+
+type FindOverdueEvaluationsUseCase interface {
+	Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.OverdueEvaluationResponse, *dto.PaginationMeta, error)
+}
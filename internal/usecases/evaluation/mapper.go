@@ -0,0 +1,203 @@
+package evaluation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"math"
+	"time"
+)
+
+type Mapper interface {
+	CreateRequestToEvaluation(req *dto.EvaluationCreateRequest, childId string, recordedBy string, observation *entities.Observation) *entities.Evaluation
+	GoalSnapshot(goal *entities.CarePlanGoal, input *dto.EvaluationGoalInput) entities.EvaluationGoal
+	GoalResponse(goal entities.EvaluationGoal) *dto.EvaluationGoalResponse
+	ObservationResponse(observation *entities.Observation, scores []entities.ObservationDomainScore, domains []*entities.ObservationDomain) *dto.EvaluationObservationResponse
+	DraftResponse(child *entities.Children, carePlan *entities.CarePlan, goals []*entities.CarePlanGoal, observation *dto.EvaluationObservationResponse) *dto.EvaluationDraftResponse
+	EvaluationResponse(evaluation *entities.Evaluation) *dto.EvaluationResponse
+	DetailResponse(evaluation *entities.Evaluation, observation *dto.EvaluationObservationResponse) *dto.EvaluationDetailResponse
+	OverdueResponse(carePlan *entities.CarePlan, today time.Time) *dto.OverdueEvaluationResponse
+}
+
+type evaluationMapper struct{}
+
+func NewEvaluationMapper() Mapper {
+	return &evaluationMapper{}
+}
+
+func (m *evaluationMapper) CreateRequestToEvaluation(req *dto.EvaluationCreateRequest, childId string, recordedBy string, observation *entities.Observation) *entities.Evaluation {
+	evaluation := &entities.Evaluation{
+		ChildId:         childId,
+		EvaluationDate:  req.EvaluationDate,
+		Summary:         req.Summary,
+		Decision:        req.Decision,
+		ProgrammeStatus: req.ProgrammeStatus,
+		RecordedBy:      recordedBy,
+	}
+
+	if req.Decision != string(constants.EvaluationDecisionEnd) {
+		evaluation.NextEvaluationDate = req.NextEvaluationDate
+	}
+
+	if observation != nil {
+		evaluation.ObservationId = &observation.Id
+	}
+
+	return evaluation
+}
+
+// GoalSnapshot applies the team's input over the goal as it stands. Without a
+// new value the latest measurement is carried over.
+func (m *evaluationMapper) GoalSnapshot(goal *entities.CarePlanGoal, input *dto.EvaluationGoalInput) entities.EvaluationGoal {
+	snapshot := entities.EvaluationGoal{
+		GoalId:         goal.Id,
+		TherapySection: goal.TherapySection,
+		Description:    goal.Description,
+		Status:         goal.Status,
+	}
+
+	if goal.Latest != nil {
+		value := goal.Latest.Value
+		snapshot.Value = &value
+	}
+
+	if input != nil {
+		if input.Value != nil {
+			snapshot.Value = input.Value
+		}
+		if input.Status != "" {
+			snapshot.Status = input.Status
+		}
+		snapshot.Note = input.Note
+	}
+
+	switch {
+	case snapshot.Status == string(constants.GoalStatusAchieved):
+		snapshot.Attainment = 100
+	case snapshot.Value != nil:
+		snapshot.Attainment = roundPercent(goal.Attainment(*snapshot.Value))
+	}
+
+	return snapshot
+}
+
+func (m *evaluationMapper) GoalResponse(goal entities.EvaluationGoal) *dto.EvaluationGoalResponse {
+	return &dto.EvaluationGoalResponse{
+		GoalId:         goal.GoalId,
+		TherapySection: goal.TherapySection,
+		Description:    goal.Description,
+		Value:          goal.Value,
+		Attainment:     goal.Attainment,
+		Status:         goal.Status,
+		Note:           goal.Note,
+	}
+}
+
+func (m *evaluationMapper) ObservationResponse(observation *entities.Observation, scores []entities.ObservationDomainScore, domains []*entities.ObservationDomain) *dto.EvaluationObservationResponse {
+	domainNames := make(map[string]string, len(domains))
+	for _, domain := range domains {
+		domainNames[domain.Code] = domain.Name
+	}
+
+	domainScores := make([]*dto.EvaluationDomainScoreResponse, 0, len(scores))
+	for _, score := range scores {
+		domainScores = append(domainScores, &dto.EvaluationDomainScoreResponse{
+			Domain:     score.Domain,
+			DomainName: domainNames[score.Domain],
+			Score:      score.Score,
+			MaxScore:   score.MaxScore,
+			RiskLevel:  score.RiskLevel,
+		})
+	}
+
+	return &dto.EvaluationObservationResponse{
+		ObservationId:  observation.Id,
+		ScheduledDate:  observation.ScheduledDate,
+		TotalScore:     observation.TotalScore,
+		RiskLevel:      observation.RiskLevel,
+		TherapySection: observation.TherapySection,
+		DomainScores:   domainScores,
+	}
+}
+
+// DraftResponse leaves out discontinued goals; they no longer count towards
+// the programme.
+func (m *evaluationMapper) DraftResponse(child *entities.Children, carePlan *entities.CarePlan, goals []*entities.CarePlanGoal, observation *dto.EvaluationObservationResponse) *dto.EvaluationDraftResponse {
+	response := &dto.EvaluationDraftResponse{
+		ChildId:     child.Id,
+		ChildName:   child.ChildName,
+		Goals:       make([]*dto.EvaluationGoalResponse, 0, len(goals)),
+		Observation: observation,
+	}
+
+	if carePlan != nil {
+		response.ProgrammeStatus = carePlan.ProgrammeStatus
+		response.NextEvaluationDate = carePlan.NextEvaluationDate
+		response.LastEvaluatedOn = carePlan.LastEvaluatedOn
+	}
+
+	for _, goal := range goals {
+		if goal.Status == string(constants.GoalStatusDiscontinued) {
+			continue
+		}
+		response.Goals = append(response.Goals, m.GoalResponse(m.GoalSnapshot(goal, nil)))
+	}
+
+	return response
+}
+
+func (m *evaluationMapper) EvaluationResponse(evaluation *entities.Evaluation) *dto.EvaluationResponse {
+	return &dto.EvaluationResponse{
+		EvaluationId:       evaluation.Id,
+		ChildId:            evaluation.ChildId,
+		EvaluationDate:     evaluation.EvaluationDate,
+		Decision:           evaluation.Decision,
+		ProgrammeStatus:    evaluation.ProgrammeStatus,
+		NextEvaluationDate: evaluation.NextEvaluationDate,
+		Summary:            evaluation.Summary,
+		CreatedAt:          evaluation.CreatedAt,
+	}
+}
+
+func (m *evaluationMapper) DetailResponse(evaluation *entities.Evaluation, observation *dto.EvaluationObservationResponse) *dto.EvaluationDetailResponse {
+	response := &dto.EvaluationDetailResponse{
+		EvaluationResponse: *m.EvaluationResponse(evaluation),
+		Goals:              make([]*dto.EvaluationGoalResponse, 0, len(evaluation.Goals)),
+		Observation:        observation,
+	}
+
+	if evaluation.Children != nil {
+		response.ChildName = evaluation.Children.ChildName
+	}
+
+	for _, goal := range evaluation.Goals {
+		response.Goals = append(response.Goals, m.GoalResponse(goal))
+	}
+
+	return response
+}
+
+func (m *evaluationMapper) OverdueResponse(carePlan *entities.CarePlan, today time.Time) *dto.OverdueEvaluationResponse {
+	response := &dto.OverdueEvaluationResponse{
+		ChildId:            carePlan.ChildId,
+		ProgrammeStatus:    carePlan.ProgrammeStatus,
+		NextEvaluationDate: carePlan.NextEvaluationDate,
+		LastEvaluatedOn:    carePlan.LastEvaluatedOn,
+	}
+
+	if carePlan.Children != nil {
+		response.ChildName = carePlan.Children.ChildName
+	}
+
+	if carePlan.NextEvaluationDate != nil {
+		due := carePlan.NextEvaluationDate.ToTime()
+		day := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, due.Location())
+		response.DaysOverdue = int(day.Sub(due).Hours() / 24)
+	}
+
+	return response
+}
+
+func roundPercent(value float64) float64 {
+	return math.Round(value*10) / 10
+}
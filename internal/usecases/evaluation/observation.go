@@ -0,0 +1,46 @@
+package evaluation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+// latestObservation returns the child's most recent completed observation, or
+// nil when the child has none.
+func latestObservation(ctx context.Context, deps *Dependencies, childId string) (*entities.Observation, error) {
+	observations, err := deps.ObservationRepo.GetByChildId(ctx, childId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	for i := len(observations) - 1; i >= 0; i-- {
+		if observations[i].Status == string(constants.ObservationStatusCompleted) {
+			return observations[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// observationSummary loads the domain scores shown next to the goals.
+func observationSummary(ctx context.Context, deps *Dependencies, observation *entities.Observation) (*dto.EvaluationObservationResponse, error) {
+	if observation == nil {
+		return nil, nil
+	}
+
+	scores, err := deps.DomainScoreRepo.GetByObservationId(ctx, observation.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	domains, err := deps.DomainRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	return deps.Mapper.ObservationResponse(observation, scores, domains), nil
+}
@@ -0,0 +1,47 @@
+package evaluation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type prepareEvaluationUseCase struct {
+	deps *Dependencies
+}
+
+func NewPrepareEvaluationUseCase(deps *Dependencies) PrepareEvaluationUseCase {
+	return &prepareEvaluationUseCase{deps: deps}
+}
+
+// Execute gathers what the team reviews before recording an evaluation: goal
+// progress so far and the scores of the latest completed observation.
+func (uc *prepareEvaluationUseCase) Execute(ctx context.Context, childId string) (*dto.EvaluationDraftResponse, error) {
+	child, _, err := staffChild(ctx, uc.deps, childId)
+	if err != nil {
+		return nil, err
+	}
+
+	carePlan, err := uc.deps.CarePlanRepo.GetByChildId(ctx, child.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	goals, err := uc.deps.CarePlanRepo.GetGoalsByChildId(ctx, child.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	observation, err := latestObservation(ctx, uc.deps, child.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := observationSummary(ctx, uc.deps, observation)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.deps.Mapper.DraftResponse(child, carePlan, goals, summary), nil
+}
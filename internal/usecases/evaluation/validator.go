@@ -0,0 +1,53 @@
+package evaluation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/validator"
+	"time"
+)
+
+type Validator interface {
+	ValidateCreateEvaluationRequest(req *dto.EvaluationCreateRequest) error
+}
+
+type evaluationValidator struct{}
+
+func NewEvaluationValidator() Validator {
+	return &evaluationValidator{}
+}
+
+// ValidateCreateEvaluationRequest keeps the decision and the programme status
+// consistent: ending therapy graduates or discharges the child, anything else
+// keeps the programme going and needs the next evaluation date.
+func (v *evaluationValidator) ValidateCreateEvaluationRequest(req *dto.EvaluationCreateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	if req.EvaluationDate.ToTime().After(time.Now()) {
+		return errors.ErrEvaluationInFuture
+	}
+
+	ongoing := req.ProgrammeStatus == string(constants.ProgrammeStatusActive) ||
+		req.ProgrammeStatus == string(constants.ProgrammeStatusPaused)
+	ending := req.Decision == string(constants.EvaluationDecisionEnd)
+	if ongoing == ending {
+		return errors.ErrEvaluationDecisionMismatch
+	}
+
+	if ongoing && (req.NextEvaluationDate == nil || !req.NextEvaluationDate.ToTime().After(req.EvaluationDate.ToTime())) {
+		return errors.ErrNextEvaluationRequired
+	}
+
+	seen := make(map[int]bool, len(req.Goals))
+	for _, goal := range req.Goals {
+		if seen[goal.GoalId] {
+			return errors.ErrDuplicateEvaluationGoal
+		}
+		seen[goal.GoalId] = true
+	}
+
+	return nil
+}
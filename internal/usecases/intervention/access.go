@@ -0,0 +1,36 @@
+package intervention
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/usecases/access"
+	"context"
+)
+
+// sessionTherapist is for views that only make sense for a therapist.
+func sessionTherapist(ctx context.Context, deps *Dependencies) (*entities.Therapist, error) {
+	therapist, err := access.CurrentTherapist(ctx, deps.TherapistRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	if therapist == nil {
+		return nil, errors.ErrForbidden
+	}
+
+	return therapist, nil
+}
+
+// activeTherapist loads a therapist who can run sessions in the section.
+func activeTherapist(ctx context.Context, deps *Dependencies, therapistId string, therapySection string) (*entities.Therapist, error) {
+	therapist, err := deps.TherapistRepo.GetById(ctx, therapistId)
+	if err != nil || therapist.User == nil || !therapist.User.IsActive {
+		return nil, errors.ErrTherapistNotFound
+	}
+
+	if therapist.TherapistSection != therapySection {
+		return nil, errors.ErrTherapistSectionMismatch
+	}
+
+	return therapist, nil
+}
@@ -0,0 +1,75 @@
+package intervention
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type createPlanUseCase struct {
+	deps *Dependencies
+}
+
+func NewCreatePlanUseCase(deps *Dependencies) CreatePlanUseCase {
+	return &createPlanUseCase{deps: deps}
+}
+
+// Execute opens a recurring plan and resumes the child's programme. A child
+// has at most one active plan per therapy section.
+func (uc *createPlanUseCase) Execute(ctx context.Context, req *dto.InterventionPlanCreateRequest) error {
+	if err := uc.deps.Validator.ValidateCreatePlanRequest(req); err != nil {
+		return err
+	}
+
+	if _, err := uc.deps.ChildRepo.GetById(ctx, req.ChildId); err != nil {
+		return errors.ErrChildNotFound
+	}
+
+	if _, err := activeTherapist(ctx, uc.deps, req.TherapistId, req.TherapySection); err != nil {
+		return err
+	}
+
+	plan := uc.deps.Mapper.CreateRequestToPlan(req)
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.ChildRepo.LockById(ctx, tx, plan.ChildId); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	exists, err := uc.deps.PlanRepo.ExistActiveByChildSection(ctx, tx, plan.ChildId, plan.TherapySection, 0)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+	if exists {
+		tx.Rollback()
+		return errors.ErrInterventionPlanExists
+	}
+
+	if err := uc.deps.PlanRepo.Create(ctx, tx, plan); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := resumeProgramme(ctx, uc.deps, tx, plan.ChildId, plan.StartDate); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Int("planId", plan.Id).Str("childId", plan.ChildId).Str("therapySection", plan.TherapySection).Msg("Intervention plan created")
+	return nil
+}
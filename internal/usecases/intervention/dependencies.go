@@ -0,0 +1,34 @@
+package intervention
+
+import "backend-golang/internal/domain/repositories"
+
+type Dependencies struct {
+	TxRepo        repositories.TransactionRepository
+	ChildRepo     repositories.ChildRepository
+	TherapistRepo repositories.TherapistRepository
+	PlanRepo      repositories.InterventionPlanRepository
+	SessionRepo   repositories.InterventionSessionRepository
+	CarePlanRepo  repositories.CarePlanRepository
+	Validator     Validator
+	Mapper        Mapper
+}
+
+func NewDependencies(
+	txRepo repositories.TransactionRepository,
+	childRepo repositories.ChildRepository,
+	therapistRepo repositories.TherapistRepository,
+	planRepo repositories.InterventionPlanRepository,
+	sessionRepo repositories.InterventionSessionRepository,
+	carePlanRepo repositories.CarePlanRepository,
+) *Dependencies {
+	return &Dependencies{
+		TxRepo:        txRepo,
+		ChildRepo:     childRepo,
+		TherapistRepo: therapistRepo,
+		PlanRepo:      planRepo,
+		SessionRepo:   sessionRepo,
+		CarePlanRepo:  carePlanRepo,
+		Validator:     NewInterventionValidator(),
+		Mapper:        NewInterventionMapper(),
+	}
+}
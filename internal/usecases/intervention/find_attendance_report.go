@@ -0,0 +1,74 @@
+package intervention
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+const maxReportDays = 92
+
+type findAttendanceReportUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindAttendanceReportUseCase(deps *Dependencies) FindAttendanceReportUseCase {
+	return &findAttendanceReportUseCase{deps: deps}
+}
+
+// Execute counts, per plan, the sessions planned in the period and how each
+// ended.
+func (uc *findAttendanceReportUseCase) Execute(ctx context.Context, req *dto.AttendanceReportQuery) (*dto.AttendanceReportResponse, error) {
+	if err := uc.deps.Validator.ValidateAttendanceReportQuery(req); err != nil {
+		return nil, err
+	}
+
+	from, err := time.ParseInLocation(entities.DateLayout, req.DateFrom, time.Local)
+	if err != nil {
+		return nil, errors.ErrInvalidReportRange
+	}
+
+	to, err := time.ParseInLocation(entities.DateLayout, req.DateTo, time.Local)
+	if err != nil {
+		return nil, errors.ErrInvalidReportRange
+	}
+
+	if to.Before(from) || to.Sub(from) > maxReportDays*24*time.Hour {
+		return nil, errors.ErrInvalidReportRange
+	}
+
+	plans, err := uc.deps.PlanRepo.GetInRange(ctx, from, to, req.ChildId, req.TherapistId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	planIds := make([]int, 0, len(plans))
+	for _, plan := range plans {
+		planIds = append(planIds, plan.Id)
+	}
+
+	sessions, err := uc.deps.SessionRepo.GetByPlans(ctx, planIds, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	var totals entities.AttendanceCounts
+	response := &dto.AttendanceReportResponse{
+		DateFrom: req.DateFrom,
+		DateTo:   req.DateTo,
+		Plans:    make([]*dto.PlanAttendanceResponse, 0, len(plans)),
+	}
+
+	today := time.Now()
+	for _, plan := range plans {
+		counts := entities.PlanAttendance(plan, sessions, from, to, today)
+		totals.Add(counts)
+		response.Plans = append(response.Plans, uc.deps.Mapper.PlanAttendanceResponse(plan, counts))
+	}
+	response.Totals = uc.deps.Mapper.AttendanceCountsResponse(totals)
+
+	return response, nil
+}
@@ -0,0 +1,49 @@
+package intervention
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/usecases/access"
+	"backend-golang/internal/usecases/pagination"
+	"context"
+)
+
+type findPlansUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindPlansUseCase(deps *Dependencies) FindPlansUseCase {
+	return &findPlansUseCase{deps: deps}
+}
+
+func (uc *findPlansUseCase) Execute(ctx context.Context, req *dto.InterventionPlanListQuery) ([]*dto.InterventionPlanResponse, *dto.PaginationMeta, error) {
+	if err := uc.deps.Validator.ValidatePlanListQuery(req); err != nil {
+		return nil, nil, err
+	}
+
+	therapist, err := access.CurrentTherapist(ctx, uc.deps.TherapistRepo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Therapists only see the plans they run.
+	if therapist != nil {
+		req.TherapistId = therapist.Id
+	}
+
+	query, err := pagination.ToListQuery(&req.ListQueryRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plans, pageInfo, err := uc.deps.PlanRepo.GetAll(ctx, query, req.ChildId, req.TherapistId)
+	if err != nil {
+		return nil, nil, pagination.RetrievalError(err)
+	}
+
+	responses := make([]*dto.InterventionPlanResponse, 0, len(plans))
+	for _, plan := range plans {
+		responses = append(responses, uc.deps.Mapper.PlanResponse(plan))
+	}
+
+	return responses, pagination.ToMeta(pageInfo), nil
+}
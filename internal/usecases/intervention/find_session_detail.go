@@ -0,0 +1,35 @@
+package intervention
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/usecases/access"
+	"context"
+)
+
+type findSessionDetailUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindSessionDetailUseCase(deps *Dependencies) FindSessionDetailUseCase {
+	return &findSessionDetailUseCase{deps: deps}
+}
+
+// Execute shows a recorded session; therapists only see the ones they ran.
+func (uc *findSessionDetailUseCase) Execute(ctx context.Context, sessionId int) (*dto.InterventionSessionResponse, error) {
+	therapist, err := access.CurrentTherapist(ctx, uc.deps.TherapistRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := uc.deps.SessionRepo.GetById(ctx, sessionId)
+	if err != nil {
+		return nil, errors.ErrInterventionSessionNotFound
+	}
+
+	if therapist != nil && session.TherapistId != therapist.Id {
+		return nil, errors.ErrInterventionSessionNotFound
+	}
+
+	return uc.deps.Mapper.SessionResponse(session), nil
+}
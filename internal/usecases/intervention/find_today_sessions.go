@@ -0,0 +1,66 @@
+package intervention
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+type findTodaySessionsUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindTodaySessionsUseCase(deps *Dependencies) FindTodaySessionsUseCase {
+	return &findTodaySessionsUseCase{deps: deps}
+}
+
+// Execute lists the therapist's planned sessions for today with their
+// recorded outcome, if any, ordered by start time.
+func (uc *findTodaySessionsUseCase) Execute(ctx context.Context) ([]*dto.TodaySessionResponse, error) {
+	therapist, err := sessionTherapist(ctx, uc.deps)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Now()
+	plans, err := uc.deps.PlanRepo.GetInRange(ctx, today, today, "", therapist.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	planIds := make([]int, 0, len(plans))
+	for _, plan := range plans {
+		planIds = append(planIds, plan.Id)
+	}
+
+	sessions, err := uc.deps.SessionRepo.GetByPlans(ctx, planIds, today, today)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	recorded := make(map[int]map[string]*entities.InterventionSession, len(plans))
+	for _, session := range sessions {
+		if recorded[session.PlanId] == nil {
+			recorded[session.PlanId] = make(map[string]*entities.InterventionSession)
+		}
+		recorded[session.PlanId][session.Occurrence().Key()] = session
+	}
+
+	responses := make([]*dto.TodaySessionResponse, 0)
+	for _, plan := range plans {
+		for _, occurrence := range plan.Occurrences(today, today) {
+			session := recorded[plan.Id][occurrence.Key()]
+			responses = append(responses, uc.deps.Mapper.TodaySessionResponse(plan, occurrence, session))
+		}
+	}
+
+	sort.SliceStable(responses, func(i, j int) bool {
+		return responses[i].StartTime < responses[j].StartTime
+	})
+
+	return responses, nil
+}
@@ -0,0 +1,34 @@
+package intervention
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type CreatePlanUseCase interface {
+	Execute(ctx context.Context, req *dto.InterventionPlanCreateRequest) error
+}
+
+type FindPlansUseCase interface {
+	Execute(ctx context.Context, req *dto.InterventionPlanListQuery) ([]*dto.InterventionPlanResponse, *dto.PaginationMeta, error)
+}
+
+type UpdatePlanUseCase interface {
+	Execute(ctx context.Context, planId int, req *dto.InterventionPlanUpdateRequest) error
+}
+
+type FindTodaySessionsUseCase interface {
+	Execute(ctx context.Context) ([]*dto.TodaySessionResponse, error)
+}
+
+type RecordSessionUseCase interface {
+	Execute(ctx context.Context, req *dto.RecordSessionRequest) error
+}
+
+type FindSessionDetailUseCase interface {
+	Execute(ctx context.Context, sessionId int) (*dto.InterventionSessionResponse, error)
+}
+
+type FindAttendanceReportUseCase interface {
+	Execute(ctx context.Context, req *dto.AttendanceReportQuery) (*dto.AttendanceReportResponse, error)
+}
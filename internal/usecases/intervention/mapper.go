@@ -0,0 +1,258 @@
+package intervention
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"math"
+	"time"
+)
+
+type Mapper interface {
+	CreateRequestToPlan(req *dto.InterventionPlanCreateRequest) *entities.InterventionPlan
+	SlotsFromRequest(slots []dto.InterventionPlanSlotInput) []entities.InterventionPlanSlot
+	RecordRequestToSession(req *dto.RecordSessionRequest, plan *entities.InterventionPlan, therapistId string, carePlanGoals map[int]*entities.CarePlanGoal) *entities.InterventionSession
+	GoalsFromRequest(goals []dto.SessionGoalInput, carePlanGoals map[int]*entities.CarePlanGoal) []entities.InterventionSessionGoal
+	MeasurementsFromRequest(req *dto.RecordSessionRequest, therapistId string) []entities.CarePlanGoalMeasurement
+	PlanResponse(plan *entities.InterventionPlan) *dto.InterventionPlanResponse
+	TodaySessionResponse(plan *entities.InterventionPlan, occurrence entities.SessionOccurrence, session *entities.InterventionSession) *dto.TodaySessionResponse
+	SessionResponse(session *entities.InterventionSession) *dto.InterventionSessionResponse
+	AttendanceCountsResponse(counts entities.AttendanceCounts) dto.AttendanceCountsResponse
+	PlanAttendanceResponse(plan *entities.InterventionPlan, counts entities.AttendanceCounts) *dto.PlanAttendanceResponse
+}
+
+type interventionMapper struct{}
+
+func NewInterventionMapper() Mapper {
+	return &interventionMapper{}
+}
+
+func (m *interventionMapper) CreateRequestToPlan(req *dto.InterventionPlanCreateRequest) *entities.InterventionPlan {
+	return &entities.InterventionPlan{
+		ChildId:        req.ChildId,
+		TherapistId:    req.TherapistId,
+		TherapySection: req.TherapySection,
+		StartDate:      req.StartDate,
+		EndDate:        req.EndDate,
+		SessionMinutes: req.SessionMinutes,
+		IsActive:       true,
+		Slots:          m.SlotsFromRequest(req.Slots),
+	}
+}
+
+func (m *interventionMapper) SlotsFromRequest(slots []dto.InterventionPlanSlotInput) []entities.InterventionPlanSlot {
+	result := make([]entities.InterventionPlanSlot, 0, len(slots))
+	for _, slot := range slots {
+		result = append(result, entities.InterventionPlanSlot{
+			DayOfWeek: *slot.DayOfWeek,
+			StartTime: slot.StartTime,
+		})
+	}
+
+	return result
+}
+
+// RecordRequestToSession expects the care plan goals the request refers to,
+// keyed by id.
+func (m *interventionMapper) RecordRequestToSession(req *dto.RecordSessionRequest, plan *entities.InterventionPlan, therapistId string, carePlanGoals map[int]*entities.CarePlanGoal) *entities.InterventionSession {
+	session := &entities.InterventionSession{
+		PlanId:         plan.Id,
+		ChildId:        plan.ChildId,
+		TherapistId:    therapistId,
+		TherapySection: plan.TherapySection,
+		SessionDate:    req.SessionDate,
+		StartTime:      req.StartTime,
+		Status:         req.Status,
+		RecordedAt:     time.Now(),
+		Goals:          m.GoalsFromRequest(req.Goals, carePlanGoals),
+		Measurements:   m.MeasurementsFromRequest(req, therapistId),
+	}
+
+	switch req.Status {
+	case string(constants.InterventionStatusAttended):
+		session.Subjective = req.Subjective
+		session.Objective = req.Objective
+		session.Assessment = req.Assessment
+		session.Plan = req.Plan
+	case string(constants.InterventionStatusCancelled):
+		session.CancelReason = req.CancelReason
+	}
+
+	return session
+}
+
+// GoalsFromRequest takes a care plan goal's own description when the
+// therapist did not write one.
+func (m *interventionMapper) GoalsFromRequest(goals []dto.SessionGoalInput, carePlanGoals map[int]*entities.CarePlanGoal) []entities.InterventionSessionGoal {
+	result := make([]entities.InterventionSessionGoal, 0, len(goals))
+	for _, goal := range goals {
+		description := goal.Description
+		if description == "" && goal.CarePlanGoalId != nil {
+			description = carePlanGoals[*goal.CarePlanGoalId].Description
+		}
+
+		result = append(result, entities.InterventionSessionGoal{
+			CarePlanGoalId: goal.CarePlanGoalId,
+			Description:    description,
+			Note:           goal.Note,
+		})
+	}
+
+	return result
+}
+
+func (m *interventionMapper) MeasurementsFromRequest(req *dto.RecordSessionRequest, therapistId string) []entities.CarePlanGoalMeasurement {
+	result := make([]entities.CarePlanGoalMeasurement, 0)
+	for _, goal := range req.Goals {
+		if goal.CarePlanGoalId == nil || goal.Value == nil {
+			continue
+		}
+
+		result = append(result, entities.CarePlanGoalMeasurement{
+			GoalId:      *goal.CarePlanGoalId,
+			TherapistId: &therapistId,
+			Value:       *goal.Value,
+			Note:        goal.Note,
+			MeasuredOn:  req.SessionDate,
+		})
+	}
+
+	return result
+}
+
+func (m *interventionMapper) PlanResponse(plan *entities.InterventionPlan) *dto.InterventionPlanResponse {
+	response := &dto.InterventionPlanResponse{
+		PlanId:         plan.Id,
+		ChildId:        plan.ChildId,
+		TherapistId:    plan.TherapistId,
+		TherapySection: plan.TherapySection,
+		StartDate:      plan.StartDate,
+		EndDate:        plan.EndDate,
+		SessionMinutes: plan.SessionMinutes,
+		IsActive:       plan.IsActive,
+		Slots:          make([]*dto.InterventionPlanSlotResponse, 0, len(plan.Slots)),
+	}
+
+	if plan.Children != nil {
+		response.ChildName = plan.Children.ChildName
+	}
+
+	if plan.Therapist != nil {
+		response.TherapistName = plan.Therapist.TherapistName
+	}
+
+	for _, slot := range plan.Slots {
+		response.Slots = append(response.Slots, &dto.InterventionPlanSlotResponse{
+			DayOfWeek: slot.DayOfWeek,
+			StartTime: slot.StartTime,
+		})
+	}
+
+	return response
+}
+
+func (m *interventionMapper) TodaySessionResponse(plan *entities.InterventionPlan, occurrence entities.SessionOccurrence, session *entities.InterventionSession) *dto.TodaySessionResponse {
+	response := &dto.TodaySessionResponse{
+		PlanId:         plan.Id,
+		ChildId:        plan.ChildId,
+		TherapySection: plan.TherapySection,
+		SessionDate:    occurrence.SessionDate,
+		StartTime:      occurrence.StartTime,
+		SessionMinutes: plan.SessionMinutes,
+		Status:         string(constants.InterventionStatusScheduled),
+	}
+
+	if plan.Children != nil {
+		response.ChildName = plan.Children.ChildName
+	}
+
+	if session != nil {
+		response.SessionId = &session.Id
+		response.Status = session.Status
+	}
+
+	return response
+}
+
+func (m *interventionMapper) SessionResponse(session *entities.InterventionSession) *dto.InterventionSessionResponse {
+	response := &dto.InterventionSessionResponse{
+		SessionId:      session.Id,
+		PlanId:         session.PlanId,
+		ChildId:        session.ChildId,
+		TherapistId:    session.TherapistId,
+		TherapySection: session.TherapySection,
+		SessionDate:    session.SessionDate,
+		StartTime:      session.StartTime,
+		Status:         session.Status,
+		CancelReason:   session.CancelReason,
+		Subjective:     session.Subjective,
+		Objective:      session.Objective,
+		Assessment:     session.Assessment,
+		Plan:           session.Plan,
+		Goals:          make([]*dto.SessionGoalResponse, 0, len(session.Goals)),
+		RecordedAt:     session.RecordedAt,
+	}
+
+	if session.Children != nil {
+		response.ChildName = session.Children.ChildName
+	}
+
+	if session.Therapist != nil {
+		response.TherapistName = session.Therapist.TherapistName
+	}
+
+	values := make(map[int]float64, len(session.Measurements))
+	for _, measurement := range session.Measurements {
+		values[measurement.GoalId] = measurement.Value
+	}
+
+	for _, goal := range session.Goals {
+		goalResponse := &dto.SessionGoalResponse{
+			GoalId:         goal.Id,
+			CarePlanGoalId: goal.CarePlanGoalId,
+			Description:    goal.Description,
+			Note:           goal.Note,
+		}
+
+		if goal.CarePlanGoalId != nil {
+			if value, ok := values[*goal.CarePlanGoalId]; ok {
+				goalResponse.Value = &value
+			}
+		}
+
+		response.Goals = append(response.Goals, goalResponse)
+	}
+
+	return response
+}
+
+func (m *interventionMapper) AttendanceCountsResponse(counts entities.AttendanceCounts) dto.AttendanceCountsResponse {
+	return dto.AttendanceCountsResponse{
+		Planned:        counts.Planned,
+		Attended:       counts.Attended,
+		Cancelled:      counts.Cancelled,
+		NoShow:         counts.NoShow,
+		Unrecorded:     counts.Unrecorded,
+		AttendanceRate: math.Round(counts.Rate()*10) / 10,
+	}
+}
+
+func (m *interventionMapper) PlanAttendanceResponse(plan *entities.InterventionPlan, counts entities.AttendanceCounts) *dto.PlanAttendanceResponse {
+	response := &dto.PlanAttendanceResponse{
+		PlanId:                   plan.Id,
+		ChildId:                  plan.ChildId,
+		TherapistId:              plan.TherapistId,
+		TherapySection:           plan.TherapySection,
+		AttendanceCountsResponse: m.AttendanceCountsResponse(counts),
+	}
+
+	if plan.Children != nil {
+		response.ChildName = plan.Children.ChildName
+	}
+
+	if plan.Therapist != nil {
+		response.TherapistName = plan.Therapist.TherapistName
+	}
+
+	return response
+}
@@ -0,0 +1,30 @@
+package intervention
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/helpers"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// resumeProgramme puts the child's programme back to Active when a plan
+// starts. A programme that was paused or had ended gets a fresh evaluation
+// date one interval after the plan starts.
+func resumeProgramme(ctx context.Context, deps *Dependencies, tx *gorm.DB, childId string, from helpers.DateOnly) error {
+	carePlan, err := deps.CarePlanRepo.GetOrCreateByChildId(ctx, tx, childId)
+	if err != nil {
+		return err
+	}
+
+	if carePlan.ProgrammeStatus == string(constants.ProgrammeStatusActive) && carePlan.NextEvaluationDate != nil {
+		return nil
+	}
+
+	next := entities.DefaultEvaluationDate(from)
+	carePlan.ProgrammeStatus = string(constants.ProgrammeStatusActive)
+	carePlan.NextEvaluationDate = &next
+
+	return deps.CarePlanRepo.UpdateProgramme(ctx, tx, carePlan)
+}
@@ -0,0 +1,131 @@
+package intervention
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+type recordSessionUseCase struct {
+	deps *Dependencies
+}
+
+func NewRecordSessionUseCase(deps *Dependencies) RecordSessionUseCase {
+	return &recordSessionUseCase{deps: deps}
+}
+
+// Execute records the outcome of a planned session, or corrects one recorded
+// earlier. Only cancellations may be recorded before the session starts.
+func (uc *recordSessionUseCase) Execute(ctx context.Context, req *dto.RecordSessionRequest) error {
+	if err := uc.deps.Validator.ValidateRecordSessionRequest(req); err != nil {
+		return err
+	}
+
+	therapist, err := sessionTherapist(ctx, uc.deps)
+	if err != nil {
+		return err
+	}
+
+	plan, err := uc.deps.PlanRepo.GetById(ctx, req.PlanId)
+	if err != nil {
+		return errors.ErrInterventionPlanNotFound
+	}
+
+	if plan.TherapistId != therapist.Id {
+		return errors.ErrInterventionPlanNotAssigned
+	}
+
+	if !plan.HasOccurrence(req.SessionDate, req.StartTime) {
+		return errors.ErrSessionNotInPlan
+	}
+
+	startAt, err := req.SessionDate.At(req.StartTime, time.Local)
+	if err != nil {
+		return errors.ErrSessionNotInPlan
+	}
+	if req.Status != string(constants.InterventionStatusCancelled) && startAt.After(time.Now()) {
+		return errors.ErrSessionInFuture
+	}
+
+	carePlanGoals, err := uc.carePlanGoals(ctx, req, plan)
+	if err != nil {
+		return err
+	}
+
+	session := uc.deps.Mapper.RecordRequestToSession(req, plan, therapist.Id, carePlanGoals)
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if _, err := uc.deps.PlanRepo.LockById(ctx, tx, plan.Id); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	existing, err := uc.deps.SessionRepo.GetByOccurrence(ctx, tx, plan.Id, req.SessionDate, req.StartTime)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	if existing == nil {
+		if err := uc.deps.SessionRepo.Create(ctx, tx, session); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+		}
+	} else {
+		session.Id = existing.Id
+		if err := uc.deps.SessionRepo.Update(ctx, tx, session); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+		}
+	}
+
+	if err := uc.deps.SessionRepo.ReplaceGoals(ctx, tx, session.Id, session.Goals); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := uc.deps.CarePlanRepo.ReplaceSessionMeasurements(ctx, tx, session.Id, session.Measurements); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
+
+// carePlanGoals loads the care plan goals the session refers to. They must be
+// active goals of the plan's child in the plan's section.
+func (uc *recordSessionUseCase) carePlanGoals(ctx context.Context, req *dto.RecordSessionRequest, plan *entities.InterventionPlan) (map[int]*entities.CarePlanGoal, error) {
+	goals := make(map[int]*entities.CarePlanGoal)
+	for _, input := range req.Goals {
+		if input.CarePlanGoalId == nil {
+			continue
+		}
+
+		goal, err := uc.deps.CarePlanRepo.GetGoalById(ctx, *input.CarePlanGoalId)
+		if err != nil || goal.ChildId != plan.ChildId || goal.TherapySection != plan.TherapySection {
+			return nil, errors.ErrGoalNotInPlan
+		}
+
+		if !goal.IsActive() {
+			return nil, errors.ErrGoalNotActive
+		}
+
+		goals[goal.Id] = goal
+	}
+
+	return goals, nil
+}
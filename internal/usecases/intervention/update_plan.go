@@ -0,0 +1,94 @@
+package intervention
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+	"time"
+)
+
+type updatePlanUseCase struct {
+	deps *Dependencies
+}
+
+func NewUpdatePlanUseCase(deps *Dependencies) UpdatePlanUseCase {
+	return &updatePlanUseCase{deps: deps}
+}
+
+// Execute changes how the plan recurs from now on. Sessions already recorded
+// keep the therapist and time they were recorded with. Reactivating a plan
+// resumes the child's programme.
+func (uc *updatePlanUseCase) Execute(ctx context.Context, planId int, req *dto.InterventionPlanUpdateRequest) error {
+	if err := uc.deps.Validator.ValidateUpdatePlanRequest(req); err != nil {
+		return err
+	}
+
+	plan, err := uc.deps.PlanRepo.GetById(ctx, planId)
+	if err != nil {
+		return errors.ErrInterventionPlanNotFound
+	}
+
+	if err := validatePeriod(plan.StartDate, req.EndDate); err != nil {
+		return err
+	}
+
+	if _, err := activeTherapist(ctx, uc.deps, req.TherapistId, plan.TherapySection); err != nil {
+		return err
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if _, err := uc.deps.PlanRepo.LockById(ctx, tx, plan.Id); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	if err := uc.deps.ChildRepo.LockById(ctx, tx, plan.ChildId); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	reactivated := *req.IsActive && !plan.IsActive
+	if reactivated {
+		exists, err := uc.deps.PlanRepo.ExistActiveByChildSection(ctx, tx, plan.ChildId, plan.TherapySection, plan.Id)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+		}
+		if exists {
+			tx.Rollback()
+			return errors.ErrInterventionPlanExists
+		}
+	}
+
+	plan.TherapistId = req.TherapistId
+	plan.EndDate = req.EndDate
+	plan.SessionMinutes = req.SessionMinutes
+	plan.IsActive = *req.IsActive
+	plan.Slots = uc.deps.Mapper.SlotsFromRequest(req.Slots)
+
+	if err := uc.deps.PlanRepo.Update(ctx, tx, plan); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if reactivated {
+		if err := resumeProgramme(ctx, uc.deps, tx, plan.ChildId, helpers.DateOnly(time.Now())); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
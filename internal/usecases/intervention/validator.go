@@ -0,0 +1,121 @@
+package intervention
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"backend-golang/internal/validator"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Validator interface {
+	ValidateCreatePlanRequest(req *dto.InterventionPlanCreateRequest) error
+	ValidateUpdatePlanRequest(req *dto.InterventionPlanUpdateRequest) error
+	ValidatePlanListQuery(req *dto.InterventionPlanListQuery) error
+	ValidateRecordSessionRequest(req *dto.RecordSessionRequest) error
+	ValidateAttendanceReportQuery(req *dto.AttendanceReportQuery) error
+}
+
+type interventionValidator struct{}
+
+func NewInterventionValidator() Validator {
+	return &interventionValidator{}
+}
+
+func (v *interventionValidator) ValidateCreatePlanRequest(req *dto.InterventionPlanCreateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	if req.StartDate.ToTime().Before(time.Now().Truncate(24 * time.Hour)) {
+		return errors.ErrScheduleInPast
+	}
+
+	if err := validatePeriod(req.StartDate, req.EndDate); err != nil {
+		return err
+	}
+
+	return validateSlots(req.Slots)
+}
+
+func (v *interventionValidator) ValidateUpdatePlanRequest(req *dto.InterventionPlanUpdateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return validateSlots(req.Slots)
+}
+
+func (v *interventionValidator) ValidatePlanListQuery(req *dto.InterventionPlanListQuery) error {
+	return validator.ValidateStruct(req)
+}
+
+// ValidateRecordSessionRequest checks the notes each outcome needs: SOAP notes
+// for an attended session and a reason for a cancelled one.
+func (v *interventionValidator) ValidateRecordSessionRequest(req *dto.RecordSessionRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	switch req.Status {
+	case string(constants.InterventionStatusAttended):
+		for _, note := range []string{req.Subjective, req.Objective, req.Assessment, req.Plan} {
+			if strings.TrimSpace(note) == "" {
+				return errors.ErrSoapNotesRequired
+			}
+		}
+	case string(constants.InterventionStatusCancelled):
+		if strings.TrimSpace(req.CancelReason) == "" {
+			return errors.ErrCancelReasonRequired
+		}
+	}
+
+	if req.Status != string(constants.InterventionStatusAttended) && len(req.Goals) > 0 {
+		return errors.ErrSessionGoalsNotAllowed
+	}
+
+	linked := make(map[int]bool, len(req.Goals))
+	for _, goal := range req.Goals {
+		if goal.CarePlanGoalId == nil {
+			if goal.Value != nil {
+				return errors.ErrGoalValueWithoutGoal
+			}
+			continue
+		}
+
+		if linked[*goal.CarePlanGoalId] {
+			return errors.ErrDuplicateSessionGoal
+		}
+		linked[*goal.CarePlanGoalId] = true
+	}
+
+	return nil
+}
+
+func (v *interventionValidator) ValidateAttendanceReportQuery(req *dto.AttendanceReportQuery) error {
+	return validator.ValidateStruct(req)
+}
+
+func validatePeriod(startDate helpers.DateOnly, endDate *helpers.DateOnly) error {
+	if endDate != nil && endDate.ToTime().Before(startDate.ToTime()) {
+		return errors.ErrInvalidPlanPeriod
+	}
+
+	return nil
+}
+
+func validateSlots(slots []dto.InterventionPlanSlotInput) error {
+	seen := make(map[string]bool, len(slots))
+	for _, slot := range slots {
+		key := fmt.Sprintf("%d %s", *slot.DayOfWeek, slot.StartTime)
+		if seen[key] {
+			return errors.ErrDuplicatePlanSlot
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package observation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type amendObservationUseCase struct {
+	deps *Dependencies
+}
+
+func NewAmendObservationUseCase(deps *Dependencies) AmendObservationUseCase {
+	return &amendObservationUseCase{deps: deps}
+}
+
+// Execute corrects a completed observation. The current state is kept as a
+// revision before the corrected answers are rescored and written over it.
+func (uc *amendObservationUseCase) Execute(ctx context.Context, observationId int, req *dto.AmendObservationRequest) error {
+	if err := uc.deps.Validator.ValidateAmendRequest(req); err != nil {
+		return err
+	}
+
+	observation, err := accessibleObservation(ctx, uc.deps, observationId)
+	if err != nil {
+		return err
+	}
+
+	if observation.Status != string(constants.ObservationStatusCompleted) {
+		return errors.ErrObservationNotCompleted
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	current, err := uc.deps.ObservationRepo.LockById(ctx, tx, observationId)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	if current.Status != string(constants.ObservationStatusCompleted) {
+		tx.Rollback()
+		return errors.ErrObservationStatusChanged
+	}
+
+	currentAnswers, err := uc.deps.ObservationAnswerRepo.GetByObservationId(ctx, observationId)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	currentScores, err := uc.deps.DomainScoreRepo.GetByObservationId(ctx, observationId)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	answerInputs := mergeAnswers(currentAnswers, req.Answers)
+
+	result, questions, err := scoreAnswers(ctx, uc.deps, current, answerInputs)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := checkComplete(answerInputs, questions); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	revisionNumber, err := uc.deps.RevisionRepo.NextRevisionNumber(ctx, tx, observationId)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	actorId, _ := helpers.GetUserID(ctx)
+	actorRole, _ := helpers.GetUserRole(ctx)
+
+	revision := &entities.ObservationRevision{
+		ObservationId:      observationId,
+		Revision:           revisionNumber,
+		TotalScore:         current.TotalScore,
+		Conclusion:         current.Conclusion,
+		Recommendation:     current.Recommendation,
+		SuggestedRiskLevel: current.SuggestedRiskLevel,
+		SuggestedSection:   current.SuggestedSection,
+		RiskLevel:          current.RiskLevel,
+		TherapySection:     current.TherapySection,
+		Answers:            make([]entities.ObservationAnswer, 0, len(currentAnswers)),
+		DomainScores:       currentScores,
+		Reason:             strings.TrimSpace(req.Reason),
+		ActorId:            actorId,
+		ActorRole:          actorRole,
+		CreatedAt:          time.Now(),
+	}
+	for _, answer := range currentAnswers {
+		revision.Answers = append(revision.Answers, *answer)
+	}
+
+	if err := uc.deps.RevisionRepo.Create(ctx, tx, revision); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	amended := *current
+	if strings.TrimSpace(req.Conclusion) != "" {
+		amended.Conclusion = req.Conclusion
+	}
+	if strings.TrimSpace(req.Recommendation) != "" {
+		amended.Recommendation = req.Recommendation
+	}
+
+	// A decision the therapist made against the suggestion survives the
+	// rescore unless it is replaced explicitly.
+	riskLevel := req.RiskLevel
+	if riskLevel == "" && current.RiskLevel != current.SuggestedRiskLevel {
+		riskLevel = current.RiskLevel
+	}
+	therapySection := req.TherapySection
+	if therapySection == "" && current.TherapySection != current.SuggestedSection {
+		therapySection = current.TherapySection
+	}
+
+	applyScoring(&amended, result, riskLevel, therapySection)
+
+	answers, err := uc.deps.Mapper.AnswersToEntities(observationId, answerInputs, questions)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrInternalServer, err)
+	}
+
+	if err := uc.deps.ObservationRepo.UpdateAfterObservation(ctx, tx, &amended); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := uc.deps.ObservationAnswerRepo.Upsert(ctx, tx, answers); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := uc.deps.DomainScoreRepo.ReplaceByObservationId(ctx, tx, observationId, result.DomainScores); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Int("observationId", observationId).Int("revision", revisionNumber).Msg("Observation amended")
+	return nil
+}
@@ -0,0 +1,55 @@
+package observation
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/usecases/access"
+	"context"
+)
+
+// accessibleObservation hides observations assigned to other therapists so a
+// therapist cannot tell them apart from missing ones.
+func accessibleObservation(ctx context.Context, deps *Dependencies, observationId int) (*entities.Observation, error) {
+	if observationId == 0 {
+		return nil, errors.ErrObservationNotFound
+	}
+
+	therapist, err := access.CurrentTherapist(ctx, deps.TherapistRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	observation, err := deps.ObservationRepo.GetById(ctx, observationId)
+	if err != nil || observation == nil {
+		return nil, errors.ErrObservationNotFound
+	}
+
+	if therapist != nil && observation.TherapistId != therapist.Id {
+		return nil, errors.ErrObservationNotFound
+	}
+
+	return observation, nil
+}
+
+// assignedObservation is for writes only the assigned therapist may make.
+func assignedObservation(ctx context.Context, deps *Dependencies, observationId int) (*entities.Observation, error) {
+	if observationId == 0 {
+		return nil, errors.ErrObservationNotFound
+	}
+
+	therapist, err := access.CurrentTherapist(ctx, deps.TherapistRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	observation, err := deps.ObservationRepo.GetById(ctx, observationId)
+	if err != nil || observation == nil {
+		return nil, errors.ErrObservationNotFound
+	}
+
+	if therapist == nil || observation.TherapistId != therapist.Id {
+		return nil, errors.ErrObservationNotAssigned
+	}
+
+	return observation, nil
+}
@@ -0,0 +1,109 @@
+package observation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+// mergeAnswers lays the given answers over the saved draft so a final submit
+// only needs to carry what changed since the last save.
+func mergeAnswers(drafts []*entities.ObservationAnswer, answers []dto.AnswerInput) []dto.AnswerInput {
+	merged := make([]dto.AnswerInput, 0, len(drafts)+len(answers))
+	position := make(map[int]int, len(drafts)+len(answers))
+
+	put := func(answer dto.AnswerInput) {
+		if i, ok := position[answer.QuestionId]; ok {
+			merged[i] = answer
+			return
+		}
+		position[answer.QuestionId] = len(merged)
+		merged = append(merged, answer)
+	}
+
+	for _, draft := range drafts {
+		answer := dto.AnswerInput{QuestionId: draft.QuestionId, Answer: draft.Answer}
+		if draft.Note != nil {
+			answer.Note = *draft.Note
+		}
+		put(answer)
+	}
+
+	for _, answer := range answers {
+		put(answer)
+	}
+
+	return merged
+}
+
+// checkComplete requires an answer to every active question; scoreAnswers has
+// already rejected answers to any other question.
+func checkComplete(answers []dto.AnswerInput, questions map[int]*entities.ObservationQuestion) error {
+	answered := make(map[int]bool, len(answers))
+	for _, answer := range answers {
+		answered[answer.QuestionId] = true
+	}
+
+	if len(answered) < len(questions) {
+		return errors.ErrObservationIncomplete
+	}
+
+	return nil
+}
+
+// completeObservation scores the full answer set, requires every active
+// question to be answered and moves the observation to Completed.
+func completeObservation(ctx context.Context, deps *Dependencies, observation *entities.Observation, req *dto.SubmitObservationRequest) error {
+	result, questions, err := scoreAnswers(ctx, deps, observation, req.Answers)
+	if err != nil {
+		return err
+	}
+
+	if err := checkComplete(req.Answers, questions); err != nil {
+		return err
+	}
+
+	tx := deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	updatedObservation, answers, err := deps.Mapper.UpdateToObservationAndCreateToAnswer(ctx, observation.Id, req, questions)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	applyScoring(updatedObservation, result, req.RiskLevel, req.TherapySection)
+
+	if err := deps.ObservationRepo.UpdateAfterObservation(ctx, tx, updatedObservation); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := deps.ObservationAnswerRepo.Upsert(ctx, tx, answers); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := deps.DomainScoreRepo.ReplaceByObservationId(ctx, tx, observation.Id, result.DomainScores); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := transitionObservation(ctx, tx, deps, observation, constants.ObservationStatusCompleted, ""); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
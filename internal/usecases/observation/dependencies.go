@@ -2,6 +2,7 @@ package observation
 
 import (
 	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/domain/services"
 )
 
 type Dependencies struct {
@@ -10,6 +11,15 @@ type Dependencies struct {
 	ObservationQuestionsRepo repositories.ObservationQuestionRepository
 	ObservationAnswerRepo    repositories.ObservationAnswerRepository
 	TherapistRepo            repositories.TherapistRepository
+	WorkingHourRepo          repositories.TherapistWorkingHourRepository
+	TimeOffRepo              repositories.TherapistTimeOffRepository
+	StatusHistoryRepo        repositories.ObservationStatusHistoryRepository
+	ThresholdRepo            repositories.ScoringThresholdRepository
+	DomainScoreRepo          repositories.ObservationDomainScoreRepository
+	QuestionnaireVersionRepo repositories.QuestionnaireVersionRepository
+	DomainRepo               repositories.ObservationDomainRepository
+	RevisionRepo             repositories.ObservationRevisionRepository
+	ScoringService           services.ScoringService
 	Validator                Validator
 	Mapper                   Mapper
 }
@@ -20,6 +30,15 @@ func NewDependencies(
 	observationQuestionsRepo repositories.ObservationQuestionRepository,
 	observationAnswerRepo repositories.ObservationAnswerRepository,
 	therapistRepo repositories.TherapistRepository,
+	workingHourRepo repositories.TherapistWorkingHourRepository,
+	timeOffRepo repositories.TherapistTimeOffRepository,
+	statusHistoryRepo repositories.ObservationStatusHistoryRepository,
+	thresholdRepo repositories.ScoringThresholdRepository,
+	domainScoreRepo repositories.ObservationDomainScoreRepository,
+	questionnaireVersionRepo repositories.QuestionnaireVersionRepository,
+	domainRepo repositories.ObservationDomainRepository,
+	revisionRepo repositories.ObservationRevisionRepository,
+	scoringService services.ScoringService,
 ) *Dependencies {
 	return &Dependencies{
 		TxRepo:                   txRepo,
@@ -27,7 +46,16 @@ func NewDependencies(
 		ObservationQuestionsRepo: observationQuestionsRepo,
 		ObservationAnswerRepo:    observationAnswerRepo,
 		TherapistRepo:            therapistRepo,
+		WorkingHourRepo:          workingHourRepo,
+		TimeOffRepo:              timeOffRepo,
+		StatusHistoryRepo:        statusHistoryRepo,
+		ThresholdRepo:            thresholdRepo,
+		DomainScoreRepo:          domainScoreRepo,
+		QuestionnaireVersionRepo: questionnaireVersionRepo,
+		DomainRepo:               domainRepo,
+		RevisionRepo:             revisionRepo,
+		ScoringService:           scoringService,
 		Validator:                NewObservationValidator(),
-		Mapper:                   NewObservationMapper(observationQuestionsRepo, therapistRepo),
+		Mapper:                   NewObservationMapper(therapistRepo),
 	}
 }
@@ -0,0 +1,64 @@
+package observation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"strings"
+)
+
+type finaliseObservationUseCase struct {
+	deps *Dependencies
+}
+
+func NewFinaliseObservationUseCase(deps *Dependencies) FinaliseObservationUseCase {
+	return &finaliseObservationUseCase{deps: deps}
+}
+
+// Execute completes an observation from its saved draft; notes sent here take
+// precedence over the drafted ones.
+func (uc *finaliseObservationUseCase) Execute(ctx context.Context, observationId int, req *dto.FinaliseObservationRequest) error {
+	if err := uc.deps.Validator.ValidateFinaliseRequest(req); err != nil {
+		return err
+	}
+
+	observation, err := assignedObservation(ctx, uc.deps, observationId)
+	if err != nil {
+		return err
+	}
+
+	if err := checkTransition(observation, constants.ObservationStatusCompleted, ""); err != nil {
+		return err
+	}
+
+	drafts, err := uc.deps.ObservationAnswerRepo.GetByObservationId(ctx, observationId)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	submission := &dto.SubmitObservationRequest{
+		Answers:        mergeAnswers(drafts, nil),
+		Conclusion:     observation.Conclusion,
+		Recommendation: observation.Recommendation,
+		RiskLevel:      req.RiskLevel,
+		TherapySection: req.TherapySection,
+	}
+	if strings.TrimSpace(req.Conclusion) != "" {
+		submission.Conclusion = req.Conclusion
+	}
+	if strings.TrimSpace(req.Recommendation) != "" {
+		submission.Recommendation = req.Recommendation
+	}
+
+	if strings.TrimSpace(submission.Conclusion) == "" || strings.TrimSpace(submission.Recommendation) == "" {
+		return errors.ErrObservationNotesRequired
+	}
+
+	if len(submission.Answers) == 0 {
+		return errors.ErrObservationIncomplete
+	}
+
+	return completeObservation(ctx, uc.deps, observation, submission)
+}
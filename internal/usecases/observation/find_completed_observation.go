@@ -2,8 +2,7 @@ package observation
 
 import (
 	"backend-golang/internal/adapters/http/dto"
-	"backend-golang/internal/domain/entities"
-	"backend-golang/internal/errors"
+	"backend-golang/internal/usecases/pagination"
 	"context"
 	"fmt"
 )
@@ -16,14 +15,19 @@ func NewFindCompletedObservationsUseCase(deps *Dependencies) FindCompletedObserv
 	return &findCompletedObservationsUseCase{deps: deps}
 }
 
-func (uc *findCompletedObservationsUseCase) Execute(ctx context.Context) ([]*dto.ObservationsResponse, error) {
-	observations, err := uc.deps.ObservationRepo.GetByCompletedStatus(ctx)
+func (uc *findCompletedObservationsUseCase) Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.ObservationsResponse, *dto.PaginationMeta, error) {
+	query, err := pagination.ToListQuery(req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+		return nil, nil, err
+	}
+
+	observations, pageInfo, err := uc.deps.ObservationRepo.GetByCompletedStatus(ctx, query)
+	if err != nil {
+		return nil, nil, pagination.RetrievalError(err)
 	}
 
 	if observations == nil {
-		return []*dto.ObservationsResponse{}, nil
+		return []*dto.ObservationsResponse{}, pagination.ToMeta(pageInfo), nil
 	}
 
 	responses := make([]*dto.ObservationsResponse, 0, len(observations))
@@ -32,14 +36,11 @@ func (uc *findCompletedObservationsUseCase) Execute(ctx context.Context) ([]*dto
 			continue
 		}
 
-		var parentDetail *entities.ParentDetail
-		if len(observation.Children.Parent.ParentDetail) > 0 {
-			parentDetail = &observation.Children.Parent.ParentDetail[0]
-		}
+		parentDetail := observation.Children.Parent.PrimaryContact()
 
 		response, err := uc.deps.Mapper.ObservationsResponse(parentDetail, observation.Children, observation)
 		if err != nil {
-			return nil, fmt.Errorf("failed to map observation %d: %w", observation.Id, err)
+			return nil, nil, fmt.Errorf("failed to map observation %d: %w", observation.Id, err)
 		}
 
 		if response != nil {
@@ -47,5 +48,5 @@ func (uc *findCompletedObservationsUseCase) Execute(ctx context.Context) ([]*dto
 		}
 	}
 
-	return responses, nil
+	return responses, pagination.ToMeta(pageInfo), nil
 }
@@ -3,7 +3,8 @@ package observation
 import (
 	"backend-golang/internal/adapters/http/dto"
 	"backend-golang/internal/domain/entities"
-	"backend-golang/internal/errors"
+	"backend-golang/internal/usecases/access"
+	"backend-golang/internal/usecases/pagination"
 	"context"
 	"fmt"
 )
@@ -16,14 +17,30 @@ func NewFindScheduledObservationsUseCase(deps *Dependencies) FindScheduledObserv
 	return &findScheduledObservationsUseCase{deps: deps}
 }
 
-func (uc *findScheduledObservationsUseCase) Execute(ctx context.Context) ([]*dto.ObservationsResponse, error) {
-	observations, err := uc.deps.ObservationRepo.GetByScheduledStatus(ctx)
+func (uc *findScheduledObservationsUseCase) Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.ObservationsResponse, *dto.PaginationMeta, error) {
+	query, err := pagination.ToListQuery(req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+		return nil, nil, err
+	}
+
+	therapist, err := access.CurrentTherapist(ctx, uc.deps.TherapistRepo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var observations []*entities.Observation
+	var pageInfo *entities.PageInfo
+	if therapist != nil {
+		observations, pageInfo, err = uc.deps.ObservationRepo.GetScheduledByTherapistId(ctx, therapist.Id, query)
+	} else {
+		observations, pageInfo, err = uc.deps.ObservationRepo.GetByScheduledStatus(ctx, query)
+	}
+	if err != nil {
+		return nil, nil, pagination.RetrievalError(err)
 	}
 
 	if observations == nil {
-		return []*dto.ObservationsResponse{}, nil
+		return []*dto.ObservationsResponse{}, pagination.ToMeta(pageInfo), nil
 	}
 
 	responses := make([]*dto.ObservationsResponse, 0, len(observations))
@@ -32,14 +49,11 @@ func (uc *findScheduledObservationsUseCase) Execute(ctx context.Context) ([]*dto
 			continue
 		}
 
-		var parentDetail *entities.ParentDetail
-		if len(observation.Children.Parent.ParentDetail) > 0 {
-			parentDetail = &observation.Children.Parent.ParentDetail[0]
-		}
+		parentDetail := observation.Children.Parent.PrimaryContact()
 
 		response, err := uc.deps.Mapper.ObservationsResponse(parentDetail, observation.Children, observation)
 		if err != nil {
-			return nil, fmt.Errorf("failed to map observation %d: %w", observation.Id, err)
+			return nil, nil, fmt.Errorf("failed to map observation %d: %w", observation.Id, err)
 		}
 
 		if response != nil {
@@ -47,5 +61,5 @@ func (uc *findScheduledObservationsUseCase) Execute(ctx context.Context) ([]*dto
 		}
 	}
 
-	return responses, nil
+	return responses, pagination.ToMeta(pageInfo), nil
 }
@@ -6,15 +6,15 @@ import (
 )
 
 type FindPendingObservationsUseCase interface {
-	Execute(ctx context.Context) ([]*dto.ObservationsResponse, error)
+	Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.ObservationsResponse, *dto.PaginationMeta, error)
 }
 
 type FindScheduledObservationsUseCase interface {
-	Execute(ctx context.Context) ([]*dto.ObservationsResponse, error)
+	Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.ObservationsResponse, *dto.PaginationMeta, error)
 }
 
 type FindCompletedObservationsUseCase interface {
-	Execute(ctx context.Context) ([]*dto.ObservationsResponse, error)
+	Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.ObservationsResponse, *dto.PaginationMeta, error)
 }
 
 type FindObservationDetailUseCase interface {
@@ -25,10 +25,38 @@ type UpdateObservationDateUseCase interface {
 	Execute(ctx context.Context, observationId int, req *dto.UpdateObservationDateRequest) error
 }
 
+type UpdateObservationStatusUseCase interface {
+	Execute(ctx context.Context, observationId int, req *dto.UpdateObservationStatusRequest) error
+}
+
 type QuestionsUseCase interface {
 	Execute(ctx context.Context, observationId int) ([]*dto.ObservationQuestionsResponse, error)
 }
 
+type PreviewObservationScoreUseCase interface {
+	Execute(ctx context.Context, observationId int, req *dto.ScorePreviewRequest) (*dto.ScoringResultResponse, error)
+}
+
 type SubmitObservationUseCase interface {
 	Execute(ctx context.Context, observationId int, req *dto.SubmitObservationRequest) error
 }
+
+type FindObservationDraftUseCase interface {
+	Execute(ctx context.Context, observationId int) (*dto.ObservationDraftResponse, error)
+}
+
+type SaveObservationDraftUseCase interface {
+	Execute(ctx context.Context, observationId int, req *dto.SaveObservationDraftRequest) error
+}
+
+type FinaliseObservationUseCase interface {
+	Execute(ctx context.Context, observationId int, req *dto.FinaliseObservationRequest) error
+}
+
+type FindObservationAnswersUseCase interface {
+	Execute(ctx context.Context, observationId int, req *dto.ObservationAnswersQuery) (*dto.ObservationAnswerSheetResponse, error)
+}
+
+type AmendObservationUseCase interface {
+	Execute(ctx context.Context, observationId int, req *dto.AmendObservationRequest) error
+}
@@ -26,28 +26,31 @@ type Mapper interface {
 		child *entities.Children,
 		observation *entities.Observation,
 	) (*dto.DetailObservationResponse, error)
-	UpdateToObservationAndCreateToAnswer(ctx context.Context, observationId int, req *dto.SubmitObservationRequest) (*entities.Observation, []*entities.ObservationAnswer, error)
+	UpdateToObservationAndCreateToAnswer(ctx context.Context, observationId int, req *dto.SubmitObservationRequest, questions map[int]*entities.ObservationQuestion) (*entities.Observation, []*entities.ObservationAnswer, error)
+	AnswersToEntities(observationId int, answers []dto.AnswerInput, questions map[int]*entities.ObservationQuestion) ([]*entities.ObservationAnswer, error)
+	ObservationDraftResponse(observation *entities.Observation, answers []*entities.ObservationAnswer, questionCount int) *dto.ObservationDraftResponse
+	ScoringResultResponse(result *entities.ScoringResult) *dto.ScoringResultResponse
+	DomainScoresResponse(scores []entities.ObservationDomainScore) []*dto.DomainScoreResponse
+	StatusHistoryResponse(history *entities.ObservationStatusHistory) *dto.ObservationStatusHistoryResponse
+	RevisionResponse(revision *entities.ObservationRevision) *dto.ObservationRevisionResponse
+	AnswerSheetResponse(observation *entities.Observation, sheet []entities.AnswerSheetDomain) *dto.ObservationAnswerSheetResponse
+	CompareAnswerSheets(current *dto.ObservationAnswerSheetResponse, previous *dto.ObservationAnswerSheetResponse)
 }
 
 type observationMapper struct {
-	encryptionKey            string
-	observationQuestionsRepo repositories.ObservationQuestionRepository
-	therapistRepo            repositories.TherapistRepository
+	encryptionKey string
+	therapistRepo repositories.TherapistRepository
 }
 
-func NewObservationMapper(
-	observationQuestionsRepo repositories.ObservationQuestionRepository,
-	therapistRepo repositories.TherapistRepository,
-) Mapper {
+func NewObservationMapper(therapistRepo repositories.TherapistRepository) Mapper {
 	key := config.GetEnv("ENCRYPTION_KEY", "")
 	if key == "" {
 		log.Fatal().Err(fmt.Errorf("missing encrypted key"))
 	}
 
 	return &observationMapper{
-		encryptionKey:            key,
-		observationQuestionsRepo: observationQuestionsRepo,
-		therapistRepo:            therapistRepo,
+		encryptionKey: key,
+		therapistRepo: therapistRepo,
 	}
 }
 
@@ -85,6 +88,11 @@ func (m *observationMapper) ObservationsResponse(parentDetail *entities.ParentDe
 		}
 	}
 
+	var therapistName string
+	if observation.Therapist != nil {
+		therapistName = observation.Therapist.TherapistName
+	}
+
 	return &dto.ObservationsResponse{
 		ObservationId:  observation.Id,
 		AgeCategory:    observation.AgeCategory,
@@ -95,7 +103,11 @@ func (m *observationMapper) ObservationsResponse(parentDetail *entities.ParentDe
 		ParentName:     parentName,
 		ParentPhone:    parentPhone,
 		ScheduledDate:  observation.ScheduledDate,
+		ScheduledStart: observation.ScheduledStart,
+		ScheduledEnd:   observation.ScheduledEnd,
 		Status:         observation.Status,
+		TherapistId:    observation.TherapistId,
+		TherapistName:  therapistName,
 	}, nil
 }
 
@@ -155,6 +167,11 @@ func (m *observationMapper) ObservationDetailResponse(
 		}
 	}
 
+	var therapistName string
+	if observation.Therapist != nil {
+		therapistName = observation.Therapist.TherapistName
+	}
+
 	return &dto.DetailObservationResponse{
 		ObservationId:  observation.Id,
 		ChildName:      childName,
@@ -168,10 +185,24 @@ func (m *observationMapper) ObservationDetailResponse(
 		ParentPhone:    parentPhone,
 		ChildComplaint: childComplaint,
 		Email:          parentEmail,
+		ScheduledStart: observation.ScheduledStart,
+		ScheduledEnd:   observation.ScheduledEnd,
+		TherapistId:    observation.TherapistId,
+		TherapistName:  therapistName,
+		Status:         observation.Status,
+
+		TotalScore:         observation.TotalScore,
+		SuggestedRiskLevel: observation.SuggestedRiskLevel,
+		SuggestedSection:   observation.SuggestedSection,
+		RiskLevel:          observation.RiskLevel,
+		TherapySection:     observation.TherapySection,
+		DomainScores:       m.DomainScoresResponse(observation.DomainScores),
+		Conclusion:         observation.Conclusion,
+		Recommendation:     observation.Recommendation,
 	}, nil
 }
 
-func (m *observationMapper) UpdateToObservationAndCreateToAnswer(ctx context.Context, observationId int, req *dto.SubmitObservationRequest) (*entities.Observation, []*entities.ObservationAnswer, error) {
+func (m *observationMapper) UpdateToObservationAndCreateToAnswer(ctx context.Context, observationId int, req *dto.SubmitObservationRequest, questions map[int]*entities.ObservationQuestion) (*entities.Observation, []*entities.ObservationAnswer, error) {
 	userId, ok := helpers.GetUserID(ctx)
 	if !ok {
 		return nil, nil, fmt.Errorf("userId not found in context")
@@ -187,6 +218,7 @@ func (m *observationMapper) UpdateToObservationAndCreateToAnswer(ctx context.Con
 	}
 
 	observation := &entities.Observation{
+		Id:             observationId,
 		TherapistId:    therapist.Id,
 		Conclusion:     req.Conclusion,
 		Recommendation: req.Recommendation,
@@ -197,16 +229,21 @@ func (m *observationMapper) UpdateToObservationAndCreateToAnswer(ctx context.Con
 		return observation, nil, errors.New("at least one answer is required")
 	}
 
-	observationAnswers := make([]*entities.ObservationAnswer, 0, len(req.Answers))
-	var totalScore int
+	observationAnswers, err := m.AnswersToEntities(observationId, req.Answers, questions)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	for _, answerInput := range req.Answers {
-		question, err := m.observationQuestionsRepo.GetById(ctx, answerInput.QuestionId)
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to get question with id %d: %w", answerInput.QuestionId, err)
-		}
-		if question == nil {
-			return nil, nil, fmt.Errorf("question with id %d not found", answerInput.QuestionId)
+	return observation, observationAnswers, nil
+}
+
+func (m *observationMapper) AnswersToEntities(observationId int, answers []dto.AnswerInput, questions map[int]*entities.ObservationQuestion) ([]*entities.ObservationAnswer, error) {
+	observationAnswers := make([]*entities.ObservationAnswer, 0, len(answers))
+
+	for _, answerInput := range answers {
+		question, ok := questions[answerInput.QuestionId]
+		if !ok || question == nil {
+			return nil, fmt.Errorf("question with id %d not found", answerInput.QuestionId)
 		}
 
 		var scoreEarned int
@@ -230,11 +267,178 @@ func (m *observationMapper) UpdateToObservationAndCreateToAnswer(ctx context.Con
 		}
 
 		observationAnswers = append(observationAnswers, observationAnswer)
-		totalScore += scoreEarned
-		log.Debug().Int("total_score", totalScore).Msg("Calculated total score")
 	}
 
-	observation.TotalScore = totalScore
+	return observationAnswers, nil
+}
 
-	return observation, observationAnswers, nil
+func (m *observationMapper) ObservationDraftResponse(observation *entities.Observation, answers []*entities.ObservationAnswer, questionCount int) *dto.ObservationDraftResponse {
+	answerResponses := make([]*dto.DraftAnswerResponse, 0, len(answers))
+	for _, answer := range answers {
+		var note string
+		if answer.Note != nil {
+			note = *answer.Note
+		}
+
+		answerResponses = append(answerResponses, &dto.DraftAnswerResponse{
+			QuestionId: answer.QuestionId,
+			Answer:     answer.Answer,
+			Note:       note,
+		})
+	}
+
+	return &dto.ObservationDraftResponse{
+		ObservationId:  observation.Id,
+		Status:         observation.Status,
+		Conclusion:     observation.Conclusion,
+		Recommendation: observation.Recommendation,
+		Answers:        answerResponses,
+		AnsweredCount:  len(answerResponses),
+		QuestionCount:  questionCount,
+		DraftSavedAt:   observation.DraftSavedAt,
+	}
+}
+
+func (m *observationMapper) ScoringResultResponse(result *entities.ScoringResult) *dto.ScoringResultResponse {
+	return &dto.ScoringResultResponse{
+		TotalScore:       result.TotalScore,
+		RiskLevel:        result.RiskLevel,
+		SuggestedSection: result.SuggestedSection,
+		DomainScores:     m.DomainScoresResponse(result.DomainScores),
+	}
+}
+
+func (m *observationMapper) DomainScoresResponse(scores []entities.ObservationDomainScore) []*dto.DomainScoreResponse {
+	responses := make([]*dto.DomainScoreResponse, 0, len(scores))
+	for _, score := range scores {
+		responses = append(responses, &dto.DomainScoreResponse{
+			Domain:    score.Domain,
+			Score:     score.Score,
+			MaxScore:  score.MaxScore,
+			RiskLevel: score.RiskLevel,
+		})
+	}
+
+	return responses
+}
+
+func (m *observationMapper) StatusHistoryResponse(history *entities.ObservationStatusHistory) *dto.ObservationStatusHistoryResponse {
+	return &dto.ObservationStatusHistoryResponse{
+		FromStatus: history.FromStatus,
+		ToStatus:   history.ToStatus,
+		Reason:     history.Reason,
+		ActorId:    history.ActorId,
+		ActorRole:  history.ActorRole,
+		CreatedAt:  history.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (m *observationMapper) RevisionResponse(revision *entities.ObservationRevision) *dto.ObservationRevisionResponse {
+	answers := make([]*dto.RevisionAnswerResponse, 0, len(revision.Answers))
+	for _, answer := range revision.Answers {
+		var note string
+		if answer.Note != nil {
+			note = *answer.Note
+		}
+
+		answers = append(answers, &dto.RevisionAnswerResponse{
+			QuestionId:  answer.QuestionId,
+			Answer:      answer.Answer,
+			ScoreEarned: answer.ScoreEarned,
+			Note:        note,
+		})
+	}
+
+	return &dto.ObservationRevisionResponse{
+		Revision:           revision.Revision,
+		TotalScore:         revision.TotalScore,
+		SuggestedRiskLevel: revision.SuggestedRiskLevel,
+		SuggestedSection:   revision.SuggestedSection,
+		RiskLevel:          revision.RiskLevel,
+		TherapySection:     revision.TherapySection,
+		Conclusion:         revision.Conclusion,
+		Recommendation:     revision.Recommendation,
+		Answers:            answers,
+		DomainScores:       m.DomainScoresResponse(revision.DomainScores),
+		Reason:             revision.Reason,
+		ActorId:            revision.ActorId,
+		ActorRole:          revision.ActorRole,
+		CreatedAt:          revision.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (m *observationMapper) AnswerSheetResponse(observation *entities.Observation, sheet []entities.AnswerSheetDomain) *dto.ObservationAnswerSheetResponse {
+	domains := make([]*dto.AnswerSheetDomainResponse, 0, len(sheet))
+	for _, domain := range sheet {
+		answers := make([]*dto.AnswerSheetItemResponse, 0, len(domain.Answers))
+		for _, item := range domain.Answers {
+			answers = append(answers, &dto.AnswerSheetItemResponse{
+				QuestionId:     item.QuestionId,
+				QuestionCode:   item.QuestionCode,
+				QuestionNumber: item.QuestionNumber,
+				QuestionText:   item.QuestionText,
+				Answer:         item.Answer,
+				Score:          item.Score,
+				ScoreEarned:    item.ScoreEarned,
+				Note:           item.Note,
+			})
+		}
+
+		domains = append(domains, &dto.AnswerSheetDomainResponse{
+			Domain:     domain.Code,
+			DomainName: domain.Name,
+			Score:      domain.Score,
+			MaxScore:   domain.MaxScore,
+			RiskLevel:  domain.RiskLevel,
+			Answers:    answers,
+		})
+	}
+
+	return &dto.ObservationAnswerSheetResponse{
+		ObservationId:  observation.Id,
+		ScheduledDate:  observation.ScheduledDate,
+		AgeCategory:    observation.AgeCategory,
+		TotalScore:     observation.TotalScore,
+		RiskLevel:      observation.RiskLevel,
+		TherapySection: observation.TherapySection,
+		Conclusion:     observation.Conclusion,
+		Recommendation: observation.Recommendation,
+		Domains:        domains,
+	}
+}
+
+// CompareAnswerSheets annotates current with the earlier sheet. Domains match
+// by code and questions by question code, since the two observations may use
+// different questionnaire versions.
+func (m *observationMapper) CompareAnswerSheets(current *dto.ObservationAnswerSheetResponse, previous *dto.ObservationAnswerSheetResponse) {
+	previousDomains := make(map[string]*dto.AnswerSheetDomainResponse, len(previous.Domains))
+	previousItems := make(map[string]*dto.AnswerSheetItemResponse)
+	for _, domain := range previous.Domains {
+		previousDomains[domain.Domain] = domain
+		for _, item := range domain.Answers {
+			previousItems[item.QuestionCode] = item
+		}
+	}
+
+	for _, domain := range current.Domains {
+		if previousDomain, ok := previousDomains[domain.Domain]; ok {
+			previousScore := previousDomain.Score
+			delta := domain.Score - previousScore
+			domain.PreviousScore = &previousScore
+			domain.ScoreDelta = &delta
+		}
+
+		for _, item := range domain.Answers {
+			if previousItem, ok := previousItems[item.QuestionCode]; ok {
+				previousAnswer := previousItem.Answer
+				previousScoreEarned := previousItem.ScoreEarned
+				item.PreviousAnswer = &previousAnswer
+				item.PreviousScoreEarned = &previousScoreEarned
+			}
+		}
+	}
+
+	delta := current.TotalScore - previous.TotalScore
+	current.TotalScoreDelta = &delta
+	current.ComparedWith = previous
 }
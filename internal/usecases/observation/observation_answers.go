@@ -0,0 +1,94 @@
+package observation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findObservationAnswersUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindObservationAnswersUseCase(deps *Dependencies) FindObservationAnswersUseCase {
+	return &findObservationAnswersUseCase{deps: deps}
+}
+
+func (uc *findObservationAnswersUseCase) Execute(ctx context.Context, observationId int, req *dto.ObservationAnswersQuery) (*dto.ObservationAnswerSheetResponse, error) {
+	if err := uc.deps.Validator.ValidateAnswersQuery(req); err != nil {
+		return nil, err
+	}
+
+	observation, err := accessibleObservation(ctx, uc.deps, observationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if observation.Status != string(constants.ObservationStatusCompleted) {
+		return nil, errors.ErrAnswerSheetNotAvailable
+	}
+
+	domains, err := uc.deps.DomainRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	sheet, err := answerSheet(ctx, uc.deps, observation, domains)
+	if err != nil {
+		return nil, err
+	}
+	response := uc.deps.Mapper.AnswerSheetResponse(observation, sheet)
+
+	if req.CompareWith == 0 {
+		return response, nil
+	}
+
+	previous, err := uc.deps.ObservationRepo.GetById(ctx, req.CompareWith)
+	if err != nil || previous == nil {
+		return nil, errors.ErrInvalidComparison
+	}
+
+	if previous.Id == observation.Id ||
+		previous.ChildId != observation.ChildId ||
+		previous.Status != string(constants.ObservationStatusCompleted) ||
+		previous.ScheduledDate.ToTime().After(observation.ScheduledDate.ToTime()) {
+		return nil, errors.ErrInvalidComparison
+	}
+
+	previousSheet, err := answerSheet(ctx, uc.deps, previous, domains)
+	if err != nil {
+		return nil, err
+	}
+	uc.deps.Mapper.CompareAnswerSheets(response, uc.deps.Mapper.AnswerSheetResponse(previous, previousSheet))
+
+	return response, nil
+}
+
+// answerSheet loads what the observation was scored against: every question
+// of its questionnaire version, so answers to questions deactivated since
+// are still shown.
+func answerSheet(ctx context.Context, deps *Dependencies, observation *entities.Observation, domains []*entities.ObservationDomain) ([]entities.AnswerSheetDomain, error) {
+	if observation.QuestionnaireVersionId == nil {
+		return nil, errors.ErrQuestionnaireVersionNotFound
+	}
+
+	answers, err := deps.ObservationAnswerRepo.GetByObservationId(ctx, observation.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	questions, err := deps.ObservationQuestionsRepo.GetByVersionId(ctx, *observation.QuestionnaireVersionId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	scores, err := deps.DomainScoreRepo.GetByObservationId(ctx, observation.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	return entities.BuildAnswerSheet(answers, questions, domains, scores), nil
+}
@@ -2,6 +2,7 @@ package observation
 
 import (
 	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
 	"backend-golang/internal/domain/entities"
 	"backend-golang/internal/errors"
 	"context"
@@ -17,17 +18,9 @@ func NewFindObservationDetailUseCase(deps *Dependencies) FindObservationDetailUs
 }
 
 func (uc *findObservationDetailUseCase) Execute(ctx context.Context, observationId int) (*dto.DetailObservationResponse, error) {
-	if observationId == 0 {
-		return nil, fmt.Errorf("observationId is required")
-	}
-
-	observationDetail, err := uc.deps.ObservationRepo.GetById(ctx, observationId)
+	observationDetail, err := accessibleObservation(ctx, uc.deps, observationId)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
-	}
-
-	if observationDetail == nil {
-		return &dto.DetailObservationResponse{}, nil
+		return nil, err
 	}
 
 	var parent *entities.Parent
@@ -38,12 +31,16 @@ func (uc *findObservationDetailUseCase) Execute(ctx context.Context, observation
 		child = observationDetail.Children
 		if child.Parent != nil {
 			parent = child.Parent
-			if len(parent.ParentDetail) > 0 {
-				parentDetail = &parent.ParentDetail[0]
-			}
+			parentDetail = parent.PrimaryContact()
 		}
 	}
 
+	domainScores, err := uc.deps.DomainScoreRepo.GetByObservationId(ctx, observationDetail.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+	observationDetail.DomainScores = domainScores
+
 	response, err := uc.deps.Mapper.ObservationDetailResponse(
 		parent,
 		parentDetail,
@@ -54,5 +51,28 @@ func (uc *findObservationDetailUseCase) Execute(ctx context.Context, observation
 		return nil, fmt.Errorf("failed to map observation %d: %w", observationDetail.Id, err)
 	}
 
+	histories, err := uc.deps.StatusHistoryRepo.GetByObservationId(ctx, observationDetail.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	response.StatusHistory = make([]*dto.ObservationStatusHistoryResponse, 0, len(histories))
+	for _, history := range histories {
+		if history.ToStatus == string(constants.ObservationStatusRescheduled) {
+			response.RescheduleCount++
+		}
+		response.StatusHistory = append(response.StatusHistory, uc.deps.Mapper.StatusHistoryResponse(history))
+	}
+
+	revisions, err := uc.deps.RevisionRepo.GetByObservationId(ctx, observationDetail.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	response.Revisions = make([]*dto.ObservationRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, uc.deps.Mapper.RevisionResponse(revision))
+	}
+
 	return response, nil
 }
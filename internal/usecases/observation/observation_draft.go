@@ -0,0 +1,35 @@
+package observation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findObservationDraftUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindObservationDraftUseCase(deps *Dependencies) FindObservationDraftUseCase {
+	return &findObservationDraftUseCase{deps: deps}
+}
+
+func (uc *findObservationDraftUseCase) Execute(ctx context.Context, observationId int) (*dto.ObservationDraftResponse, error) {
+	observation, err := accessibleObservation(ctx, uc.deps, observationId)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, _, err := activeQuestions(ctx, uc.deps, observation)
+	if err != nil {
+		return nil, err
+	}
+
+	answers, err := uc.deps.ObservationAnswerRepo.GetByObservationId(ctx, observationId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	return uc.deps.Mapper.ObservationDraftResponse(observation, answers, len(questions)), nil
+}
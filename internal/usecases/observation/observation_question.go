@@ -16,22 +16,24 @@ func NewObservationQuestionsUseCase(deps *Dependencies) QuestionsUseCase {
 }
 
 func (uc *observationQuestionsUseCase) Execute(ctx context.Context, observationId int) ([]*dto.ObservationQuestionsResponse, error) {
-	observation, err := uc.deps.ObservationRepo.GetById(ctx, observationId)
+	observation, err := accessibleObservation(ctx, uc.deps, observationId)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+		return nil, err
 	}
-	if observation == nil {
-		return nil, fmt.Errorf("observation with id %d not found", observationId)
+
+	versionId, err := questionnaireVersionId(ctx, uc.deps, observation)
+	if err != nil {
+		return nil, err
 	}
 
-	questions, err := uc.deps.ObservationQuestionsRepo.GetByAgeCategory(ctx, observation.AgeCategory)
+	questions, err := uc.deps.ObservationQuestionsRepo.GetByAgeCategory(ctx, versionId, observation.AgeCategory)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
 	}
 
 	responses := make([]*dto.ObservationQuestionsResponse, 0, len(questions))
 	for _, question := range questions {
-		if question == nil {
+		if question == nil || !question.IsActive {
 			continue
 		}
 
@@ -0,0 +1,32 @@
+package observation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type previewObservationScoreUseCase struct {
+	deps *Dependencies
+}
+
+func NewPreviewObservationScoreUseCase(deps *Dependencies) PreviewObservationScoreUseCase {
+	return &previewObservationScoreUseCase{deps: deps}
+}
+
+func (uc *previewObservationScoreUseCase) Execute(ctx context.Context, observationId int, req *dto.ScorePreviewRequest) (*dto.ScoringResultResponse, error) {
+	if err := uc.deps.Validator.ValidateScorePreviewRequest(req); err != nil {
+		return nil, err
+	}
+
+	observation, err := accessibleObservation(ctx, uc.deps, observationId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, _, err := scoreAnswers(ctx, uc.deps, observation, req.Answers)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.deps.Mapper.ScoringResultResponse(result), nil
+}
@@ -0,0 +1,39 @@
+package observation
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// questionnaireVersionId is the version an observation is answered against:
+// the one pinned when it started, or the published one before that.
+func questionnaireVersionId(ctx context.Context, deps *Dependencies, observation *entities.Observation) (int, error) {
+	if observation.QuestionnaireVersionId != nil {
+		return *observation.QuestionnaireVersionId, nil
+	}
+
+	version, err := deps.QuestionnaireVersionRepo.GetPublished(ctx)
+	if err != nil || version == nil {
+		return 0, errors.ErrQuestionnaireNotPublished
+	}
+
+	return version.Id, nil
+}
+
+func pinQuestionnaireVersion(ctx context.Context, tx *gorm.DB, deps *Dependencies, observation *entities.Observation) error {
+	versionId, err := questionnaireVersionId(ctx, deps, observation)
+	if err != nil {
+		return err
+	}
+
+	if err := deps.ObservationRepo.PinQuestionnaireVersion(ctx, tx, observation.Id, versionId); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	observation.QuestionnaireVersionId = &versionId
+	return nil
+}
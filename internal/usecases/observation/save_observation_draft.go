@@ -0,0 +1,80 @@
+package observation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	internalError "backend-golang/internal/errors"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type saveObservationDraftUseCase struct {
+	deps *Dependencies
+}
+
+func NewSaveObservationDraftUseCase(deps *Dependencies) SaveObservationDraftUseCase {
+	return &saveObservationDraftUseCase{deps: deps}
+}
+
+func (uc *saveObservationDraftUseCase) Execute(ctx context.Context, observationId int, req *dto.SaveObservationDraftRequest) error {
+	if err := uc.deps.Validator.ValidateSaveDraftRequest(req); err != nil {
+		return err
+	}
+
+	observation, err := assignedObservation(ctx, uc.deps, observationId)
+	if err != nil {
+		return err
+	}
+
+	if observation.Status != string(constants.ObservationStatusInProgress) {
+		return internalError.ErrObservationNotInProgress
+	}
+
+	var answers []*entities.ObservationAnswer
+	if len(req.Answers) > 0 {
+		_, questions, err := activeQuestions(ctx, uc.deps, observation)
+		if err != nil {
+			return err
+		}
+
+		for _, answer := range req.Answers {
+			if _, ok := questions[answer.QuestionId]; !ok {
+				return internalError.ErrQuestionNotInObservation
+			}
+		}
+
+		answers, err = uc.deps.Mapper.AnswersToEntities(observationId, mergeAnswers(nil, req.Answers), questions)
+		if err != nil {
+			return fmt.Errorf("%w: %v", internalError.ErrInternalServer, err)
+		}
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.ObservationRepo.SaveDraft(ctx, tx, observationId, req.Conclusion, req.Recommendation); err != nil {
+		tx.Rollback()
+		if errors.Is(err, repositories.ErrStatusChanged) {
+			return internalError.ErrObservationNotInProgress
+		}
+		return fmt.Errorf("%w: %v", internalError.ErrUpdateFailed, err)
+	}
+
+	if err := uc.deps.ObservationAnswerRepo.Upsert(ctx, tx, answers); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", internalError.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", internalError.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
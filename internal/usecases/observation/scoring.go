@@ -0,0 +1,83 @@
+package observation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+// activeQuestions returns the questions an observation has to answer: the
+// active ones of its age category in its questionnaire version.
+func activeQuestions(ctx context.Context, deps *Dependencies, observation *entities.Observation) ([]*entities.ObservationQuestion, map[int]*entities.ObservationQuestion, error) {
+	versionId, err := questionnaireVersionId(ctx, deps, observation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	questions, err := deps.ObservationQuestionsRepo.GetByAgeCategory(ctx, versionId, observation.AgeCategory)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	active := make([]*entities.ObservationQuestion, 0, len(questions))
+	questionById := make(map[int]*entities.ObservationQuestion, len(questions))
+	for _, question := range questions {
+		if question == nil || !question.IsActive {
+			continue
+		}
+		active = append(active, question)
+		questionById[question.Id] = question
+	}
+
+	return active, questionById, nil
+}
+
+// scoreAnswers rates the answers against the observation's active questions
+// and returns those questions keyed by id.
+func scoreAnswers(ctx context.Context, deps *Dependencies, observation *entities.Observation, answers []dto.AnswerInput) (*entities.ScoringResult, map[int]*entities.ObservationQuestion, error) {
+	questions, questionById, err := activeQuestions(ctx, deps, observation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	given := make(map[int]bool, len(answers))
+	for _, answer := range answers {
+		if _, ok := questionById[answer.QuestionId]; !ok {
+			return nil, nil, errors.ErrQuestionNotInObservation
+		}
+		given[answer.QuestionId] = answer.Answer
+	}
+
+	thresholds, err := deps.ThresholdRepo.GetByAgeCategory(ctx, observation.AgeCategory)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	domains, err := deps.DomainRepo.GetAll(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	return deps.ScoringService.Score(questions, given, thresholds, domains), questionById, nil
+}
+
+// applyScoring stores the engine's suggestion next to the therapist's final
+// decision, which defaults to the suggestion when not overridden.
+func applyScoring(observation *entities.Observation, result *entities.ScoringResult, riskLevel string, therapySection string) {
+	observation.TotalScore = result.TotalScore
+	observation.SuggestedRiskLevel = result.RiskLevel
+	observation.SuggestedSection = result.SuggestedSection
+	observation.DomainScores = result.DomainScores
+
+	observation.RiskLevel = result.RiskLevel
+	if riskLevel != "" {
+		observation.RiskLevel = riskLevel
+	}
+
+	observation.TherapySection = result.SuggestedSection
+	if therapySection != "" {
+		observation.TherapySection = therapySection
+	}
+}
@@ -2,6 +2,7 @@ package observation
 
 import (
 	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
 	"backend-golang/internal/errors"
 	"context"
 	"fmt"
@@ -20,40 +21,26 @@ func (uc *submitObservationUseCase) Execute(ctx context.Context, observationId i
 		return fmt.Errorf("ObservationId is required")
 	}
 
-	tx := uc.deps.TxRepo.Begin(ctx)
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
+	if err := uc.deps.Validator.ValidateSubmitRequest(req); err != nil {
+		return err
+	}
 
-	observation, answers, err := uc.deps.Mapper.UpdateToObservationAndCreateToAnswer(ctx, observationId, req)
+	observation, err := assignedObservation(ctx, uc.deps, observationId)
 	if err != nil {
-		tx.Rollback()
-		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+		return err
 	}
 
-	if err := uc.deps.ObservationRepo.UpdateAfterObservation(
-		ctx,
-		tx,
-		observationId,
-		observation.TherapistId,
-		observation.TotalScore,
-		observation.Conclusion,
-		observation.Recommendation,
-	); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	if err := checkTransition(observation, constants.ObservationStatusCompleted, ""); err != nil {
+		return err
 	}
 
-	if err := uc.deps.ObservationAnswerRepo.Create(ctx, tx, answers); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	drafts, err := uc.deps.ObservationAnswerRepo.GetByObservationId(ctx, observationId)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
-	}
+	submission := *req
+	submission.Answers = mergeAnswers(drafts, req.Answers)
 
-	return nil
+	return completeObservation(ctx, uc.deps, observation, &submission)
 }
@@ -0,0 +1,65 @@
+package observation
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	internalError "backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// checkTransition validates a status change without touching the database.
+func checkTransition(observation *entities.Observation, to constants.ObservationStatus, reason string) error {
+	if !observation.CanTransitionTo(to) {
+		return internalError.ErrInvalidStatusTransition
+	}
+
+	if entities.TransitionRequiresReason(to) && strings.TrimSpace(reason) == "" {
+		return internalError.ErrTransitionReasonRequired
+	}
+
+	return nil
+}
+
+// transitionObservation is the only place an existing observation's status is
+// written; every change is recorded with the acting user.
+func transitionObservation(ctx context.Context, tx *gorm.DB, deps *Dependencies, observation *entities.Observation, to constants.ObservationStatus, reason string) error {
+	if err := checkTransition(observation, to, reason); err != nil {
+		return err
+	}
+
+	from := constants.ObservationStatus(observation.Status)
+	if err := deps.ObservationRepo.UpdateStatus(ctx, tx, observation.Id, from, to); err != nil {
+		if errors.Is(err, repositories.ErrStatusChanged) {
+			return internalError.ErrObservationStatusChanged
+		}
+		return fmt.Errorf("%w: %v", internalError.ErrUpdateFailed, err)
+	}
+
+	actorId, _ := helpers.GetUserID(ctx)
+	actorRole, _ := helpers.GetUserRole(ctx)
+
+	history := &entities.ObservationStatusHistory{
+		ObservationId: observation.Id,
+		FromStatus:    string(from),
+		ToStatus:      string(to),
+		Reason:        strings.TrimSpace(reason),
+		ActorId:       actorId,
+		ActorRole:     actorRole,
+		CreatedAt:     time.Now(),
+	}
+
+	if err := deps.StatusHistoryRepo.Create(ctx, tx, history); err != nil {
+		return fmt.Errorf("%w: %v", internalError.ErrCreationFailed, err)
+	}
+
+	observation.Status = string(to)
+	return nil
+}
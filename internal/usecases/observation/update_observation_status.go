@@ -0,0 +1,88 @@
+package observation
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Scheduling and completion have their own use cases; these are the
+// transitions that only need a reason.
+var statusChangesByRole = map[constants.Role][]constants.ObservationStatus{
+	constants.RoleAdmin:     {constants.ObservationStatusCancelled, constants.ObservationStatusNoShow, constants.ObservationStatusRetracted},
+	constants.RoleTherapist: {constants.ObservationStatusInProgress, constants.ObservationStatusNoShow},
+}
+
+type updateObservationStatusUseCase struct {
+	deps *Dependencies
+}
+
+func NewUpdateObservationStatusUseCase(deps *Dependencies) UpdateObservationStatusUseCase {
+	return &updateObservationStatusUseCase{deps: deps}
+}
+
+func (uc *updateObservationStatusUseCase) Execute(ctx context.Context, observationId int, req *dto.UpdateObservationStatusRequest) error {
+	if err := uc.deps.Validator.ValidateUpdateStatusRequest(req); err != nil {
+		return err
+	}
+
+	role, ok := helpers.GetUserRole(ctx)
+	if !ok {
+		return errors.ErrUnauthorized
+	}
+
+	to := constants.ObservationStatus(req.Status)
+	if !roleMayChangeStatus(constants.Role(role), to) {
+		return errors.ErrForbidden
+	}
+
+	observation, err := accessibleObservation(ctx, uc.deps, observationId)
+	if err != nil {
+		return err
+	}
+
+	if err := checkTransition(observation, to, req.Reason); err != nil {
+		return err
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := transitionObservation(ctx, tx, uc.deps, observation, to, req.Reason); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if to == constants.ObservationStatusInProgress {
+		if err := pinQuestionnaireVersion(ctx, tx, uc.deps, observation); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Int("observationId", observationId).Str("status", req.Status).Msg("Observation status changed")
+	return nil
+}
+
+func roleMayChangeStatus(role constants.Role, to constants.ObservationStatus) bool {
+	for _, allowed := range statusChangesByRole[role] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,9 +2,13 @@ package observation
 
 import (
 	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
 	"backend-golang/internal/errors"
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
 )
 
 type updateObservationDateUseCase struct {
@@ -20,9 +24,109 @@ func (uc *updateObservationDateUseCase) Execute(ctx context.Context, observation
 		return err
 	}
 
-	if err := uc.deps.ObservationRepo.UpdateScheduledDate(ctx, observationId, req.ScheduledDate); err != nil {
+	observation, err := uc.deps.ObservationRepo.GetById(ctx, observationId)
+	if err != nil || observation == nil {
+		return errors.ErrObservationNotFound
+	}
+
+	target := observation.ScheduleTarget()
+	if err := checkTransition(observation, target, req.Reason); err != nil {
+		return err
+	}
+
+	therapistId := req.TherapistId
+	if therapistId == "" {
+		therapistId = observation.TherapistId
+	}
+
+	if therapistId == "" {
+		return errors.ErrTherapistRequired
+	}
+
+	therapist, err := uc.deps.TherapistRepo.GetById(ctx, therapistId)
+	if err != nil || therapist.User == nil || !therapist.User.IsActive {
+		return errors.ErrTherapistNotFound
+	}
+
+	start, err := req.ScheduledDate.At(req.StartTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrInternalServer, err)
+	}
+
+	if start.Before(time.Now()) {
+		return errors.ErrScheduleInPast
+	}
+
+	workingHours, err := uc.deps.WorkingHourRepo.GetByTherapistId(ctx, therapistId)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	period, ok := slotFor(workingHours, start, observation.AgeCategory)
+	if !ok {
+		return errors.ErrOutsideWorkingHours
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.TherapistRepo.LockById(ctx, tx, therapistId); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	onLeave, err := uc.deps.TimeOffRepo.ExistOverlapping(ctx, tx, therapistId, period)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+	if onLeave {
+		tx.Rollback()
+		return errors.ErrTherapistOnLeave
+	}
+
+	booked, err := uc.deps.ObservationRepo.ExistOverlapping(ctx, tx, therapistId, period, observationId)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+	if booked {
+		tx.Rollback()
+		return errors.ErrScheduleConflict
+	}
+
+	if err := uc.deps.ObservationRepo.UpdateScheduledDate(ctx, tx, observationId, req.ScheduledDate, period, therapistId); err != nil {
+		tx.Rollback()
 		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
 	}
 
+	if err := transitionObservation(ctx, tx, uc.deps, observation, target, req.Reason); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Int("observationId", observationId).Str("therapistId", therapistId).Time("start", period.Start).Msg("Observation scheduled")
 	return nil
 }
+
+func slotFor(workingHours []*entities.TherapistWorkingHour, start time.Time, ageCategory string) (entities.TimeRange, bool) {
+	for _, workingHour := range workingHours {
+		if !workingHour.AcceptsAgeCategory(ageCategory) {
+			continue
+		}
+
+		if slot, ok := workingHour.SlotAt(start); ok {
+			return slot, true
+		}
+	}
+
+	return entities.TimeRange{}, false
+}
@@ -7,6 +7,13 @@ import (
 
 type Validator interface {
 	ValidateUpdateScheduledDateRequest(req *dto.UpdateObservationDateRequest) error
+	ValidateUpdateStatusRequest(req *dto.UpdateObservationStatusRequest) error
+	ValidateSubmitRequest(req *dto.SubmitObservationRequest) error
+	ValidateScorePreviewRequest(req *dto.ScorePreviewRequest) error
+	ValidateSaveDraftRequest(req *dto.SaveObservationDraftRequest) error
+	ValidateFinaliseRequest(req *dto.FinaliseObservationRequest) error
+	ValidateAmendRequest(req *dto.AmendObservationRequest) error
+	ValidateAnswersQuery(req *dto.ObservationAnswersQuery) error
 }
 
 type observationValidator struct{}
@@ -22,3 +29,59 @@ func (v *observationValidator) ValidateUpdateScheduledDateRequest(req *dto.Updat
 
 	return nil
 }
+
+func (v *observationValidator) ValidateUpdateStatusRequest(req *dto.UpdateObservationStatusRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *observationValidator) ValidateSubmitRequest(req *dto.SubmitObservationRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *observationValidator) ValidateScorePreviewRequest(req *dto.ScorePreviewRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *observationValidator) ValidateSaveDraftRequest(req *dto.SaveObservationDraftRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *observationValidator) ValidateFinaliseRequest(req *dto.FinaliseObservationRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *observationValidator) ValidateAmendRequest(req *dto.AmendObservationRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *observationValidator) ValidateAnswersQuery(req *dto.ObservationAnswersQuery) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package pagination
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/domain/repositories"
+	internalError "backend-golang/internal/errors"
+	"backend-golang/internal/validator"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func ToListQuery(req *dto.ListQueryRequest) (entities.ListQuery, error) {
+	if req == nil {
+		return entities.ListQuery{Page: 1, PageSize: entities.DefaultPageSize}, nil
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return entities.ListQuery{}, err
+	}
+
+	query := entities.ListQuery{
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Cursor:    req.Cursor,
+		SortBy:    req.SortBy,
+		SortOrder: req.SortOrder,
+		Search:    req.Search,
+	}
+
+	if req.DateFrom != "" {
+		dateFrom, err := time.Parse("2006-01-02", req.DateFrom)
+		if err != nil {
+			return entities.ListQuery{}, internalError.ErrInvalidListQuery
+		}
+		query.DateFrom = &dateFrom
+	}
+
+	if req.DateTo != "" {
+		dateTo, err := time.Parse("2006-01-02", req.DateTo)
+		if err != nil {
+			return entities.ListQuery{}, internalError.ErrInvalidListQuery
+		}
+		query.DateTo = &dateTo
+	}
+
+	if query.DateFrom != nil && query.DateTo != nil && query.DateTo.Before(*query.DateFrom) {
+		return entities.ListQuery{}, internalError.ErrInvalidListQuery
+	}
+
+	return query, nil
+}
+
+func ToMeta(pageInfo *entities.PageInfo) *dto.PaginationMeta {
+	if pageInfo == nil {
+		return nil
+	}
+
+	totalPages := 0
+	if pageInfo.PageSize > 0 {
+		totalPages = int((pageInfo.TotalItems + int64(pageInfo.PageSize) - 1) / int64(pageInfo.PageSize))
+	}
+
+	return &dto.PaginationMeta{
+		Page:       pageInfo.Page,
+		PageSize:   pageInfo.PageSize,
+		TotalItems: pageInfo.TotalItems,
+		TotalPages: totalPages,
+		HasNext:    pageInfo.HasNext,
+		NextCursor: pageInfo.NextCursor,
+	}
+}
+
+// RetrievalError keeps a bad cursor a client error instead of a failed retrieval.
+func RetrievalError(err error) error {
+	if errors.Is(err, repositories.ErrInvalidCursor) {
+		return internalError.ErrInvalidCursor
+	}
+
+	return fmt.Errorf("%w: %v", internalError.ErrRetrievalFailed, err)
+}
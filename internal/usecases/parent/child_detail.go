@@ -0,0 +1,23 @@
+package parent
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type findParentChildDetailUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindParentChildDetailUseCase(deps *Dependencies) FindParentChildDetailUseCase {
+	return &findParentChildDetailUseCase{deps: deps}
+}
+
+func (uc *findParentChildDetailUseCase) Execute(ctx context.Context, childId string) (*dto.ParentChildDetailResponse, error) {
+	child, err := ownedChild(ctx, uc.deps, childId)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.deps.Mapper.ParentChildDetailResponse(child), nil
+}
@@ -0,0 +1,29 @@
+package parent
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+)
+
+type findChildObservationUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindChildObservationUseCase(deps *Dependencies) FindChildObservationUseCase {
+	return &findChildObservationUseCase{deps: deps}
+}
+
+func (uc *findChildObservationUseCase) Execute(ctx context.Context, childId string) (*dto.ParentObservationResponse, error) {
+	child, err := ownedChild(ctx, uc.deps, childId)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := child.LatestObservation()
+	if latest == nil {
+		return nil, errors.ErrObservationNotFound
+	}
+
+	return uc.deps.Mapper.ParentObservationResponse(latest), nil
+}
@@ -0,0 +1,72 @@
+package parent
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type createParentDetailUseCase struct {
+	deps *Dependencies
+}
+
+func NewCreateParentDetailUseCase(deps *Dependencies) CreateParentDetailUseCase {
+	return &createParentDetailUseCase{deps: deps}
+}
+
+func (uc *createParentDetailUseCase) Execute(ctx context.Context, req *dto.ParentDetailCreateRequest) error {
+	if err := uc.deps.Validator.ValidateCreateParentDetailRequest(req); err != nil {
+		return err
+	}
+
+	parent, err := currentParent(ctx, uc.deps)
+	if err != nil {
+		return err
+	}
+
+	exists, err := uc.deps.ParentDetailRepo.ExistByParentType(ctx, parent.Id, req.ParentType)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+	if exists {
+		return errors.ErrParentTypeExists
+	}
+
+	parentDetail, err := uc.deps.Mapper.CreateRequestToParentDetail(parent.Id, req)
+	if err != nil {
+		return err
+	}
+
+	if len(parent.ParentDetail) == 0 {
+		parentDetail.IsPrimary = true
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if parentDetail.IsPrimary {
+		if err := uc.deps.ParentDetailRepo.ClearPrimary(ctx, tx, parent.Id); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+		}
+	}
+
+	if err := uc.deps.ParentDetailRepo.Create(ctx, tx, parentDetail); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Str("parentId", parent.Id).Str("parentDetailId", parentDetail.Id).Msg("Parent detail created")
+	return nil
+}
@@ -0,0 +1,61 @@
+package parent
+
+import (
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type deleteParentDetailUseCase struct {
+	deps *Dependencies
+}
+
+func NewDeleteParentDetailUseCase(deps *Dependencies) DeleteParentDetailUseCase {
+	return &deleteParentDetailUseCase{deps: deps}
+}
+
+func (uc *deleteParentDetailUseCase) Execute(ctx context.Context, parentDetailId string) error {
+	parent, parentDetail, err := ownedParentDetail(ctx, uc.deps, parentDetailId)
+	if err != nil {
+		return err
+	}
+
+	if len(parent.ParentDetail) <= 1 {
+		return errors.ErrLastParentDetail
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.ParentDetailRepo.Delete(ctx, tx, parentDetail.Id); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDeletionFailed, err)
+	}
+
+	if parentDetail.IsPrimary {
+		for _, remaining := range parent.ParentDetail {
+			if remaining.Id == parentDetail.Id {
+				continue
+			}
+
+			if err := uc.deps.ParentDetailRepo.SetPrimary(ctx, tx, remaining.Id); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+			}
+			break
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Str("parentId", parent.Id).Str("parentDetailId", parentDetailId).Msg("Parent detail deleted")
+	return nil
+}
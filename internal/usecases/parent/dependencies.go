@@ -0,0 +1,28 @@
+package parent
+
+import "backend-golang/internal/domain/repositories"
+
+type Dependencies struct {
+	TxRepo           repositories.TransactionRepository
+	ParentRepo       repositories.ParentRepository
+	ParentDetailRepo repositories.ParentDetailRepository
+	ChildRepo        repositories.ChildRepository
+	Validator        Validator
+	Mapper           Mapper
+}
+
+func NewDependencies(
+	txRepo repositories.TransactionRepository,
+	parentRepo repositories.ParentRepository,
+	parentDetailRepo repositories.ParentDetailRepository,
+	childRepo repositories.ChildRepository,
+) *Dependencies {
+	return &Dependencies{
+		TxRepo:           txRepo,
+		ParentRepo:       parentRepo,
+		ParentDetailRepo: parentDetailRepo,
+		ChildRepo:        childRepo,
+		Validator:        NewParentValidator(),
+		Mapper:           NewParentMapper(),
+	}
+}
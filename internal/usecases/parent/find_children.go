@@ -0,0 +1,35 @@
+package parent
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findParentChildrenUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindParentChildrenUseCase(deps *Dependencies) FindParentChildrenUseCase {
+	return &findParentChildrenUseCase{deps: deps}
+}
+
+func (uc *findParentChildrenUseCase) Execute(ctx context.Context) ([]*dto.ParentChildResponse, error) {
+	parent, err := currentParent(ctx, uc.deps)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := uc.deps.ChildRepo.GetByParentId(ctx, parent.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	responses := make([]*dto.ParentChildResponse, 0, len(children))
+	for _, child := range children {
+		responses = append(responses, uc.deps.Mapper.ParentChildResponse(child))
+	}
+
+	return responses, nil
+}
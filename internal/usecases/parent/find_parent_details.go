@@ -0,0 +1,28 @@
+package parent
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type findParentDetailsUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindParentDetailsUseCase(deps *Dependencies) FindParentDetailsUseCase {
+	return &findParentDetailsUseCase{deps: deps}
+}
+
+func (uc *findParentDetailsUseCase) Execute(ctx context.Context) ([]*dto.ParentDetailResponse, error) {
+	parent, err := currentParent(ctx, uc.deps)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.ParentDetailResponse, 0, len(parent.ParentDetail))
+	for i := range parent.ParentDetail {
+		responses = append(responses, uc.deps.Mapper.ParentDetailResponse(&parent.ParentDetail[i]))
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,23 @@
+package parent
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type findParentProfileUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindParentProfileUseCase(deps *Dependencies) FindParentProfileUseCase {
+	return &findParentProfileUseCase{deps: deps}
+}
+
+func (uc *findParentProfileUseCase) Execute(ctx context.Context) (*dto.ParentProfileResponse, error) {
+	parent, err := currentParent(ctx, uc.deps)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.deps.Mapper.ParentProfileResponse(parent), nil
+}
@@ -0,0 +1,42 @@
+package parent
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type FindParentProfileUseCase interface {
+	Execute(ctx context.Context) (*dto.ParentProfileResponse, error)
+}
+
+type FindParentChildrenUseCase interface {
+	Execute(ctx context.Context) ([]*dto.ParentChildResponse, error)
+}
+
+type FindParentChildDetailUseCase interface {
+	Execute(ctx context.Context, childId string) (*dto.ParentChildDetailResponse, error)
+}
+
+type FindChildObservationUseCase interface {
+	Execute(ctx context.Context, childId string) (*dto.ParentObservationResponse, error)
+}
+
+type CreateParentDetailUseCase interface {
+	Execute(ctx context.Context, req *dto.ParentDetailCreateRequest) error
+}
+
+type FindParentDetailsUseCase interface {
+	Execute(ctx context.Context) ([]*dto.ParentDetailResponse, error)
+}
+
+type UpdateParentDetailUseCase interface {
+	Execute(ctx context.Context, parentDetailId string, req *dto.ParentDetailUpdateRequest) error
+}
+
+type DeleteParentDetailUseCase interface {
+	Execute(ctx context.Context, parentDetailId string) error
+}
+
+type SetPrimaryContactUseCase interface {
+	Execute(ctx context.Context, parentDetailId string) error
+}
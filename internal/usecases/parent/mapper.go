@@ -0,0 +1,223 @@
+package parent
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/helpers"
+	"backend-golang/internal/infrastructure/config"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type Mapper interface {
+	ParentProfileResponse(parent *entities.Parent) *dto.ParentProfileResponse
+	ParentDetailResponse(parentDetail *entities.ParentDetail) *dto.ParentDetailResponse
+	CreateRequestToParentDetail(parentId string, req *dto.ParentDetailCreateRequest) (*entities.ParentDetail, error)
+	UpdateRequestToParentDetail(req *dto.ParentDetailUpdateRequest, existing *entities.ParentDetail) (*entities.ParentDetail, error)
+	ParentChildResponse(child *entities.Children) *dto.ParentChildResponse
+	ParentChildDetailResponse(child *entities.Children) *dto.ParentChildDetailResponse
+	ParentObservationResponse(observation *entities.Observation) *dto.ParentObservationResponse
+}
+
+type parentMapper struct {
+	encryptionKey string
+	blindIndexKey string
+}
+
+func NewParentMapper() Mapper {
+	key := config.GetEnv("ENCRYPTION_KEY", "")
+	if key == "" {
+		log.Fatal().Err(fmt.Errorf("missing encrypted key"))
+	}
+
+	blindIndexKey := config.GetEnv("BLIND_INDEX_KEY", "")
+	if blindIndexKey == "" {
+		log.Fatal().Err(fmt.Errorf("missing blind index key"))
+	}
+
+	return &parentMapper{
+		encryptionKey: key,
+		blindIndexKey: blindIndexKey,
+	}
+}
+
+func (m *parentMapper) ParentProfileResponse(parent *entities.Parent) *dto.ParentProfileResponse {
+	response := &dto.ParentProfileResponse{
+		ParentId:           parent.Id,
+		Email:              parent.TempEmail,
+		RegistrationStatus: parent.RegistrationStatus,
+		ParentDetails:      make([]dto.ParentDetailResponse, 0, len(parent.ParentDetail)),
+	}
+
+	if parent.User != nil {
+		response.Username = parent.User.Username
+		response.Email = parent.User.Email
+	}
+
+	for i := range parent.ParentDetail {
+		response.ParentDetails = append(response.ParentDetails, *m.ParentDetailResponse(&parent.ParentDetail[i]))
+	}
+
+	return response
+}
+
+func (m *parentMapper) ParentDetailResponse(parentDetail *entities.ParentDetail) *dto.ParentDetailResponse {
+	return &dto.ParentDetailResponse{
+		ParentDetailId: parentDetail.Id,
+		ParentType:     parentDetail.ParentType,
+		ParentName:     parentDetail.ParentName,
+		ParentPhone:    m.decrypt(parentDetail.ParentPhone),
+		IsPrimary:      parentDetail.IsPrimary,
+		CreatedAt:      parentDetail.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:      parentDetail.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (m *parentMapper) CreateRequestToParentDetail(parentId string, req *dto.ParentDetailCreateRequest) (*entities.ParentDetail, error) {
+	phoneEncrypted, err := helpers.EncryptData([]byte(req.ParentPhone), m.encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt contact: %w", err)
+	}
+
+	phoneIndex, err := helpers.PhoneBlindIndex(req.ParentPhone, m.blindIndexKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to index contact: %w", err)
+	}
+
+	return &entities.ParentDetail{
+		Id:               helpers.GenerateULID(),
+		ParentId:         parentId,
+		ParentType:       req.ParentType,
+		ParentName:       req.ParentName,
+		ParentPhone:      phoneEncrypted,
+		ParentPhoneIndex: phoneIndex,
+		IsPrimary:        req.IsPrimary,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}, nil
+}
+
+func (m *parentMapper) UpdateRequestToParentDetail(req *dto.ParentDetailUpdateRequest, existing *entities.ParentDetail) (*entities.ParentDetail, error) {
+	updatedParentDetail := &entities.ParentDetail{
+		Id:               existing.Id,
+		ParentId:         existing.ParentId,
+		ParentType:       existing.ParentType,
+		ParentName:       existing.ParentName,
+		ParentPhone:      existing.ParentPhone,
+		ParentPhoneIndex: existing.ParentPhoneIndex,
+		IsPrimary:        existing.IsPrimary,
+		CreatedAt:        existing.CreatedAt,
+		UpdatedAt:        time.Now(),
+	}
+
+	if req.ParentType != "" {
+		updatedParentDetail.ParentType = req.ParentType
+	}
+
+	if req.ParentName != "" {
+		updatedParentDetail.ParentName = req.ParentName
+	}
+
+	if req.ParentPhone != "" {
+		phoneEncrypted, err := helpers.EncryptData([]byte(req.ParentPhone), m.encryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt phone: %w", err)
+		}
+		updatedParentDetail.ParentPhone = phoneEncrypted
+
+		phoneIndex, err := helpers.PhoneBlindIndex(req.ParentPhone, m.blindIndexKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to index phone: %w", err)
+		}
+		updatedParentDetail.ParentPhoneIndex = phoneIndex
+	}
+
+	return updatedParentDetail, nil
+}
+
+func (m *parentMapper) ParentChildResponse(child *entities.Children) *dto.ParentChildResponse {
+	response := &dto.ParentChildResponse{
+		ChildId:        child.Id,
+		ChildName:      child.ChildName,
+		ChildGender:    child.ChildGender,
+		ChildBirthDate: child.ChildBirthDate,
+		ChildSchool:    child.ChildSchool,
+	}
+
+	if !child.ChildBirthDate.ToTime().IsZero() {
+		response.ChildAge = helpers.CalculateAge(child.ChildBirthDate.ToTime())
+	}
+
+	if latest := child.LatestObservation(); latest != nil {
+		response.ObservationId = latest.Id
+		response.ObservationStatus = latest.Status
+		response.ScheduledDate = latest.ScheduledDate
+	}
+	response.ObservationCount = len(child.Observations)
+
+	return response
+}
+
+func (m *parentMapper) ParentChildDetailResponse(child *entities.Children) *dto.ParentChildDetailResponse {
+	response := &dto.ParentChildDetailResponse{
+		ChildId:            child.Id,
+		ChildName:          child.ChildName,
+		ChildGender:        child.ChildGender,
+		ChildBirthPlace:    child.ChildBirthPlace,
+		ChildBirthDate:     child.ChildBirthDate,
+		ChildSchool:        child.ChildSchool,
+		ChildAddress:       m.decrypt(child.ChildAddress),
+		ChildComplaint:     child.ChildComplaint,
+		ChildServiceChoice: child.ChildServiceChoice,
+	}
+
+	if !child.ChildBirthDate.ToTime().IsZero() {
+		response.ChildAge = helpers.CalculateAge(child.ChildBirthDate.ToTime())
+	}
+
+	if latest := child.LatestObservation(); latest != nil {
+		response.Observation = m.ParentObservationResponse(latest)
+	}
+
+	response.Observations = make([]*dto.ParentObservationResponse, 0, len(child.Observations))
+	for _, observation := range child.Observations {
+		response.Observations = append(response.Observations, m.ParentObservationResponse(observation))
+	}
+
+	return response
+}
+
+func (m *parentMapper) ParentObservationResponse(observation *entities.Observation) *dto.ParentObservationResponse {
+	response := &dto.ParentObservationResponse{
+		ObservationId: observation.Id,
+		AgeCategory:   observation.AgeCategory,
+		ScheduledDate: observation.ScheduledDate,
+		Status:        observation.Status,
+	}
+
+	if observation.Status == string(constants.ObservationStatusCompleted) {
+		totalScore := observation.TotalScore
+		response.TotalScore = &totalScore
+		response.Conclusion = observation.Conclusion
+		response.Recommendation = observation.Recommendation
+	}
+
+	return response
+}
+
+func (m *parentMapper) decrypt(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	decrypted, err := helpers.DecryptData(data, m.encryptionKey)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to decrypt parent data")
+		return "[Encrypted]"
+	}
+
+	return string(decrypted)
+}
@@ -0,0 +1,67 @@
+package parent
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
+
+func currentParent(ctx context.Context, deps *Dependencies) (*entities.Parent, error) {
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	parent, err := deps.ParentRepo.GetByUserId(ctx, userId)
+	if err != nil {
+		log.Warn().Err(err).Str("userId", userId).Msg("Parent not found for user")
+		return nil, errors.ErrParentNotFound
+	}
+
+	return parent, nil
+}
+
+func ownedChild(ctx context.Context, deps *Dependencies, childId string) (*entities.Children, error) {
+	if childId == "" {
+		return nil, errors.ErrChildNotFound
+	}
+
+	parent, err := currentParent(ctx, deps)
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := deps.ChildRepo.GetById(ctx, childId)
+	if err != nil {
+		return nil, errors.ErrChildNotFound
+	}
+
+	// A child of another family is reported as not found so its existence is not leaked.
+	if child.ParentId != parent.Id {
+		log.Warn().Str("parentId", parent.Id).Str("childId", childId).Msg("Parent tried to access a child they do not own")
+		return nil, errors.ErrChildNotFound
+	}
+
+	return child, nil
+}
+
+func ownedParentDetail(ctx context.Context, deps *Dependencies, parentDetailId string) (*entities.Parent, *entities.ParentDetail, error) {
+	if parentDetailId == "" {
+		return nil, nil, errors.ErrParentDetailNotFound
+	}
+
+	parent, err := currentParent(ctx, deps)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parentDetail, err := deps.ParentDetailRepo.GetById(ctx, parentDetailId)
+	if err != nil || parentDetail.ParentId != parent.Id {
+		return nil, nil, errors.ErrParentDetailNotFound
+	}
+
+	return parent, parentDetail, nil
+}
@@ -0,0 +1,52 @@
+package parent
+
+import (
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type setPrimaryContactUseCase struct {
+	deps *Dependencies
+}
+
+func NewSetPrimaryContactUseCase(deps *Dependencies) SetPrimaryContactUseCase {
+	return &setPrimaryContactUseCase{deps: deps}
+}
+
+func (uc *setPrimaryContactUseCase) Execute(ctx context.Context, parentDetailId string) error {
+	parent, parentDetail, err := ownedParentDetail(ctx, uc.deps, parentDetailId)
+	if err != nil {
+		return err
+	}
+
+	if parentDetail.IsPrimary {
+		return nil
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.ParentDetailRepo.ClearPrimary(ctx, tx, parent.Id); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := uc.deps.ParentDetailRepo.SetPrimary(ctx, tx, parentDetail.Id); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Str("parentId", parent.Id).Str("parentDetailId", parentDetailId).Msg("Primary contact changed")
+	return nil
+}
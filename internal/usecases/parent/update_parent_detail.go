@@ -0,0 +1,63 @@
+package parent
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type updateParentDetailUseCase struct {
+	deps *Dependencies
+}
+
+func NewUpdateParentDetailUseCase(deps *Dependencies) UpdateParentDetailUseCase {
+	return &updateParentDetailUseCase{deps: deps}
+}
+
+func (uc *updateParentDetailUseCase) Execute(ctx context.Context, parentDetailId string, req *dto.ParentDetailUpdateRequest) error {
+	if err := uc.deps.Validator.ValidateUpdateParentDetailRequest(req); err != nil {
+		return err
+	}
+
+	parent, parentDetail, err := ownedParentDetail(ctx, uc.deps, parentDetailId)
+	if err != nil {
+		return err
+	}
+
+	if req.ParentType != "" && req.ParentType != parentDetail.ParentType {
+		exists, err := uc.deps.ParentDetailRepo.ExistByParentType(ctx, parent.Id, req.ParentType)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+		}
+		if exists {
+			return errors.ErrParentTypeExists
+		}
+	}
+
+	updatedParentDetail, err := uc.deps.Mapper.UpdateRequestToParentDetail(req, parentDetail)
+	if err != nil {
+		return err
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.ParentDetailRepo.Update(ctx, tx, updatedParentDetail); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Str("parentDetailId", parentDetailId).Msg("Parent detail updated")
+	return nil
+}
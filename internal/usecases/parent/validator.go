@@ -0,0 +1,38 @@
+package parent
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/validator"
+	"fmt"
+)
+
+type Validator interface {
+	ValidateCreateParentDetailRequest(req *dto.ParentDetailCreateRequest) error
+	ValidateUpdateParentDetailRequest(req *dto.ParentDetailUpdateRequest) error
+}
+
+type parentValidator struct{}
+
+func NewParentValidator() Validator {
+	return &parentValidator{}
+}
+
+func (v *parentValidator) ValidateCreateParentDetailRequest(req *dto.ParentDetailCreateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *parentValidator) ValidateUpdateParentDetailRequest(req *dto.ParentDetailUpdateRequest) error {
+	if req.ParentType == "" && req.ParentName == "" && req.ParentPhone == "" {
+		return fmt.Errorf("at least one field must be provided for update")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+type createAgeCategoryUseCase struct {
+	deps *Dependencies
+}
+
+func NewCreateAgeCategoryUseCase(deps *Dependencies) CreateAgeCategoryUseCase {
+	return &createAgeCategoryUseCase{deps: deps}
+}
+
+func (uc *createAgeCategoryUseCase) Execute(ctx context.Context, req *dto.AgeCategoryCreateRequest) error {
+	if err := uc.deps.Validator.ValidateCreateAgeCategoryRequest(req); err != nil {
+		return err
+	}
+
+	exists, err := uc.deps.AgeCategoryRepo.ExistByName(ctx, req.Name)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+	if exists {
+		return errors.ErrAgeCategoryExists
+	}
+
+	now := time.Now()
+	category := &entities.AgeCategory{
+		Name:      req.Name,
+		MinAge:    *req.MinAge,
+		MaxAge:    req.MaxAge,
+		SortOrder: req.SortOrder,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.AgeCategoryRepo.Create(ctx, tx, category); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
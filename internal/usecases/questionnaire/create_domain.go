@@ -0,0 +1,61 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+type createDomainUseCase struct {
+	deps *Dependencies
+}
+
+func NewCreateDomainUseCase(deps *Dependencies) CreateDomainUseCase {
+	return &createDomainUseCase{deps: deps}
+}
+
+func (uc *createDomainUseCase) Execute(ctx context.Context, req *dto.ObservationDomainCreateRequest) error {
+	if err := uc.deps.Validator.ValidateCreateDomainRequest(req); err != nil {
+		return err
+	}
+
+	exists, err := uc.deps.DomainRepo.ExistByCode(ctx, req.Code)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+	if exists {
+		return errors.ErrObservationDomainExists
+	}
+
+	now := time.Now()
+	domain := &entities.ObservationDomain{
+		Code:           req.Code,
+		Name:           req.Name,
+		TherapySection: req.TherapySection,
+		SortOrder:      req.SortOrder,
+		IsActive:       true,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.DomainRepo.Create(ctx, tx, domain); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type createQuestionUseCase struct {
+	deps *Dependencies
+}
+
+func NewCreateQuestionUseCase(deps *Dependencies) CreateQuestionUseCase {
+	return &createQuestionUseCase{deps: deps}
+}
+
+func (uc *createQuestionUseCase) Execute(ctx context.Context, versionId int, req *dto.QuestionnaireQuestionRequest) (*dto.QuestionnaireQuestionResponse, error) {
+	if err := uc.deps.Validator.ValidateQuestionRequest(req); err != nil {
+		return nil, err
+	}
+
+	question := uc.deps.Mapper.RequestToQuestion(versionId, req)
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if _, err := editableVersion(ctx, tx, uc.deps, versionId); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := checkQuestion(ctx, uc.deps, question); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := uc.deps.QuestionRepo.Create(ctx, tx, question); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return uc.deps.Mapper.QuestionResponse(question), nil
+}
@@ -0,0 +1,78 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+type createVersionUseCase struct {
+	deps *Dependencies
+}
+
+func NewCreateVersionUseCase(deps *Dependencies) CreateVersionUseCase {
+	return &createVersionUseCase{deps: deps}
+}
+
+// Execute opens a new draft. It starts as a copy of copy_from_version_id, or
+// of the published version when none is given.
+func (uc *createVersionUseCase) Execute(ctx context.Context, req *dto.QuestionnaireVersionCreateRequest) (*dto.QuestionnaireVersionResponse, error) {
+	if err := uc.deps.Validator.ValidateCreateVersionRequest(req); err != nil {
+		return nil, err
+	}
+
+	var source *entities.QuestionnaireVersion
+	if req.CopyFromVersionId != nil {
+		version, err := uc.deps.VersionRepo.GetById(ctx, *req.CopyFromVersionId)
+		if err != nil || version == nil {
+			return nil, errors.ErrQuestionnaireVersionNotFound
+		}
+		source = version
+	} else if version, err := uc.deps.VersionRepo.GetPublished(ctx); err == nil {
+		source = version
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	number, err := uc.deps.VersionRepo.NextVersionNumber(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	now := time.Now()
+	version := &entities.QuestionnaireVersion{
+		Version:   number,
+		Name:      req.Name,
+		Status:    string(constants.QuestionnaireStatusDraft),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := uc.deps.VersionRepo.Create(ctx, tx, version); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if source != nil {
+		if err := uc.deps.QuestionRepo.CopyToVersion(ctx, tx, source.Id, version.Id); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return uc.deps.Mapper.VersionResponse(version), nil
+}
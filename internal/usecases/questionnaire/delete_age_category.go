@@ -0,0 +1,46 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+type deleteAgeCategoryUseCase struct {
+	deps *Dependencies
+}
+
+func NewDeleteAgeCategoryUseCase(deps *Dependencies) DeleteAgeCategoryUseCase {
+	return &deleteAgeCategoryUseCase{deps: deps}
+}
+
+// Execute deactivates the category so new registrations stop landing in it;
+// existing observations keep their category.
+func (uc *deleteAgeCategoryUseCase) Execute(ctx context.Context, categoryId int) error {
+	category, err := uc.deps.AgeCategoryRepo.GetById(ctx, categoryId)
+	if err != nil || category == nil {
+		return errors.ErrAgeCategoryNotFound
+	}
+
+	category.IsActive = false
+	category.UpdatedAt = time.Now()
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.AgeCategoryRepo.Update(ctx, tx, category); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDeletionFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+type deleteDomainUseCase struct {
+	deps *Dependencies
+}
+
+func NewDeleteDomainUseCase(deps *Dependencies) DeleteDomainUseCase {
+	return &deleteDomainUseCase{deps: deps}
+}
+
+// Execute deactivates the domain. Questions and scores in earlier versions
+// still refer to it.
+func (uc *deleteDomainUseCase) Execute(ctx context.Context, code string) error {
+	domain, err := uc.deps.DomainRepo.GetByCode(ctx, code)
+	if err != nil || domain == nil {
+		return errors.ErrObservationDomainNotFound
+	}
+
+	domain.IsActive = false
+	domain.UpdatedAt = time.Now()
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.DomainRepo.Update(ctx, tx, domain); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDeletionFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type deleteQuestionUseCase struct {
+	deps *Dependencies
+}
+
+func NewDeleteQuestionUseCase(deps *Dependencies) DeleteQuestionUseCase {
+	return &deleteQuestionUseCase{deps: deps}
+}
+
+// Execute removes a question from a draft. Drafts have never been answered,
+// so nothing references it yet.
+func (uc *deleteQuestionUseCase) Execute(ctx context.Context, versionId int, questionId int) error {
+	if _, err := versionQuestion(ctx, uc.deps, versionId, questionId); err != nil {
+		return err
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if _, err := editableVersion(ctx, tx, uc.deps, versionId); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := uc.deps.QuestionRepo.Delete(ctx, tx, questionId); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDeletionFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
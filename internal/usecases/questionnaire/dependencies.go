@@ -0,0 +1,31 @@
+package questionnaire
+
+import "backend-golang/internal/domain/repositories"
+
+type Dependencies struct {
+	TxRepo          repositories.TransactionRepository
+	VersionRepo     repositories.QuestionnaireVersionRepository
+	QuestionRepo    repositories.ObservationQuestionRepository
+	DomainRepo      repositories.ObservationDomainRepository
+	AgeCategoryRepo repositories.AgeCategoryRepository
+	Validator       Validator
+	Mapper          Mapper
+}
+
+func NewDependencies(
+	txRepo repositories.TransactionRepository,
+	versionRepo repositories.QuestionnaireVersionRepository,
+	questionRepo repositories.ObservationQuestionRepository,
+	domainRepo repositories.ObservationDomainRepository,
+	ageCategoryRepo repositories.AgeCategoryRepository,
+) *Dependencies {
+	return &Dependencies{
+		TxRepo:          txRepo,
+		VersionRepo:     versionRepo,
+		QuestionRepo:    questionRepo,
+		DomainRepo:      domainRepo,
+		AgeCategoryRepo: ageCategoryRepo,
+		Validator:       NewQuestionnaireValidator(),
+		Mapper:          NewQuestionnaireMapper(),
+	}
+}
@@ -0,0 +1,30 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findAgeCategoriesUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindAgeCategoriesUseCase(deps *Dependencies) FindAgeCategoriesUseCase {
+	return &findAgeCategoriesUseCase{deps: deps}
+}
+
+func (uc *findAgeCategoriesUseCase) Execute(ctx context.Context) ([]*dto.AgeCategoryResponse, error) {
+	categories, err := uc.deps.AgeCategoryRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	response := make([]*dto.AgeCategoryResponse, 0, len(categories))
+	for _, category := range categories {
+		response = append(response, uc.deps.Mapper.AgeCategoryResponse(category))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,30 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findDomainsUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindDomainsUseCase(deps *Dependencies) FindDomainsUseCase {
+	return &findDomainsUseCase{deps: deps}
+}
+
+func (uc *findDomainsUseCase) Execute(ctx context.Context) ([]*dto.ObservationDomainResponse, error) {
+	domains, err := uc.deps.DomainRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	response := make([]*dto.ObservationDomainResponse, 0, len(domains))
+	for _, domain := range domains {
+		response = append(response, uc.deps.Mapper.DomainResponse(domain))
+	}
+
+	return response, nil
+}
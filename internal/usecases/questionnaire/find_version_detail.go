@@ -0,0 +1,38 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findVersionDetailUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindVersionDetailUseCase(deps *Dependencies) FindVersionDetailUseCase {
+	return &findVersionDetailUseCase{deps: deps}
+}
+
+func (uc *findVersionDetailUseCase) Execute(ctx context.Context, versionId int) (*dto.QuestionnaireVersionDetailResponse, error) {
+	version, err := uc.deps.VersionRepo.GetById(ctx, versionId)
+	if err != nil || version == nil {
+		return nil, errors.ErrQuestionnaireVersionNotFound
+	}
+
+	questions, err := uc.deps.QuestionRepo.GetByVersionId(ctx, versionId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	response := &dto.QuestionnaireVersionDetailResponse{
+		QuestionnaireVersionResponse: *uc.deps.Mapper.VersionResponse(version),
+		Questions:                    make([]*dto.QuestionnaireQuestionResponse, 0, len(questions)),
+	}
+	for _, question := range questions {
+		response.Questions = append(response.Questions, uc.deps.Mapper.QuestionResponse(question))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,30 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findVersionsUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindVersionsUseCase(deps *Dependencies) FindVersionsUseCase {
+	return &findVersionsUseCase{deps: deps}
+}
+
+func (uc *findVersionsUseCase) Execute(ctx context.Context) ([]*dto.QuestionnaireVersionResponse, error) {
+	versions, err := uc.deps.VersionRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	response := make([]*dto.QuestionnaireVersionResponse, 0, len(versions))
+	for _, version := range versions {
+		response = append(response, uc.deps.Mapper.VersionResponse(version))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,66 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type FindVersionsUseCase interface {
+	Execute(ctx context.Context) ([]*dto.QuestionnaireVersionResponse, error)
+}
+
+type FindVersionDetailUseCase interface {
+	Execute(ctx context.Context, versionId int) (*dto.QuestionnaireVersionDetailResponse, error)
+}
+
+type CreateVersionUseCase interface {
+	Execute(ctx context.Context, req *dto.QuestionnaireVersionCreateRequest) (*dto.QuestionnaireVersionResponse, error)
+}
+
+type PublishVersionUseCase interface {
+	Execute(ctx context.Context, versionId int) error
+}
+
+type CreateQuestionUseCase interface {
+	Execute(ctx context.Context, versionId int, req *dto.QuestionnaireQuestionRequest) (*dto.QuestionnaireQuestionResponse, error)
+}
+
+type UpdateQuestionUseCase interface {
+	Execute(ctx context.Context, versionId int, questionId int, req *dto.QuestionnaireQuestionRequest) error
+}
+
+type DeleteQuestionUseCase interface {
+	Execute(ctx context.Context, versionId int, questionId int) error
+}
+
+type FindDomainsUseCase interface {
+	Execute(ctx context.Context) ([]*dto.ObservationDomainResponse, error)
+}
+
+type CreateDomainUseCase interface {
+	Execute(ctx context.Context, req *dto.ObservationDomainCreateRequest) error
+}
+
+type UpdateDomainUseCase interface {
+	Execute(ctx context.Context, code string, req *dto.ObservationDomainUpdateRequest) error
+}
+
+type DeleteDomainUseCase interface {
+	Execute(ctx context.Context, code string) error
+}
+
+type FindAgeCategoriesUseCase interface {
+	Execute(ctx context.Context) ([]*dto.AgeCategoryResponse, error)
+}
+
+type CreateAgeCategoryUseCase interface {
+	Execute(ctx context.Context, req *dto.AgeCategoryCreateRequest) error
+}
+
+type UpdateAgeCategoryUseCase interface {
+	Execute(ctx context.Context, categoryId int, req *dto.AgeCategoryUpdateRequest) error
+}
+
+type DeleteAgeCategoryUseCase interface {
+	Execute(ctx context.Context, categoryId int) error
+}
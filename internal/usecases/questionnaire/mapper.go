@@ -0,0 +1,85 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"time"
+)
+
+type Mapper interface {
+	VersionResponse(version *entities.QuestionnaireVersion) *dto.QuestionnaireVersionResponse
+	QuestionResponse(question *entities.ObservationQuestion) *dto.QuestionnaireQuestionResponse
+	RequestToQuestion(versionId int, req *dto.QuestionnaireQuestionRequest) *entities.ObservationQuestion
+	DomainResponse(domain *entities.ObservationDomain) *dto.ObservationDomainResponse
+	AgeCategoryResponse(category *entities.AgeCategory) *dto.AgeCategoryResponse
+}
+
+type questionnaireMapper struct{}
+
+func NewQuestionnaireMapper() Mapper {
+	return &questionnaireMapper{}
+}
+
+func (m *questionnaireMapper) VersionResponse(version *entities.QuestionnaireVersion) *dto.QuestionnaireVersionResponse {
+	return &dto.QuestionnaireVersionResponse{
+		VersionId:   version.Id,
+		Version:     version.Version,
+		Name:        version.Name,
+		Status:      version.Status,
+		PublishedAt: version.PublishedAt,
+		CreatedAt:   version.CreatedAt,
+	}
+}
+
+func (m *questionnaireMapper) QuestionResponse(question *entities.ObservationQuestion) *dto.QuestionnaireQuestionResponse {
+	return &dto.QuestionnaireQuestionResponse{
+		QuestionId:     question.Id,
+		QuestionCode:   question.QuestionCode,
+		AgeCategory:    question.AgeCategory,
+		Domain:         question.Domain,
+		QuestionNumber: question.QuestionNumber,
+		QuestionText:   question.QuestionText,
+		Score:          question.Score,
+		IsActive:       question.IsActive,
+	}
+}
+
+func (m *questionnaireMapper) RequestToQuestion(versionId int, req *dto.QuestionnaireQuestionRequest) *entities.ObservationQuestion {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	return &entities.ObservationQuestion{
+		QuestionnaireVersionId: versionId,
+		QuestionCode:           req.QuestionCode,
+		AgeCategory:            req.AgeCategory,
+		Domain:                 req.Domain,
+		QuestionNumber:         req.QuestionNumber,
+		QuestionText:           req.QuestionText,
+		Score:                  req.Score,
+		IsActive:               isActive,
+		CreatedAt:              time.Now(),
+	}
+}
+
+func (m *questionnaireMapper) DomainResponse(domain *entities.ObservationDomain) *dto.ObservationDomainResponse {
+	return &dto.ObservationDomainResponse{
+		Code:           domain.Code,
+		Name:           domain.Name,
+		TherapySection: domain.TherapySection,
+		SortOrder:      domain.SortOrder,
+		IsActive:       domain.IsActive,
+	}
+}
+
+func (m *questionnaireMapper) AgeCategoryResponse(category *entities.AgeCategory) *dto.AgeCategoryResponse {
+	return &dto.AgeCategoryResponse{
+		AgeCategoryId: category.Id,
+		Name:          category.Name,
+		MinAge:        category.MinAge,
+		MaxAge:        category.MaxAge,
+		SortOrder:     category.SortOrder,
+		IsActive:      category.IsActive,
+	}
+}
@@ -0,0 +1,65 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/domain/repositories"
+	internalError "backend-golang/internal/errors"
+	"context"
+	"errors"
+	"fmt"
+)
+
+type publishVersionUseCase struct {
+	deps *Dependencies
+}
+
+func NewPublishVersionUseCase(deps *Dependencies) PublishVersionUseCase {
+	return &publishVersionUseCase{deps: deps}
+}
+
+// Execute makes the draft the version new observations start with. The
+// previous version is archived; observations already pinned to it keep it.
+func (uc *publishVersionUseCase) Execute(ctx context.Context, versionId int) error {
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if _, err := editableVersion(ctx, tx, uc.deps, versionId); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	questions, err := uc.deps.QuestionRepo.GetByVersionId(ctx, versionId)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", internalError.ErrRetrievalFailed, err)
+	}
+
+	hasActive := false
+	for _, question := range questions {
+		if question.IsActive {
+			hasActive = true
+			break
+		}
+	}
+	if !hasActive {
+		tx.Rollback()
+		return internalError.ErrQuestionnaireVersionEmpty
+	}
+
+	if err := uc.deps.VersionRepo.Publish(ctx, tx, versionId); err != nil {
+		tx.Rollback()
+		if errors.Is(err, repositories.ErrStatusChanged) {
+			return internalError.ErrQuestionnaireVersionLocked
+		}
+		return fmt.Errorf("%w: %v", internalError.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", internalError.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
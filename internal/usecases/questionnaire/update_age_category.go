@@ -0,0 +1,52 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+type updateAgeCategoryUseCase struct {
+	deps *Dependencies
+}
+
+func NewUpdateAgeCategoryUseCase(deps *Dependencies) UpdateAgeCategoryUseCase {
+	return &updateAgeCategoryUseCase{deps: deps}
+}
+
+func (uc *updateAgeCategoryUseCase) Execute(ctx context.Context, categoryId int, req *dto.AgeCategoryUpdateRequest) error {
+	if err := uc.deps.Validator.ValidateUpdateAgeCategoryRequest(req); err != nil {
+		return err
+	}
+
+	category, err := uc.deps.AgeCategoryRepo.GetById(ctx, categoryId)
+	if err != nil || category == nil {
+		return errors.ErrAgeCategoryNotFound
+	}
+
+	category.MinAge = *req.MinAge
+	category.MaxAge = req.MaxAge
+	category.SortOrder = req.SortOrder
+	category.IsActive = *req.IsActive
+	category.UpdatedAt = time.Now()
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.AgeCategoryRepo.Update(ctx, tx, category); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
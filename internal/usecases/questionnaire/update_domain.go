@@ -0,0 +1,52 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+type updateDomainUseCase struct {
+	deps *Dependencies
+}
+
+func NewUpdateDomainUseCase(deps *Dependencies) UpdateDomainUseCase {
+	return &updateDomainUseCase{deps: deps}
+}
+
+func (uc *updateDomainUseCase) Execute(ctx context.Context, code string, req *dto.ObservationDomainUpdateRequest) error {
+	if err := uc.deps.Validator.ValidateUpdateDomainRequest(req); err != nil {
+		return err
+	}
+
+	domain, err := uc.deps.DomainRepo.GetByCode(ctx, code)
+	if err != nil || domain == nil {
+		return errors.ErrObservationDomainNotFound
+	}
+
+	domain.Name = req.Name
+	domain.TherapySection = req.TherapySection
+	domain.SortOrder = req.SortOrder
+	domain.IsActive = *req.IsActive
+	domain.UpdatedAt = time.Now()
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.DomainRepo.Update(ctx, tx, domain); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
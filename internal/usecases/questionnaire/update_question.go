@@ -0,0 +1,59 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type updateQuestionUseCase struct {
+	deps *Dependencies
+}
+
+func NewUpdateQuestionUseCase(deps *Dependencies) UpdateQuestionUseCase {
+	return &updateQuestionUseCase{deps: deps}
+}
+
+func (uc *updateQuestionUseCase) Execute(ctx context.Context, versionId int, questionId int, req *dto.QuestionnaireQuestionRequest) error {
+	if err := uc.deps.Validator.ValidateQuestionRequest(req); err != nil {
+		return err
+	}
+
+	existing, err := versionQuestion(ctx, uc.deps, versionId, questionId)
+	if err != nil {
+		return err
+	}
+
+	question := uc.deps.Mapper.RequestToQuestion(versionId, req)
+	question.Id = existing.Id
+	question.CreatedAt = existing.CreatedAt
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if _, err := editableVersion(ctx, tx, uc.deps, versionId); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := checkQuestion(ctx, uc.deps, question); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := uc.deps.QuestionRepo.Update(ctx, tx, question); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
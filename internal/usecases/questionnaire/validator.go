@@ -0,0 +1,62 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/validator"
+)
+
+type Validator interface {
+	ValidateCreateVersionRequest(req *dto.QuestionnaireVersionCreateRequest) error
+	ValidateQuestionRequest(req *dto.QuestionnaireQuestionRequest) error
+	ValidateCreateDomainRequest(req *dto.ObservationDomainCreateRequest) error
+	ValidateUpdateDomainRequest(req *dto.ObservationDomainUpdateRequest) error
+	ValidateCreateAgeCategoryRequest(req *dto.AgeCategoryCreateRequest) error
+	ValidateUpdateAgeCategoryRequest(req *dto.AgeCategoryUpdateRequest) error
+}
+
+type questionnaireValidator struct{}
+
+func NewQuestionnaireValidator() Validator {
+	return &questionnaireValidator{}
+}
+
+func (v *questionnaireValidator) ValidateCreateVersionRequest(req *dto.QuestionnaireVersionCreateRequest) error {
+	return validator.ValidateStruct(req)
+}
+
+func (v *questionnaireValidator) ValidateQuestionRequest(req *dto.QuestionnaireQuestionRequest) error {
+	return validator.ValidateStruct(req)
+}
+
+func (v *questionnaireValidator) ValidateCreateDomainRequest(req *dto.ObservationDomainCreateRequest) error {
+	return validator.ValidateStruct(req)
+}
+
+func (v *questionnaireValidator) ValidateUpdateDomainRequest(req *dto.ObservationDomainUpdateRequest) error {
+	return validator.ValidateStruct(req)
+}
+
+func (v *questionnaireValidator) ValidateCreateAgeCategoryRequest(req *dto.AgeCategoryCreateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return validateAgeRange(*req.MinAge, req.MaxAge)
+}
+
+func (v *questionnaireValidator) ValidateUpdateAgeCategoryRequest(req *dto.AgeCategoryUpdateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return validateAgeRange(*req.MinAge, req.MaxAge)
+}
+
+func validateAgeRange(minAge int, maxAge *int) error {
+	if maxAge != nil && *maxAge < minAge {
+		return errors.ErrInvalidAgeRange
+	}
+
+	return nil
+}
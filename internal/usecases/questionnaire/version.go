@@ -0,0 +1,65 @@
+package questionnaire
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// editableVersion locks the version for the rest of tx and rejects edits to
+// anything that is no longer a draft.
+func editableVersion(ctx context.Context, tx *gorm.DB, deps *Dependencies, versionId int) (*entities.QuestionnaireVersion, error) {
+	version, err := deps.VersionRepo.LockById(ctx, tx, versionId)
+	if err != nil || version == nil {
+		return nil, errors.ErrQuestionnaireVersionNotFound
+	}
+
+	if !version.IsEditable() {
+		return nil, errors.ErrQuestionnaireVersionLocked
+	}
+
+	return version, nil
+}
+
+// checkQuestion makes sure the question points at known reference data and
+// does not clash with another question in its version.
+func checkQuestion(ctx context.Context, deps *Dependencies, question *entities.ObservationQuestion) error {
+	exists, err := deps.AgeCategoryRepo.ExistByName(ctx, question.AgeCategory)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+	if !exists {
+		return errors.ErrAgeCategoryNotFound
+	}
+
+	exists, err = deps.DomainRepo.ExistByCode(ctx, question.Domain)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+	if !exists {
+		return errors.ErrObservationDomainNotFound
+	}
+
+	conflict, err := deps.QuestionRepo.ExistConflicting(ctx, question)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+	if conflict {
+		return errors.ErrQuestionExists
+	}
+
+	return nil
+}
+
+// versionQuestion loads a question and checks it belongs to versionId.
+func versionQuestion(ctx context.Context, deps *Dependencies, versionId int, questionId int) (*entities.ObservationQuestion, error) {
+	question, err := deps.QuestionRepo.GetById(ctx, questionId)
+	if err != nil || question == nil || question.QuestionnaireVersionId != versionId {
+		return nil, errors.ErrQuestionNotFound
+	}
+
+	return question, nil
+}
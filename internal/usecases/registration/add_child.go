@@ -0,0 +1,75 @@
+package registration
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/constants"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+type addChildUseCase struct {
+	deps *Dependencies
+}
+
+func NewAddChildUseCase(deps *Dependencies) AddChildUseCase {
+	return &addChildUseCase{deps: deps}
+}
+
+func (uc *addChildUseCase) Execute(ctx context.Context, req *dto.AddChildRequest) error {
+	if err := uc.deps.Validator.ValidateAddChildRequest(req); err != nil {
+		return err
+	}
+
+	userId, ok := helpers.GetUserID(ctx)
+	if !ok {
+		return errors.ErrUnauthorized
+	}
+
+	parent, err := uc.deps.ParentRepo.GetByUserId(ctx, userId)
+	if err != nil {
+		log.Warn().Err(err).Str("userId", userId).Msg("Parent not found for user")
+		return errors.ErrParentNotFound
+	}
+
+	if parent.RegistrationStatus != string(constants.RegistrationStatusComplete) {
+		return errors.ErrParentNotVerified
+	}
+
+	ageCategories, err := uc.deps.AgeCategoryRepo.GetActive(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	child, observation, err := uc.deps.Mapper.AddChildRequestToChild(parent.Id, req, ageCategories)
+	if err != nil {
+		return err
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.ChildRepo.Create(ctx, tx, child); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := uc.deps.ObservationRepo.Create(ctx, tx, observation); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Str("parentId", parent.Id).Str("childId", child.Id).Msg("Additional child registered")
+	return nil
+}
@@ -8,6 +8,7 @@ type Dependencies struct {
 	ParentDetailRepo repositories.ParentDetailRepository
 	ChildRepo        repositories.ChildRepository
 	ObservationRepo  repositories.ObservationRepository
+	AgeCategoryRepo  repositories.AgeCategoryRepository
 	Validator        Validator
 	Mapper           Mapper
 }
@@ -18,6 +19,7 @@ func NewDependencies(
 	parentDetailRepo repositories.ParentDetailRepository,
 	childRepo repositories.ChildRepository,
 	observationRepo repositories.ObservationRepository,
+	ageCategoryRepo repositories.AgeCategoryRepository,
 ) *Dependencies {
 	return &Dependencies{
 		TxRepo:           txRepo,
@@ -25,6 +27,7 @@ func NewDependencies(
 		ParentDetailRepo: parentDetailRepo,
 		ChildRepo:        childRepo,
 		ObservationRepo:  observationRepo,
+		AgeCategoryRepo:  ageCategoryRepo,
 		Validator:        NewRegistrationValidator(),
 		Mapper:           NewRegistrationMapper(),
 	}
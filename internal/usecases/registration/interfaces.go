@@ -8,3 +8,7 @@ import (
 type RegistrationUseCase interface {
 	Execute(ctx context.Context, req *dto.RegistrationRequest) error
 }
+
+type AddChildUseCase interface {
+	Execute(ctx context.Context, req *dto.AddChildRequest) error
+}
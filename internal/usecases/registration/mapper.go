@@ -13,10 +13,12 @@ import (
 )
 
 type Mapper interface {
-	CreateRequestToRegistration(req *dto.RegistrationRequest) (*entities.Parent, *entities.ParentDetail, *entities.Children, *entities.Observation, error)
+	CreateRequestToRegistration(req *dto.RegistrationRequest, ageCategories []*entities.AgeCategory) (*entities.Parent, *entities.ParentDetail, *entities.Children, *entities.Observation, error)
+	AddChildRequestToChild(parentId string, req *dto.AddChildRequest, ageCategories []*entities.AgeCategory) (*entities.Children, *entities.Observation, error)
 }
 type registrationMapper struct {
 	encryptionKey string
+	blindIndexKey string
 }
 
 func NewRegistrationMapper() Mapper {
@@ -25,12 +27,18 @@ func NewRegistrationMapper() Mapper {
 		log.Fatal().Err(fmt.Errorf("missing encrypted key"))
 	}
 
+	blindIndexKey := config.GetEnv("BLIND_INDEX_KEY", "")
+	if blindIndexKey == "" {
+		log.Fatal().Err(fmt.Errorf("missing blind index key"))
+	}
+
 	return &registrationMapper{
 		encryptionKey: key,
+		blindIndexKey: blindIndexKey,
 	}
 }
 
-func (m *registrationMapper) CreateRequestToRegistration(req *dto.RegistrationRequest) (*entities.Parent, *entities.ParentDetail, *entities.Children, *entities.Observation, error) {
+func (m *registrationMapper) CreateRequestToRegistration(req *dto.RegistrationRequest, ageCategories []*entities.AgeCategory) (*entities.Parent, *entities.ParentDetail, *entities.Children, *entities.Observation, error) {
 	parentID := helpers2.GenerateULID()
 	parentDetailID := helpers2.GenerateULID()
 	childID := helpers2.GenerateULID()
@@ -53,14 +61,26 @@ func (m *registrationMapper) CreateRequestToRegistration(req *dto.RegistrationRe
 		return nil, nil, nil, nil, fmt.Errorf("failed to encrypt address: %w", err)
 	}
 
+	phoneIndex, err := helpers2.PhoneBlindIndex(req.ParentPhone, m.blindIndexKey)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to index contact: %w", err)
+	}
+
+	addressIndex, err := helpers2.AddressBlindIndex(req.ChildAddress, m.blindIndexKey)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to index address: %w", err)
+	}
+
 	parentDetail := &entities.ParentDetail{
-		Id:          parentDetailID,
-		ParentId:    parentID,
-		ParentType:  req.ParentType,
-		ParentName:  req.ParentName,
-		ParentPhone: phoneEncrypted,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		Id:               parentDetailID,
+		ParentId:         parentID,
+		ParentType:       req.ParentType,
+		ParentName:       req.ParentName,
+		ParentPhone:      phoneEncrypted,
+		ParentPhoneIndex: phoneIndex,
+		IsPrimary:        true,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
 	}
 
 	child := &entities.Children{
@@ -71,6 +91,7 @@ func (m *registrationMapper) CreateRequestToRegistration(req *dto.RegistrationRe
 		ChildBirthPlace:    req.ChildBirthPlace,
 		ChildBirthDate:     req.ChildBirthDate,
 		ChildAddress:       addressEncrypted,
+		ChildAddressIndex:  addressIndex,
 		ChildComplaint:     req.ChildComplaint,
 		ChildSchool:        req.ChildSchool,
 		ChildServiceChoice: req.ChildServiceChoice,
@@ -78,33 +99,45 @@ func (m *registrationMapper) CreateRequestToRegistration(req *dto.RegistrationRe
 		UpdatedAt:          time.Now(),
 	}
 
-	var childAge int
+	observation := m.pendingObservation(childID, req.ChildBirthDate, ageCategories)
 
-	if !req.ChildBirthDate.ToTime().IsZero() {
-		birthTime := req.ChildBirthDate.ToTime()
-		childAge = helpers2.CalculateAge(birthTime)
+	return parent, parentDetail, child, observation, nil
+}
+
+func (m *registrationMapper) AddChildRequestToChild(parentId string, req *dto.AddChildRequest, ageCategories []*entities.AgeCategory) (*entities.Children, *entities.Observation, error) {
+	childID := helpers2.GenerateULID()
+
+	addressEncrypted, err := helpers2.EncryptData([]byte(req.ChildAddress), m.encryptionKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encrypt address: %w", err)
 	}
 
-	var ageCategory string
-	switch {
-	case childAge >= 0 && childAge <= 5:
-		ageCategory = "Balita"
-	case childAge >= 6 && childAge <= 12:
-		ageCategory = "Anak-anak"
-	case childAge >= 13 && childAge <= 17:
-		ageCategory = "Remaja"
-	default:
-		ageCategory = "Lainnya"
+	addressIndex, err := helpers2.AddressBlindIndex(req.ChildAddress, m.blindIndexKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to index address: %w", err)
 	}
 
-	currentTime := time.Now()
-	scheduledDate := currentTime.Add(48 * time.Hour).Truncate(24 * time.Hour)
-	observation := &entities.Observation{
-		ChildId:       childID,
-		Status:        string(constants.ObservationStatusPending),
-		AgeCategory:   ageCategory,
-		ScheduledDate: helpers2.DateOnly(scheduledDate),
+	child := &entities.Children{
+		Id:                 childID,
+		ParentId:           parentId,
+		ChildName:          req.ChildName,
+		ChildGender:        req.ChildGender,
+		ChildBirthPlace:    req.ChildBirthPlace,
+		ChildBirthDate:     req.ChildBirthDate,
+		ChildAddress:       addressEncrypted,
+		ChildAddressIndex:  addressIndex,
+		ChildComplaint:     req.ChildComplaint,
+		ChildSchool:        req.ChildSchool,
+		ChildServiceChoice: req.ChildServiceChoice,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
 	}
 
-	return parent, parentDetail, child, observation, nil
+	observation := m.pendingObservation(childID, req.ChildBirthDate, ageCategories)
+
+	return child, observation, nil
+}
+
+func (m *registrationMapper) pendingObservation(childID string, birthDate helpers2.DateOnly, ageCategories []*entities.AgeCategory) *entities.Observation {
+	return entities.NewPendingObservation(childID, birthDate, ageCategories, time.Now())
 }
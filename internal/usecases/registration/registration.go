@@ -20,6 +20,11 @@ func (uc *registrationUseCase) Execute(ctx context.Context, req *dto.Registratio
 		return err
 	}
 
+	ageCategories, err := uc.deps.AgeCategoryRepo.GetActive(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
 	tx := uc.deps.TxRepo.Begin(ctx)
 	defer func() {
 		if r := recover(); r != nil {
@@ -37,7 +42,7 @@ func (uc *registrationUseCase) Execute(ctx context.Context, req *dto.Registratio
 		return errors.ErrEmailExists
 	}
 
-	parent, parentDetail, child, observation, err := uc.deps.Mapper.CreateRequestToRegistration(req)
+	parent, parentDetail, child, observation, err := uc.deps.Mapper.CreateRequestToRegistration(req, ageCategories)
 	if err != nil {
 		tx.Rollback()
 		return err
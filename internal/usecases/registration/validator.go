@@ -7,6 +7,7 @@ import (
 
 type Validator interface {
 	ValidateRegisterRequest(req *dto.RegistrationRequest) error
+	ValidateAddChildRequest(req *dto.AddChildRequest) error
 }
 
 type registrationValidator struct{}
@@ -22,3 +23,11 @@ func (v *registrationValidator) ValidateRegisterRequest(req *dto.RegistrationReq
 
 	return nil
 }
+
+func (v *registrationValidator) ValidateAddChildRequest(req *dto.AddChildRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return nil
+}
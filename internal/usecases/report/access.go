@@ -0,0 +1,57 @@
+package report
+
+import (
+	"backend-golang/internal/constants"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/helpers"
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
+
+// reportableObservation lets admins read any report and parents only those of
+// their own children; other families' observations are reported as missing.
+func reportableObservation(ctx context.Context, deps *Dependencies, observationId int) (*entities.Observation, error) {
+	if observationId == 0 {
+		return nil, errors.ErrObservationNotFound
+	}
+
+	role, ok := helpers.GetUserRole(ctx)
+	if !ok {
+		return nil, errors.ErrUnauthorized
+	}
+
+	if role != string(constants.RoleAdmin) && role != string(constants.RoleUser) {
+		return nil, errors.ErrForbidden
+	}
+
+	observation, err := deps.ObservationRepo.GetById(ctx, observationId)
+	if err != nil || observation == nil {
+		return nil, errors.ErrObservationNotFound
+	}
+
+	if role == string(constants.RoleUser) {
+		userId, ok := helpers.GetUserID(ctx)
+		if !ok {
+			return nil, errors.ErrUnauthorized
+		}
+
+		parent, err := deps.ParentRepo.GetByUserId(ctx, userId)
+		if err != nil {
+			log.Warn().Err(err).Str("userId", userId).Msg("Parent not found for user")
+			return nil, errors.ErrParentNotFound
+		}
+
+		if observation.Children == nil || observation.Children.ParentId != parent.Id {
+			log.Warn().Str("parentId", parent.Id).Int("observationId", observationId).Msg("Parent tried to access a report they do not own")
+			return nil, errors.ErrObservationNotFound
+		}
+	}
+
+	if observation.Status != string(constants.ObservationStatusCompleted) {
+		return nil, errors.ErrReportNotAvailable
+	}
+
+	return observation, nil
+}
@@ -0,0 +1,44 @@
+package report
+
+import (
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/domain/services"
+)
+
+type Dependencies struct {
+	TxRepo                repositories.TransactionRepository
+	ObservationRepo       repositories.ObservationRepository
+	ParentRepo            repositories.ParentRepository
+	ObservationAnswerRepo repositories.ObservationAnswerRepository
+	QuestionRepo          repositories.ObservationQuestionRepository
+	DomainRepo            repositories.ObservationDomainRepository
+	DomainScoreRepo       repositories.ObservationDomainScoreRepository
+	ReportRepo            repositories.ObservationReportRepository
+	ReportService         services.ReportService
+	Mapper                Mapper
+}
+
+func NewDependencies(
+	txRepo repositories.TransactionRepository,
+	observationRepo repositories.ObservationRepository,
+	parentRepo repositories.ParentRepository,
+	observationAnswerRepo repositories.ObservationAnswerRepository,
+	questionRepo repositories.ObservationQuestionRepository,
+	domainRepo repositories.ObservationDomainRepository,
+	domainScoreRepo repositories.ObservationDomainScoreRepository,
+	reportRepo repositories.ObservationReportRepository,
+	reportService services.ReportService,
+) *Dependencies {
+	return &Dependencies{
+		TxRepo:                txRepo,
+		ObservationRepo:       observationRepo,
+		ParentRepo:            parentRepo,
+		ObservationAnswerRepo: observationAnswerRepo,
+		QuestionRepo:          questionRepo,
+		DomainRepo:            domainRepo,
+		DomainScoreRepo:       domainScoreRepo,
+		ReportRepo:            reportRepo,
+		ReportService:         reportService,
+		Mapper:                NewReportMapper(),
+	}
+}
@@ -0,0 +1,110 @@
+package report
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type downloadObservationReportUseCase struct {
+	deps *Dependencies
+}
+
+func NewDownloadObservationReportUseCase(deps *Dependencies) DownloadObservationReportUseCase {
+	return &downloadObservationReportUseCase{deps: deps}
+}
+
+// Execute serves the stored report and only renders a new one when anything
+// it prints changed since, e.g. an amendment or new guardian details. The
+// data is gathered on every download; rendering is the expensive part. The
+// stored PDF prints decrypted guardian details, so it is kept encrypted.
+func (uc *downloadObservationReportUseCase) Execute(ctx context.Context, observationId int) (*dto.ReportFileResponse, error) {
+	observation, err := reportableObservation(ctx, uc.deps, observationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if observation.QuestionnaireVersionId == nil {
+		return nil, errors.ErrQuestionnaireVersionNotFound
+	}
+
+	answers, err := uc.deps.ObservationAnswerRepo.GetByObservationId(ctx, observationId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	questions, err := uc.deps.QuestionRepo.GetByVersionId(ctx, *observation.QuestionnaireVersionId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	domains, err := uc.deps.DomainRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	scores, err := uc.deps.DomainScoreRepo.GetByObservationId(ctx, observationId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	data := uc.deps.Mapper.ReportData(observation, answers, questions, domains, scores)
+	sourceChecksum := data.Checksum()
+
+	stored, err := uc.deps.ReportRepo.GetByObservationId(ctx, observationId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	if stored.IsCurrent(sourceChecksum) {
+		content, err := uc.deps.Mapper.DecryptReport(stored)
+		if err == nil {
+			return uc.deps.Mapper.ReportFileResponse(stored, content), nil
+		}
+		log.Warn().Err(err).Int("observationId", observationId).Msg("Failed to decrypt stored report, rendering it again")
+	}
+
+	content := uc.deps.ReportService.RenderObservationReport(data)
+	checksum := sha256.Sum256(content)
+
+	encrypted, err := uc.deps.Mapper.EncryptReport(content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	report := &entities.ObservationReport{
+		ObservationId:   observationId,
+		FileName:        fmt.Sprintf("laporan-observasi-%d.pdf", observationId),
+		Content:         encrypted,
+		Checksum:        hex.EncodeToString(checksum[:]),
+		SourceUpdatedAt: observation.UpdatedAt,
+		SourceChecksum:  sourceChecksum,
+		GeneratedAt:     time.Now(),
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.ReportRepo.Upsert(ctx, tx, report); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	log.Info().Int("observationId", observationId).Int("size", len(content)).Msg("Observation report generated")
+	return uc.deps.Mapper.ReportFileResponse(report, content), nil
+}
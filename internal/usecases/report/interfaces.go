@@ -0,0 +1,10 @@
+package report
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type DownloadObservationReportUseCase interface {
+	Execute(ctx context.Context, observationId int) (*dto.ReportFileResponse, error)
+}
@@ -0,0 +1,123 @@
+package report
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/helpers"
+	"backend-golang/internal/infrastructure/config"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+type Mapper interface {
+	ReportData(
+		observation *entities.Observation,
+		answers []*entities.ObservationAnswer,
+		questions []*entities.ObservationQuestion,
+		domains []*entities.ObservationDomain,
+		scores []entities.ObservationDomainScore,
+	) *entities.ObservationReportData
+	EncryptReport(content []byte) ([]byte, error)
+	DecryptReport(report *entities.ObservationReport) ([]byte, error)
+	ReportFileResponse(report *entities.ObservationReport, content []byte) *dto.ReportFileResponse
+}
+
+type reportMapper struct {
+	encryptionKey string
+}
+
+func NewReportMapper() Mapper {
+	key := config.GetEnv("ENCRYPTION_KEY", "")
+	if key == "" {
+		log.Fatal().Err(fmt.Errorf("missing encrypted key"))
+	}
+
+	return &reportMapper{
+		encryptionKey: key,
+	}
+}
+
+func (m *reportMapper) ReportData(
+	observation *entities.Observation,
+	answers []*entities.ObservationAnswer,
+	questions []*entities.ObservationQuestion,
+	domains []*entities.ObservationDomain,
+	scores []entities.ObservationDomainScore,
+) *entities.ObservationReportData {
+	data := &entities.ObservationReportData{
+		ObservationId:   observation.Id,
+		AgeCategory:     observation.AgeCategory,
+		ObservationDate: observation.ScheduledDate.ToTime(),
+		TotalScore:      observation.TotalScore,
+		RiskLevel:       observation.RiskLevel,
+		TherapySection:  observation.TherapySection,
+		Conclusion:      observation.Conclusion,
+		Recommendation:  observation.Recommendation,
+		GeneratedAt:     time.Now(),
+	}
+
+	if observation.Therapist != nil {
+		data.TherapistName = observation.Therapist.TherapistName
+	}
+
+	if child := observation.Children; child != nil {
+		data.ChildName = child.ChildName
+		data.ChildGender = child.ChildGender
+		data.ChildBirthPlace = child.ChildBirthPlace
+		data.ChildBirthDate = child.ChildBirthDate.ToTime()
+		if !data.ChildBirthDate.IsZero() {
+			data.ChildAge = helpers.CalculateAge(data.ChildBirthDate)
+		}
+		if child.ChildSchool != nil {
+			data.ChildSchool = *child.ChildSchool
+		}
+		data.ChildAddress = m.decrypt(child.ChildAddress, "child address")
+
+		if child.Parent != nil {
+			if contact := child.Parent.PrimaryContact(); contact != nil {
+				data.ParentName = contact.ParentName
+				data.ParentPhone = m.decrypt(contact.ParentPhone, "parent phone")
+			}
+		}
+	}
+
+	data.Domains = entities.BuildAnswerSheet(answers, questions, domains, scores)
+
+	return data
+}
+
+// EncryptReport protects a rendered report at rest like the fields it prints.
+func (m *reportMapper) EncryptReport(content []byte) ([]byte, error) {
+	return helpers.EncryptData(content, m.encryptionKey)
+}
+
+func (m *reportMapper) DecryptReport(report *entities.ObservationReport) ([]byte, error) {
+	return helpers.DecryptData(report.Content, m.encryptionKey)
+}
+
+// ReportFileResponse takes the decrypted content; the report holds it
+// encrypted.
+func (m *reportMapper) ReportFileResponse(report *entities.ObservationReport, content []byte) *dto.ReportFileResponse {
+	return &dto.ReportFileResponse{
+		FileName:    report.FileName,
+		ContentType: "application/pdf",
+		Content:     content,
+		Checksum:    report.Checksum,
+	}
+}
+
+func (m *reportMapper) decrypt(data []byte, field string) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	decrypted, err := helpers.DecryptData(data, m.encryptionKey)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Failed to decrypt %s", field)
+		return "[Encrypted]"
+	}
+
+	return string(decrypted)
+}
@@ -0,0 +1,46 @@
+package schedule
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type createTimeOffUseCase struct {
+	deps *Dependencies
+}
+
+func NewCreateTimeOffUseCase(deps *Dependencies) CreateTimeOffUseCase {
+	return &createTimeOffUseCase{deps: deps}
+}
+
+func (uc *createTimeOffUseCase) Execute(ctx context.Context, therapistId string, req *dto.TimeOffCreateRequest) error {
+	if err := uc.deps.Validator.ValidateTimeOffRequest(req); err != nil {
+		return err
+	}
+
+	if _, err := activeTherapist(ctx, uc.deps, therapistId); err != nil {
+		return err
+	}
+
+	timeOff := uc.deps.Mapper.RequestToTimeOff(therapistId, req)
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.TimeOffRepo.Create(ctx, tx, timeOff); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrCreationFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package schedule
+
+import (
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type deleteTimeOffUseCase struct {
+	deps *Dependencies
+}
+
+func NewDeleteTimeOffUseCase(deps *Dependencies) DeleteTimeOffUseCase {
+	return &deleteTimeOffUseCase{deps: deps}
+}
+
+func (uc *deleteTimeOffUseCase) Execute(ctx context.Context, therapistId string, timeOffId string) error {
+	timeOff, err := uc.deps.TimeOffRepo.GetById(ctx, timeOffId)
+	if err != nil || timeOff.TherapistId != therapistId {
+		return errors.ErrTimeOffNotFound
+	}
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.TimeOffRepo.Delete(ctx, tx, timeOffId); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDeletionFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
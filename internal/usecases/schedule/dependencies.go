@@ -0,0 +1,31 @@
+package schedule
+
+import "backend-golang/internal/domain/repositories"
+
+type Dependencies struct {
+	TxRepo          repositories.TransactionRepository
+	TherapistRepo   repositories.TherapistRepository
+	WorkingHourRepo repositories.TherapistWorkingHourRepository
+	TimeOffRepo     repositories.TherapistTimeOffRepository
+	ObservationRepo repositories.ObservationRepository
+	Validator       Validator
+	Mapper          Mapper
+}
+
+func NewDependencies(
+	txRepo repositories.TransactionRepository,
+	therapistRepo repositories.TherapistRepository,
+	workingHourRepo repositories.TherapistWorkingHourRepository,
+	timeOffRepo repositories.TherapistTimeOffRepository,
+	observationRepo repositories.ObservationRepository,
+) *Dependencies {
+	return &Dependencies{
+		TxRepo:          txRepo,
+		TherapistRepo:   therapistRepo,
+		WorkingHourRepo: workingHourRepo,
+		TimeOffRepo:     timeOffRepo,
+		ObservationRepo: observationRepo,
+		Validator:       NewScheduleValidator(),
+		Mapper:          NewScheduleMapper(),
+	}
+}
@@ -0,0 +1,151 @@
+package schedule
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	defaultSlotDays  = 14
+	defaultSlotLimit = 10
+)
+
+type findAvailableSlotsUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindAvailableSlotsUseCase(deps *Dependencies) FindAvailableSlotsUseCase {
+	return &findAvailableSlotsUseCase{deps: deps}
+}
+
+type candidateSlot struct {
+	therapist *entities.Therapist
+	period    entities.TimeRange
+}
+
+func (uc *findAvailableSlotsUseCase) Execute(ctx context.Context, req *dto.AvailableSlotRequest) ([]*dto.AvailableSlotResponse, error) {
+	if err := uc.deps.Validator.ValidateAvailableSlotRequest(req); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	firstDay := startOfDay(now)
+	if req.DateFrom != "" {
+		dateFrom, err := time.ParseInLocation("2006-01-02", req.DateFrom, time.Local)
+		if err != nil {
+			return nil, errors.ErrInvalidListQuery
+		}
+		if dateFrom.After(firstDay) {
+			firstDay = dateFrom
+		}
+	}
+
+	days := req.Days
+	if days == 0 {
+		days = defaultSlotDays
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultSlotLimit
+	}
+
+	therapists, err := uc.deps.TherapistRepo.GetActiveBySection(ctx, req.TherapistSection)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	response := make([]*dto.AvailableSlotResponse, 0, limit)
+	if len(therapists) == 0 {
+		return response, nil
+	}
+
+	therapistIds := make([]string, 0, len(therapists))
+	for _, therapist := range therapists {
+		therapistIds = append(therapistIds, therapist.Id)
+	}
+
+	lastDay := firstDay.AddDate(0, 0, days)
+
+	workingHours, err := uc.deps.WorkingHourRepo.GetByTherapistIds(ctx, therapistIds)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	timeOffs, err := uc.deps.TimeOffRepo.GetByTherapistIds(ctx, therapistIds, firstDay, lastDay)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	booked, err := uc.deps.ObservationRepo.GetBookedByTherapistIds(ctx, therapistIds, firstDay, lastDay)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	workingHoursByTherapist := make(map[string][]*entities.TherapistWorkingHour)
+	for _, workingHour := range workingHours {
+		if req.AgeCategory != "" && !workingHour.AcceptsAgeCategory(req.AgeCategory) {
+			continue
+		}
+		workingHoursByTherapist[workingHour.TherapistId] = append(workingHoursByTherapist[workingHour.TherapistId], workingHour)
+	}
+
+	busyByTherapist := make(map[string][]entities.TimeRange)
+	for _, timeOff := range timeOffs {
+		busyByTherapist[timeOff.TherapistId] = append(busyByTherapist[timeOff.TherapistId], entities.TimeRange{Start: timeOff.StartAt, End: timeOff.EndAt})
+	}
+	for _, observation := range booked {
+		if observation.ScheduledStart == nil || observation.ScheduledEnd == nil {
+			continue
+		}
+		busyByTherapist[observation.TherapistId] = append(busyByTherapist[observation.TherapistId], entities.TimeRange{Start: *observation.ScheduledStart, End: *observation.ScheduledEnd})
+	}
+
+	for day := firstDay; day.Before(lastDay); day = day.AddDate(0, 0, 1) {
+		var candidates []candidateSlot
+
+		for _, therapist := range therapists {
+			for _, workingHour := range workingHoursByTherapist[therapist.Id] {
+				for _, slot := range workingHour.Slots(day) {
+					if slot.Start.Before(now) || overlapsAny(slot, busyByTherapist[therapist.Id]) {
+						continue
+					}
+					candidates = append(candidates, candidateSlot{therapist: therapist, period: slot})
+				}
+			}
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].period.Start.Before(candidates[j].period.Start)
+		})
+
+		for _, candidate := range candidates {
+			response = append(response, uc.deps.Mapper.SlotResponse(candidate.therapist, candidate.period))
+			if len(response) == limit {
+				return response, nil
+			}
+		}
+	}
+
+	return response, nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+func overlapsAny(slot entities.TimeRange, busy []entities.TimeRange) bool {
+	for _, period := range busy {
+		if slot.Overlaps(period) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,38 @@
+package schedule
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+	"time"
+)
+
+const timeOffLookahead = 365 * 24 * time.Hour
+
+type findTimeOffsUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindTimeOffsUseCase(deps *Dependencies) FindTimeOffsUseCase {
+	return &findTimeOffsUseCase{deps: deps}
+}
+
+func (uc *findTimeOffsUseCase) Execute(ctx context.Context, therapistId string) ([]*dto.TimeOffResponse, error) {
+	if _, err := activeTherapist(ctx, uc.deps, therapistId); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	timeOffs, err := uc.deps.TimeOffRepo.GetByTherapistIds(ctx, []string{therapistId}, now, now.Add(timeOffLookahead))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	response := make([]*dto.TimeOffResponse, 0, len(timeOffs))
+	for _, timeOff := range timeOffs {
+		response = append(response, uc.deps.Mapper.TimeOffResponse(timeOff))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,34 @@
+package schedule
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findWorkingHoursUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindWorkingHoursUseCase(deps *Dependencies) FindWorkingHoursUseCase {
+	return &findWorkingHoursUseCase{deps: deps}
+}
+
+func (uc *findWorkingHoursUseCase) Execute(ctx context.Context, therapistId string) ([]*dto.WorkingHourResponse, error) {
+	if _, err := activeTherapist(ctx, uc.deps, therapistId); err != nil {
+		return nil, err
+	}
+
+	workingHours, err := uc.deps.WorkingHourRepo.GetByTherapistId(ctx, therapistId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	response := make([]*dto.WorkingHourResponse, 0, len(workingHours))
+	for _, workingHour := range workingHours {
+		response = append(response, uc.deps.Mapper.WorkingHourResponse(workingHour))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,30 @@
+package schedule
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type FindWorkingHoursUseCase interface {
+	Execute(ctx context.Context, therapistId string) ([]*dto.WorkingHourResponse, error)
+}
+
+type UpdateWorkingHoursUseCase interface {
+	Execute(ctx context.Context, therapistId string, req *dto.WorkingHoursUpdateRequest) error
+}
+
+type FindTimeOffsUseCase interface {
+	Execute(ctx context.Context, therapistId string) ([]*dto.TimeOffResponse, error)
+}
+
+type CreateTimeOffUseCase interface {
+	Execute(ctx context.Context, therapistId string, req *dto.TimeOffCreateRequest) error
+}
+
+type DeleteTimeOffUseCase interface {
+	Execute(ctx context.Context, therapistId string, timeOffId string) error
+}
+
+type FindAvailableSlotsUseCase interface {
+	Execute(ctx context.Context, req *dto.AvailableSlotRequest) ([]*dto.AvailableSlotResponse, error)
+}
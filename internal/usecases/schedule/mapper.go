@@ -0,0 +1,90 @@
+package schedule
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/helpers"
+	"time"
+)
+
+type Mapper interface {
+	RequestToWorkingHours(therapistId string, req *dto.WorkingHoursUpdateRequest) []*entities.TherapistWorkingHour
+	WorkingHourResponse(workingHour *entities.TherapistWorkingHour) *dto.WorkingHourResponse
+	RequestToTimeOff(therapistId string, req *dto.TimeOffCreateRequest) *entities.TherapistTimeOff
+	TimeOffResponse(timeOff *entities.TherapistTimeOff) *dto.TimeOffResponse
+	SlotResponse(therapist *entities.Therapist, slot entities.TimeRange) *dto.AvailableSlotResponse
+}
+
+type scheduleMapper struct{}
+
+func NewScheduleMapper() Mapper {
+	return &scheduleMapper{}
+}
+
+func (m *scheduleMapper) RequestToWorkingHours(therapistId string, req *dto.WorkingHoursUpdateRequest) []*entities.TherapistWorkingHour {
+	now := time.Now()
+
+	workingHours := make([]*entities.TherapistWorkingHour, 0, len(req.WorkingHours))
+	for _, input := range req.WorkingHours {
+		workingHours = append(workingHours, &entities.TherapistWorkingHour{
+			Id:          helpers.GenerateULID(),
+			TherapistId: therapistId,
+			DayOfWeek:   *input.DayOfWeek,
+			StartTime:   input.StartTime,
+			EndTime:     input.EndTime,
+			SlotMinutes: input.SlotMinutes,
+			AgeCategory: input.AgeCategory,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+	}
+
+	return workingHours
+}
+
+func (m *scheduleMapper) WorkingHourResponse(workingHour *entities.TherapistWorkingHour) *dto.WorkingHourResponse {
+	return &dto.WorkingHourResponse{
+		WorkingHourId: workingHour.Id,
+		DayOfWeek:     workingHour.DayOfWeek,
+		StartTime:     workingHour.StartTime,
+		EndTime:       workingHour.EndTime,
+		SlotMinutes:   workingHour.SlotMinutes,
+		AgeCategory:   workingHour.AgeCategory,
+	}
+}
+
+func (m *scheduleMapper) RequestToTimeOff(therapistId string, req *dto.TimeOffCreateRequest) *entities.TherapistTimeOff {
+	now := time.Now()
+
+	return &entities.TherapistTimeOff{
+		Id:          helpers.GenerateULID(),
+		TherapistId: therapistId,
+		StartAt:     req.StartAt.In(time.Local),
+		EndAt:       req.EndAt.In(time.Local),
+		Reason:      req.Reason,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func (m *scheduleMapper) TimeOffResponse(timeOff *entities.TherapistTimeOff) *dto.TimeOffResponse {
+	return &dto.TimeOffResponse{
+		TimeOffId:   timeOff.Id,
+		TherapistId: timeOff.TherapistId,
+		StartAt:     timeOff.StartAt,
+		EndAt:       timeOff.EndAt,
+		Reason:      timeOff.Reason,
+	}
+}
+
+func (m *scheduleMapper) SlotResponse(therapist *entities.Therapist, slot entities.TimeRange) *dto.AvailableSlotResponse {
+	return &dto.AvailableSlotResponse{
+		TherapistId:      therapist.Id,
+		TherapistName:    therapist.TherapistName,
+		TherapistSection: therapist.TherapistSection,
+		Date:             helpers.DateOnly(slot.Start),
+		StartTime:        slot.Start.Format(entities.ClockLayout),
+		StartAt:          slot.Start,
+		EndAt:            slot.End,
+	}
+}
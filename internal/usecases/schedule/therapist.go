@@ -0,0 +1,16 @@
+package schedule
+
+import (
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+)
+
+func activeTherapist(ctx context.Context, deps *Dependencies, therapistId string) (*entities.Therapist, error) {
+	therapist, err := deps.TherapistRepo.GetById(ctx, therapistId)
+	if err != nil || therapist.User == nil || !therapist.User.IsActive {
+		return nil, errors.ErrTherapistNotFound
+	}
+
+	return therapist, nil
+}
@@ -0,0 +1,51 @@
+package schedule
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type updateWorkingHoursUseCase struct {
+	deps *Dependencies
+}
+
+func NewUpdateWorkingHoursUseCase(deps *Dependencies) UpdateWorkingHoursUseCase {
+	return &updateWorkingHoursUseCase{deps: deps}
+}
+
+func (uc *updateWorkingHoursUseCase) Execute(ctx context.Context, therapistId string, req *dto.WorkingHoursUpdateRequest) error {
+	if err := uc.deps.Validator.ValidateWorkingHoursRequest(req); err != nil {
+		return err
+	}
+
+	if _, err := activeTherapist(ctx, uc.deps, therapistId); err != nil {
+		return err
+	}
+
+	workingHours := uc.deps.Mapper.RequestToWorkingHours(therapistId, req)
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.TherapistRepo.LockById(ctx, tx, therapistId); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	if err := uc.deps.WorkingHourRepo.ReplaceByTherapistId(ctx, tx, therapistId, workingHours); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
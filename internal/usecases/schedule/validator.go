@@ -0,0 +1,63 @@
+package schedule
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/validator"
+	"time"
+)
+
+type Validator interface {
+	ValidateWorkingHoursRequest(req *dto.WorkingHoursUpdateRequest) error
+	ValidateTimeOffRequest(req *dto.TimeOffCreateRequest) error
+	ValidateAvailableSlotRequest(req *dto.AvailableSlotRequest) error
+}
+
+type scheduleValidator struct{}
+
+func NewScheduleValidator() Validator {
+	return &scheduleValidator{}
+}
+
+func (v *scheduleValidator) ValidateWorkingHoursRequest(req *dto.WorkingHoursUpdateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	periods := make(map[int][]entities.TimeRange)
+	for _, input := range req.WorkingHours {
+		start, _ := time.Parse(entities.ClockLayout, input.StartTime)
+		end, _ := time.Parse(entities.ClockLayout, input.EndTime)
+
+		if end.Sub(start) < time.Duration(input.SlotMinutes)*time.Minute {
+			return errors.ErrInvalidWorkingHours
+		}
+
+		period := entities.TimeRange{Start: start, End: end}
+		for _, other := range periods[*input.DayOfWeek] {
+			if period.Overlaps(other) {
+				return errors.ErrInvalidWorkingHours
+			}
+		}
+		periods[*input.DayOfWeek] = append(periods[*input.DayOfWeek], period)
+	}
+
+	return nil
+}
+
+func (v *scheduleValidator) ValidateTimeOffRequest(req *dto.TimeOffCreateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	if !req.EndAt.After(req.StartAt) {
+		return errors.ErrInvalidTimeOff
+	}
+
+	return nil
+}
+
+func (v *scheduleValidator) ValidateAvailableSlotRequest(req *dto.AvailableSlotRequest) error {
+	return validator.ValidateStruct(req)
+}
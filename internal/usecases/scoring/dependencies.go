@@ -0,0 +1,28 @@
+package scoring
+
+import "backend-golang/internal/domain/repositories"
+
+type Dependencies struct {
+	TxRepo          repositories.TransactionRepository
+	ThresholdRepo   repositories.ScoringThresholdRepository
+	DomainRepo      repositories.ObservationDomainRepository
+	AgeCategoryRepo repositories.AgeCategoryRepository
+	Validator       Validator
+	Mapper          Mapper
+}
+
+func NewDependencies(
+	txRepo repositories.TransactionRepository,
+	thresholdRepo repositories.ScoringThresholdRepository,
+	domainRepo repositories.ObservationDomainRepository,
+	ageCategoryRepo repositories.AgeCategoryRepository,
+) *Dependencies {
+	return &Dependencies{
+		TxRepo:          txRepo,
+		ThresholdRepo:   thresholdRepo,
+		DomainRepo:      domainRepo,
+		AgeCategoryRepo: ageCategoryRepo,
+		Validator:       NewScoringValidator(),
+		Mapper:          NewScoringMapper(),
+	}
+}
@@ -0,0 +1,41 @@
+package scoring
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type findThresholdsUseCase struct {
+	deps *Dependencies
+}
+
+func NewFindThresholdsUseCase(deps *Dependencies) FindThresholdsUseCase {
+	return &findThresholdsUseCase{deps: deps}
+}
+
+func (uc *findThresholdsUseCase) Execute(ctx context.Context, req *dto.ScoringThresholdQuery) ([]*dto.ScoringThresholdResponse, error) {
+	if err := uc.deps.Validator.ValidateThresholdQuery(req); err != nil {
+		return nil, err
+	}
+
+	var thresholds []*entities.ScoringThreshold
+	var err error
+	if req.AgeCategory != "" {
+		thresholds, err = uc.deps.ThresholdRepo.GetByAgeCategory(ctx, req.AgeCategory)
+	} else {
+		thresholds, err = uc.deps.ThresholdRepo.GetAll(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	response := make([]*dto.ScoringThresholdResponse, 0, len(thresholds))
+	for _, threshold := range thresholds {
+		response = append(response, uc.deps.Mapper.ThresholdResponse(threshold))
+	}
+
+	return response, nil
+}
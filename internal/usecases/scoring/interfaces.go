@@ -0,0 +1,14 @@
+package scoring
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type FindThresholdsUseCase interface {
+	Execute(ctx context.Context, req *dto.ScoringThresholdQuery) ([]*dto.ScoringThresholdResponse, error)
+}
+
+type UpdateThresholdsUseCase interface {
+	Execute(ctx context.Context, req *dto.ScoringThresholdsUpdateRequest) error
+}
@@ -0,0 +1,46 @@
+package scoring
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"time"
+)
+
+type Mapper interface {
+	ThresholdResponse(threshold *entities.ScoringThreshold) *dto.ScoringThresholdResponse
+	RequestToThresholds(req *dto.ScoringThresholdsUpdateRequest) []*entities.ScoringThreshold
+}
+
+type scoringMapper struct{}
+
+func NewScoringMapper() Mapper {
+	return &scoringMapper{}
+}
+
+func (m *scoringMapper) ThresholdResponse(threshold *entities.ScoringThreshold) *dto.ScoringThresholdResponse {
+	return &dto.ScoringThresholdResponse{
+		AgeCategory:   threshold.AgeCategory,
+		Domain:        threshold.Domain,
+		ModerateScore: threshold.ModerateScore,
+		HighScore:     threshold.HighScore,
+		UpdatedAt:     threshold.UpdatedAt,
+	}
+}
+
+func (m *scoringMapper) RequestToThresholds(req *dto.ScoringThresholdsUpdateRequest) []*entities.ScoringThreshold {
+	now := time.Now()
+
+	thresholds := make([]*entities.ScoringThreshold, 0, len(req.Thresholds))
+	for _, input := range req.Thresholds {
+		thresholds = append(thresholds, &entities.ScoringThreshold{
+			AgeCategory:   input.AgeCategory,
+			Domain:        input.Domain,
+			ModerateScore: input.ModerateScore,
+			HighScore:     input.HighScore,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	}
+
+	return thresholds
+}
@@ -0,0 +1,60 @@
+package scoring
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type updateThresholdsUseCase struct {
+	deps *Dependencies
+}
+
+func NewUpdateThresholdsUseCase(deps *Dependencies) UpdateThresholdsUseCase {
+	return &updateThresholdsUseCase{deps: deps}
+}
+
+func (uc *updateThresholdsUseCase) Execute(ctx context.Context, req *dto.ScoringThresholdsUpdateRequest) error {
+	if err := uc.deps.Validator.ValidateThresholdsRequest(req); err != nil {
+		return err
+	}
+
+	for _, input := range req.Thresholds {
+		exists, err := uc.deps.AgeCategoryRepo.ExistByName(ctx, input.AgeCategory)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+		}
+		if !exists {
+			return errors.ErrAgeCategoryNotFound
+		}
+
+		exists, err = uc.deps.DomainRepo.ExistByCode(ctx, input.Domain)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseConnection, err)
+		}
+		if !exists {
+			return errors.ErrObservationDomainNotFound
+		}
+	}
+
+	thresholds := uc.deps.Mapper.RequestToThresholds(req)
+
+	tx := uc.deps.TxRepo.Begin(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := uc.deps.ThresholdRepo.Upsert(ctx, tx, thresholds); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: %v", errors.ErrUpdateFailed, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
+	}
+
+	return nil
+}
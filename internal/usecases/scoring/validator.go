@@ -0,0 +1,36 @@
+package scoring
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"backend-golang/internal/validator"
+)
+
+type Validator interface {
+	ValidateThresholdQuery(req *dto.ScoringThresholdQuery) error
+	ValidateThresholdsRequest(req *dto.ScoringThresholdsUpdateRequest) error
+}
+
+type scoringValidator struct{}
+
+func NewScoringValidator() Validator {
+	return &scoringValidator{}
+}
+
+func (v *scoringValidator) ValidateThresholdQuery(req *dto.ScoringThresholdQuery) error {
+	return validator.ValidateStruct(req)
+}
+
+func (v *scoringValidator) ValidateThresholdsRequest(req *dto.ScoringThresholdsUpdateRequest) error {
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	for _, input := range req.Thresholds {
+		if input.HighScore < input.ModerateScore {
+			return errors.ErrInvalidScoringThreshold
+		}
+	}
+
+	return nil
+}
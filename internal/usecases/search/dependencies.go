@@ -0,0 +1,19 @@
+package search
+
+import "backend-golang/internal/domain/repositories"
+
+type Dependencies struct {
+	SearchRepo repositories.SearchRepository
+	Validator  Validator
+	Mapper     Mapper
+}
+
+func NewDependencies(
+	searchRepo repositories.SearchRepository,
+) *Dependencies {
+	return &Dependencies{
+		SearchRepo: searchRepo,
+		Validator:  NewSearchValidator(),
+		Mapper:     NewSearchMapper(),
+	}
+}
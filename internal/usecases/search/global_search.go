@@ -0,0 +1,63 @@
+package search
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/errors"
+	"context"
+	"fmt"
+)
+
+type globalSearchUseCase struct {
+	deps *Dependencies
+}
+
+func NewGlobalSearchUseCase(deps *Dependencies) GlobalSearchUseCase {
+	return &globalSearchUseCase{deps: deps}
+}
+
+func (uc *globalSearchUseCase) Execute(ctx context.Context, req *dto.SearchRequest) (*dto.SearchResponse, error) {
+	if err := uc.deps.Validator.ValidateSearchRequest(req); err != nil {
+		return nil, err
+	}
+
+	query, err := uc.deps.Mapper.RequestToSearchQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := uc.deps.SearchRepo.SearchChildren(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	parents, err := uc.deps.SearchRepo.SearchParents(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	therapists, err := uc.deps.SearchRepo.SearchTherapists(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+	}
+
+	response := &dto.SearchResponse{
+		Query:      req.Query,
+		Children:   make([]*dto.SearchChildResult, 0, len(children)),
+		Parents:    make([]*dto.SearchParentResult, 0, len(parents)),
+		Therapists: make([]*dto.SearchTherapistResult, 0, len(therapists)),
+	}
+
+	for _, child := range children {
+		response.Children = append(response.Children, uc.deps.Mapper.ChildResult(child))
+	}
+
+	for _, parent := range parents {
+		response.Parents = append(response.Parents, uc.deps.Mapper.ParentResult(parent))
+	}
+
+	for _, therapist := range therapists {
+		response.Therapists = append(response.Therapists, uc.deps.Mapper.TherapistResult(therapist))
+	}
+
+	return response, nil
+}
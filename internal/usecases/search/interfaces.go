@@ -0,0 +1,10 @@
+package search
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"context"
+)
+
+type GlobalSearchUseCase interface {
+	Execute(ctx context.Context, req *dto.SearchRequest) (*dto.SearchResponse, error)
+}
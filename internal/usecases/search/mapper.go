@@ -0,0 +1,121 @@
+package search
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/domain/entities"
+	"backend-golang/internal/helpers"
+	"backend-golang/internal/infrastructure/config"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+const defaultSearchLimit = 10
+
+type Mapper interface {
+	RequestToSearchQuery(req *dto.SearchRequest) (entities.SearchQuery, error)
+	ChildResult(result *entities.ChildSearchResult) *dto.SearchChildResult
+	ParentResult(result *entities.ParentSearchResult) *dto.SearchParentResult
+	TherapistResult(result *entities.TherapistSearchResult) *dto.SearchTherapistResult
+}
+
+type searchMapper struct {
+	blindIndexKey string
+}
+
+func NewSearchMapper() Mapper {
+	key := config.GetEnv("BLIND_INDEX_KEY", "")
+	if key == "" {
+		log.Fatal().Err(fmt.Errorf("missing blind index key"))
+	}
+
+	return &searchMapper{
+		blindIndexKey: key,
+	}
+}
+
+func (m *searchMapper) RequestToSearchQuery(req *dto.SearchRequest) (entities.SearchQuery, error) {
+	phoneIndex, err := helpers.PhoneBlindIndex(req.Query, m.blindIndexKey)
+	if err != nil {
+		return entities.SearchQuery{}, fmt.Errorf("failed to index phone: %w", err)
+	}
+
+	addressIndex, err := helpers.AddressBlindIndex(req.Query, m.blindIndexKey)
+	if err != nil {
+		return entities.SearchQuery{}, fmt.Errorf("failed to index address: %w", err)
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	return entities.SearchQuery{
+		Text:         req.Query,
+		PhoneIndex:   phoneIndex,
+		AddressIndex: addressIndex,
+		Limit:        limit,
+	}, nil
+}
+
+func (m *searchMapper) ChildResult(result *entities.ChildSearchResult) *dto.SearchChildResult {
+	child := result.Child
+
+	var parentName string
+	if child.Parent != nil {
+		if parentDetail := child.Parent.PrimaryContact(); parentDetail != nil {
+			parentName = parentDetail.ParentName
+		}
+	}
+
+	return &dto.SearchChildResult{
+		ChildId:     child.Id,
+		ChildName:   child.ChildName,
+		ChildSchool: child.ChildSchool,
+		ParentName:  parentName,
+		Score:       result.Score,
+	}
+}
+
+func (m *searchMapper) ParentResult(result *entities.ParentSearchResult) *dto.SearchParentResult {
+	parent := result.Parent
+
+	response := &dto.SearchParentResult{
+		ParentId:           parent.Id,
+		Email:              parent.TempEmail,
+		RegistrationStatus: parent.RegistrationStatus,
+		ChildNames:         make([]string, 0, len(parent.Children)),
+		Score:              result.Score,
+	}
+
+	if parent.User != nil {
+		response.Email = parent.User.Email
+	}
+
+	if parentDetail := parent.PrimaryContact(); parentDetail != nil {
+		response.ParentName = parentDetail.ParentName
+	}
+
+	for _, child := range parent.Children {
+		response.ChildNames = append(response.ChildNames, child.ChildName)
+	}
+
+	return response
+}
+
+func (m *searchMapper) TherapistResult(result *entities.TherapistSearchResult) *dto.SearchTherapistResult {
+	therapist := result.Therapist
+
+	response := &dto.SearchTherapistResult{
+		TherapistId:      therapist.Id,
+		TherapistName:    therapist.TherapistName,
+		TherapistSection: therapist.TherapistSection,
+		Score:            result.Score,
+	}
+
+	if therapist.User != nil {
+		response.Email = therapist.User.Email
+	}
+
+	return response
+}
@@ -0,0 +1,32 @@
+package search
+
+import (
+	"backend-golang/internal/adapters/http/dto"
+	"backend-golang/internal/validator"
+	"fmt"
+	"strings"
+)
+
+type Validator interface {
+	ValidateSearchRequest(req *dto.SearchRequest) error
+}
+
+type searchValidator struct{}
+
+func NewSearchValidator() Validator {
+	return &searchValidator{}
+}
+
+func (v *searchValidator) ValidateSearchRequest(req *dto.SearchRequest) error {
+	req.Query = strings.TrimSpace(req.Query)
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	if strings.Trim(req.Query, "%_*") == "" {
+		return fmt.Errorf("search query must contain letters or digits")
+	}
+
+	return nil
+}
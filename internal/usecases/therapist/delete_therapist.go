@@ -38,5 +38,9 @@ func (uc *deleteTherapistUseCase) Execute(ctx context.Context, therapistId strin
 		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
 	}
 
+	if err := uc.deps.TokenDenylist.RevokeUser(ctx, therapist.UserId); err != nil {
+		return fmt.Errorf("%w: failed to revoke access tokens: %v", errors.ErrInternalServer, err)
+	}
+
 	return nil
 }
@@ -1,25 +1,34 @@
 package therapist
 
-import "backend-golang/internal/domain/repositories"
+import (
+	"backend-golang/internal/domain/repositories"
+	"backend-golang/internal/domain/services"
+)
 
 type Dependencies struct {
-	TxRepo        repositories.TransactionRepository
-	UserRepo      repositories.UserRepository
-	TherapistRepo repositories.TherapistRepository
-	Validator     Validator
-	Mapper        Mapper
+	TxRepo           repositories.TransactionRepository
+	UserRepo         repositories.UserRepository
+	TherapistRepo    repositories.TherapistRepository
+	RefreshTokenRepo repositories.RefreshTokenRepository
+	TokenDenylist    services.TokenDenylistService
+	Validator        Validator
+	Mapper           Mapper
 }
 
 func NewDependencies(
 	txRepo repositories.TransactionRepository,
 	userRepo repositories.UserRepository,
 	therapistRepo repositories.TherapistRepository,
+	refreshTokenRepo repositories.RefreshTokenRepository,
+	tokenDenylist services.TokenDenylistService,
 ) *Dependencies {
 	return &Dependencies{
-		TxRepo:        txRepo,
-		UserRepo:      userRepo,
-		TherapistRepo: therapistRepo,
-		Validator:     NewTherapistValidator(),
-		Mapper:        NewTherapistMapper(),
+		TxRepo:           txRepo,
+		UserRepo:         userRepo,
+		TherapistRepo:    therapistRepo,
+		RefreshTokenRepo: refreshTokenRepo,
+		TokenDenylist:    tokenDenylist,
+		Validator:        NewTherapistValidator(),
+		Mapper:           NewTherapistMapper(),
 	}
 }
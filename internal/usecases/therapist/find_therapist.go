@@ -2,7 +2,7 @@ package therapist
 
 import (
 	"backend-golang/internal/adapters/http/dto"
-	"backend-golang/internal/errors"
+	"backend-golang/internal/usecases/pagination"
 	"context"
 	"fmt"
 )
@@ -15,14 +15,19 @@ func NewFindTherapistsUseCase(deps *Dependencies) FindTherapistsUseCase {
 	return &findTherapistsUseCase{deps: deps}
 }
 
-func (uc *findTherapistsUseCase) Execute(ctx context.Context) ([]*dto.TherapistResponse, error) {
-	therapists, err := uc.deps.TherapistRepo.GetAll(ctx)
+func (uc *findTherapistsUseCase) Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.TherapistResponse, *dto.PaginationMeta, error) {
+	query, err := pagination.ToListQuery(req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", errors.ErrRetrievalFailed, err)
+		return nil, nil, err
+	}
+
+	therapists, pageInfo, err := uc.deps.TherapistRepo.GetAll(ctx, query)
+	if err != nil {
+		return nil, nil, pagination.RetrievalError(err)
 	}
 
 	if therapists == nil {
-		return []*dto.TherapistResponse{}, nil
+		return []*dto.TherapistResponse{}, pagination.ToMeta(pageInfo), nil
 	}
 
 	responses := make([]*dto.TherapistResponse, 0, len(therapists))
@@ -33,7 +38,7 @@ func (uc *findTherapistsUseCase) Execute(ctx context.Context) ([]*dto.TherapistR
 
 		response, err := uc.deps.Mapper.TherapistsResponse(therapist.User, therapist)
 		if err != nil {
-			return nil, fmt.Errorf("failed to map observation %s: %w", therapist.Id, err)
+			return nil, nil, fmt.Errorf("failed to map observation %s: %w", therapist.Id, err)
 		}
 
 		if response != nil {
@@ -41,5 +46,5 @@ func (uc *findTherapistsUseCase) Execute(ctx context.Context) ([]*dto.TherapistR
 		}
 	}
 
-	return responses, nil
+	return responses, pagination.ToMeta(pageInfo), nil
 }
@@ -10,7 +10,7 @@ type CreateTherapistUseCase interface {
 }
 
 type FindTherapistsUseCase interface {
-	Execute(ctx context.Context) ([]*dto.TherapistResponse, error)
+	Execute(ctx context.Context, req *dto.ListQueryRequest) ([]*dto.TherapistResponse, *dto.PaginationMeta, error)
 }
 
 type FindTherapistDetailUseCase interface {
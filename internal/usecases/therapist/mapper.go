@@ -20,6 +20,7 @@ type Mapper interface {
 
 type therapistMapper struct {
 	encryptionKey string
+	blindIndexKey string
 }
 
 func NewTherapistMapper() Mapper {
@@ -28,8 +29,14 @@ func NewTherapistMapper() Mapper {
 		log.Fatal().Err(fmt.Errorf("missing encrypted key"))
 	}
 
+	blindIndexKey := config.GetEnv("BLIND_INDEX_KEY", "")
+	if blindIndexKey == "" {
+		log.Fatal().Err(fmt.Errorf("missing blind index key"))
+	}
+
 	return &therapistMapper{
 		encryptionKey: key,
+		blindIndexKey: blindIndexKey,
 	}
 }
 
@@ -58,12 +65,18 @@ func (m *therapistMapper) CreateRequestToUserAndTherapist(req *dto.TherapistCrea
 		return nil, nil, fmt.Errorf("failed to encrypt contact: %w", err)
 	}
 
+	phoneIndex, err := helpers2.PhoneBlindIndex(req.TherapistPhone, m.blindIndexKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to index contact: %w", err)
+	}
+
 	therapist := &entities.Therapist{
-		Id:               therapistID,
-		UserId:           userId,
-		TherapistName:    req.TherapistName,
-		TherapistSection: req.TherapistSection,
-		TherapistPhone:   phoneEncrypted,
+		Id:                  therapistID,
+		UserId:              userId,
+		TherapistName:       req.TherapistName,
+		TherapistSection:    req.TherapistSection,
+		TherapistPhone:      phoneEncrypted,
+		TherapistPhoneIndex: phoneIndex,
 	}
 
 	return user, therapist, nil
@@ -122,13 +135,14 @@ func (m *therapistMapper) UpdateRequestToUserAndTherapist(req *dto.TherapistUpda
 	}
 
 	updatedTherapist := &entities.Therapist{
-		Id:               existing.Id,
-		UserId:           existing.UserId,
-		TherapistName:    existing.TherapistName,
-		TherapistSection: existing.TherapistSection,
-		TherapistPhone:   existing.TherapistPhone,
-		CreatedAt:        existing.CreatedAt,
-		UpdatedAt:        time.Now(),
+		Id:                  existing.Id,
+		UserId:              existing.UserId,
+		TherapistName:       existing.TherapistName,
+		TherapistSection:    existing.TherapistSection,
+		TherapistPhone:      existing.TherapistPhone,
+		TherapistPhoneIndex: existing.TherapistPhoneIndex,
+		CreatedAt:           existing.CreatedAt,
+		UpdatedAt:           time.Now(),
 	}
 
 	if req.TherapistName != "" {
@@ -145,6 +159,12 @@ func (m *therapistMapper) UpdateRequestToUserAndTherapist(req *dto.TherapistUpda
 			return nil, nil, fmt.Errorf("failed to encrypt phone: %w", err)
 		}
 		updatedTherapist.TherapistPhone = phoneEncrypted
+
+		phoneIndex, err := helpers2.PhoneBlindIndex(req.TherapistPhone, m.blindIndexKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to index phone: %w", err)
+		}
+		updatedTherapist.TherapistPhoneIndex = phoneIndex
 	}
 
 	return updatedUser, updatedTherapist, nil
@@ -72,5 +72,14 @@ func (uc *updateTherapistUseCase) Execute(ctx context.Context, therapistId strin
 		return fmt.Errorf("%w: commit failed: %v", errors.ErrDatabaseConnection, err)
 	}
 
+	if updatedUser.EndsSessions(existingTherapist.User) {
+		if _, err := uc.deps.RefreshTokenRepo.RevokeAllByUser(ctx, updatedUser.Id, ""); err != nil {
+			return fmt.Errorf("%w: failed to revoke sessions: %v", errors.ErrInternalServer, err)
+		}
+		if err := uc.deps.TokenDenylist.RevokeUser(ctx, updatedUser.Id); err != nil {
+			return fmt.Errorf("%w: failed to revoke access tokens: %v", errors.ErrInternalServer, err)
+		}
+	}
+
 	return nil
 }
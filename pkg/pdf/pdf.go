@@ -0,0 +1,323 @@
+// Package pdf writes simple flowing A4 documents using the standard
+// Helvetica fonts, so no font files need to be embedded.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+	Margin     = 50.0
+
+	lineSpacing = 1.35
+	footerSize  = 8.0
+)
+
+type Style int
+
+const (
+	Regular Style = iota
+	Bold
+)
+
+type Color struct {
+	R, G, B float64
+}
+
+var (
+	Black = Color{0, 0, 0}
+	White = Color{1, 1, 1}
+	Gray  = Color{0.45, 0.45, 0.45}
+)
+
+type Document struct {
+	title     string
+	footer    string
+	pages     []*bytes.Buffer
+	page      *bytes.Buffer
+	y         float64
+	style     Style
+	size      float64
+	textColor Color
+}
+
+func New(title string) *Document {
+	d := &Document{
+		title:     title,
+		style:     Regular,
+		size:      10,
+		textColor: Black,
+	}
+	d.AddPage()
+
+	return d
+}
+
+// SetFooter sets the text printed at the bottom of every page next to the
+// page number.
+func (d *Document) SetFooter(text string) {
+	d.footer = text
+}
+
+func (d *Document) AddPage() {
+	d.page = &bytes.Buffer{}
+	d.pages = append(d.pages, d.page)
+	d.y = PageHeight - Margin
+}
+
+func (d *Document) SetFont(style Style, size float64) {
+	d.style = style
+	d.size = size
+}
+
+func (d *Document) SetTextColor(color Color) {
+	d.textColor = color
+}
+
+func (d *Document) ContentWidth() float64 {
+	return PageWidth - 2*Margin
+}
+
+func (d *Document) Space(height float64) {
+	if d.y-height < Margin+footerSize*2 {
+		d.AddPage()
+		return
+	}
+	d.y -= height
+}
+
+// Banner fills a full-width band at the cursor and writes text on it.
+func (d *Document) Banner(text string, size float64, background Color, foreground Color) {
+	height := size * 2.4
+	d.ensure(height)
+
+	fmt.Fprintf(d.page, "%.3f %.3f %.3f rg %.2f %.2f %.2f %.2f re f\n",
+		background.R, background.G, background.B, Margin, d.y-height, d.ContentWidth(), height)
+	d.writeText(Margin+size*0.8, d.y-height/2-size*0.35, Bold, size, foreground, text)
+
+	d.y -= height
+}
+
+// Rule draws a thin line across the content width.
+func (d *Document) Rule() {
+	d.ensure(6)
+	fmt.Fprintf(d.page, "0.75 0.75 0.75 RG 0.5 w %.2f %.2f m %.2f %.2f l S\n",
+		Margin, d.y-3, PageWidth-Margin, d.y-3)
+	d.y -= 6
+}
+
+// Paragraph writes text wrapped to the content width, moving to a new page
+// when it runs out of room.
+func (d *Document) Paragraph(text string) {
+	d.Row([]float64{d.ContentWidth()}, []string{text})
+}
+
+// Row writes one line of columns; each cell wraps within its width and the
+// row is as tall as its longest cell. A row is never split across pages.
+func (d *Document) Row(widths []float64, cells []string) {
+	lineHeight := d.size * lineSpacing
+
+	wrapped := make([][]string, len(cells))
+	lines := 1
+	for i, cell := range cells {
+		wrapped[i] = wrap(cell, d.style, d.size, widths[i]-4)
+		if len(wrapped[i]) > lines {
+			lines = len(wrapped[i])
+		}
+	}
+
+	d.ensure(float64(lines) * lineHeight)
+
+	x := Margin
+	for i, cellLines := range wrapped {
+		for j, line := range cellLines {
+			d.writeText(x, d.y-d.size-float64(j)*lineHeight, d.style, d.size, d.textColor, line)
+		}
+		x += widths[i]
+	}
+
+	d.y -= float64(lines) * lineHeight
+}
+
+// Bytes lays out the finished document as a PDF file.
+func (d *Document) Bytes() []byte {
+	var out bytes.Buffer
+	offsets := make([]int, 0, 4+2*len(d.pages))
+
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	pageCount := len(d.pages)
+	kids := make([]string, 0, pageCount)
+	for i := 0; i < pageCount; i++ {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 6+2*i))
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), pageCount))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	object(fmt.Sprintf("<< /Title (%s) /Producer (backend-golang) >>", escape(encode(d.title))))
+
+	for i, page := range d.pages {
+		content := bytes.Buffer{}
+		content.Write(page.Bytes())
+
+		footer := fmt.Sprintf("%d / %d", i+1, pageCount)
+		if d.footer != "" {
+			footer = d.footer + "   " + footer
+		}
+		footerX := PageWidth - Margin - textWidth(footer, Regular, footerSize)
+		fmt.Fprintf(&content, "BT %.3f %.3f %.3f rg /F1 %.1f Tf %.2f %.2f Td (%s) Tj ET\n",
+			Gray.R, Gray.G, Gray.B, footerSize, footerX, Margin/2, escape(encode(footer)))
+
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			PageWidth, PageHeight, len(offsets)+2))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.Bytes()
+}
+
+func (d *Document) ensure(height float64) {
+	if d.y-height < Margin+footerSize*2 {
+		d.AddPage()
+	}
+}
+
+func (d *Document) writeText(x, y float64, style Style, size float64, color Color, text string) {
+	font := "F1"
+	if style == Bold {
+		font = "F2"
+	}
+
+	fmt.Fprintf(d.page, "BT %.3f %.3f %.3f rg /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n",
+		color.R, color.G, color.B, font, size, x, y, escape(encode(text)))
+}
+
+// wrap breaks text into lines no wider than width, honouring explicit line
+// breaks and splitting words that are too long on their own.
+func wrap(text string, style Style, size float64, width float64) []string {
+	var lines []string
+
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			for textWidth(word, style, size) > width {
+				runes := []rune(word)
+				cut := len(runes) - 1
+				for cut > 1 && textWidth(string(runes[:cut]), style, size) > width {
+					cut--
+				}
+				if line != "" {
+					lines = append(lines, line)
+					line = ""
+				}
+				lines = append(lines, string(runes[:cut]))
+				word = string(runes[cut:])
+			}
+
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+
+			if textWidth(candidate, style, size) > width && line != "" {
+				lines = append(lines, line)
+				line = word
+				continue
+			}
+			line = candidate
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func textWidth(text string, style Style, size float64) float64 {
+	widths := helveticaWidths
+	if style == Bold {
+		widths = helveticaBoldWidths
+	}
+
+	total := 0
+	for _, b := range encode(text) {
+		if b >= 32 && int(b-32) < len(widths) {
+			total += widths[b-32]
+		} else {
+			total += 556
+		}
+	}
+
+	return float64(total) * size / 1000
+}
+
+// encode maps text onto WinAnsiEncoding; Latin-1 characters keep their code
+// and anything else becomes '?'.
+func encode(text string) []byte {
+	out := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r == '\t':
+			out = append(out, ' ')
+		case r < 32:
+			continue
+		case r < 127 || (r >= 0xA0 && r <= 0xFF):
+			out = append(out, byte(r))
+		default:
+			out = append(out, '?')
+		}
+	}
+
+	return out
+}
+
+func escape(text []byte) string {
+	var b strings.Builder
+	for _, c := range text {
+		switch c {
+		case '(', ')', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+// Glyph widths for characters 32-126, from the standard font metrics.
+var helveticaWidths = []int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = []int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}